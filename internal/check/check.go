@@ -5,15 +5,16 @@ import (
 	"slices"
 	"time"
 
-	"github.com/golang/glog"
-	"github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/deployment"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nodes"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
+var logger = logging.Logger(logging.GPU)
+
 const (
 	nfdOperatorNamespace  = "openshift-nfd"
 	nfdOperatorDeployment = "nfd-controller-manager"
@@ -28,8 +29,7 @@ func AllNodeLabel(apiClient *clients.Settings, nodeLabel string, nodeLabelValues
 	// in all the nodes that match the nodeSelectors, look for specific label
 	// For example, look in all the worker nodes for a specific label with specific value
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("could not discover %v nodes, error encountered: '%v'",
-			nodeSelector, err)
+		logger.V(logging.LevelDebug).Info("Could not discover nodes", "nodeSelector", nodeSelector, "error", err)
 
 		return false, err
 	}
@@ -41,8 +41,8 @@ func AllNodeLabel(apiClient *clients.Settings, nodeLabel string, nodeLabelValues
 		labelValue := node.Object.Labels[nodeLabel]
 
 		if slices.Contains(nodeLabelValues, labelValue) {
-			glog.V(gpuparams.GpuLogLevel).Infof("Found label %v that contains %v with label value %s on "+
-				"node %v", nodeLabel, nodeLabel, labelValue, node.Object.Name)
+			logger.V(logging.LevelDebug).Info("Found label with allowed label value on node", "label", nodeLabel,
+				"labelValue", labelValue, "node", node.Object.Name)
 
 			foundLabels++
 			// if all nodes matching nodeSelector have this label with label value.
@@ -65,7 +65,7 @@ func NodeWithLabel(apiClient *clients.Settings, nodeLabel string, nodeSelector m
 	// Check if at least one node matching the nodeSelector has the specific nodeLabel label set to true
 	// For example, look in all the worker nodes for specific label
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("could not discover %v nodes", nodeSelector)
+		logger.V(logging.LevelDebug).Info("Could not discover nodes", "nodeSelector", nodeSelector)
 
 		return false, err
 	}
@@ -74,8 +74,8 @@ func NodeWithLabel(apiClient *clients.Settings, nodeLabel string, nodeSelector m
 		labelValue, ok := node.Object.Labels[nodeLabel]
 
 		if ok {
-			glog.V(gpuparams.GpuLogLevel).Infof("Found label '%v' with label value '%v' on node '%v'",
-				nodeLabel, labelValue, node.Object.Name)
+			logger.V(logging.LevelDebug).Info("Found label with label value on node",
+				"label", nodeLabel, "labelValue", labelValue, "node", node.Object.Name)
 
 			return true, nil
 		}
@@ -92,29 +92,29 @@ func NFDDeploymentsReady(apiClient *clients.Settings) (bool, error) {
 	nfdOperatorDeployment, err1 := deployment.Pull(apiClient, nfdOperatorDeployment, nfdOperatorNamespace)
 
 	if err1 != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("Error trying to pull NFD operator deployment:  %v ", err1)
+		logger.V(logging.LevelDebug).Info("Error trying to pull NFD operator deployment", "error", err1)
 
 		return false, err1
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Pulled NFD operator deployment is:  %v ",
-		nfdOperatorDeployment.Definition.Name)
+	logger.V(logging.LevelDebug).Info("Pulled NFD operator deployment",
+		"name", nfdOperatorDeployment.Definition.Name)
 
 	// Check nfd-master deployment.
 	nfdMasterDeployment, err2 := deployment.Pull(apiClient, nfdMasterDeployment, nfdOperatorNamespace)
 
 	if err2 != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("Error trying to pull NFD master deployment:  %v ", err2)
+		logger.V(logging.LevelDebug).Info("Error trying to pull NFD master deployment", "error", err2)
 
 		return false, err2
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Pulled NFD operand master deployment:  %v ",
-		nfdMasterDeployment.Definition.Name)
+	logger.V(logging.LevelDebug).Info("Pulled NFD operand master deployment",
+		"name", nfdMasterDeployment.Definition.Name)
 
 	if nfdOperatorDeployment.IsReady(180*time.Second) && nfdMasterDeployment.IsReady(180*time.Second) {
-		glog.V(gpuparams.GpuLogLevel).Infof("NFD operator '%s' and operand '%s' deployments are ready",
-			nfdOperatorDeployment.Definition.Name, nfdMasterDeployment.Definition.Name)
+		logger.V(logging.LevelDebug).Info("NFD operator and operand deployments are ready",
+			"operator", nfdOperatorDeployment.Definition.Name, "operand", nfdMasterDeployment.Definition.Name)
 
 		return true, nil
 	}
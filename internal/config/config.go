@@ -20,16 +20,19 @@ const (
 
 // GeneralConfig type keeps general configuration.
 type GeneralConfig struct {
-	ReportsDirAbsPath    string `yaml:"reports_dump_dir" envconfig:"REPORTS_DUMP_DIR"`
-	VerboseLevel         string `yaml:"verbose_level" envconfig:"VERBOSE_LEVEL"`
-	DumpFailedTests      bool   `yaml:"dump_failed_tests" envconfig:"DUMP_FAILED_TESTS"`
-	DryRun               bool   `yaml:"dry_run" envconfig:"DRY_RUN"`
-	KubernetesRolePrefix string `yaml:"kubernetes_role_prefix" envconfig:"KUBERNETES_ROLE_PREFIX"`
-	WorkerLabelEnvVar    string `yaml:"worker_label" envconfig:"WORKER_LABEL"`
-	WorkerLabel          string
-	ControlPlaneLabel    string `yaml:"control_plane_label" envconfig:"CONTROL_PLANE_LABEL"`
-	WorkerLabelMap       map[string]string
-	ControlPlaneLabelMap map[string]string
+	ReportsDirAbsPath    string         `yaml:"reports_dump_dir" envconfig:"REPORTS_DUMP_DIR"`
+	VerboseLevel         string         `yaml:"verbose_level" envconfig:"VERBOSE_LEVEL"`
+	LogVerbosity         map[string]int `yaml:"log_verbosity" envconfig:"LOG_VERBOSITY"`
+	DumpFailedTests      bool           `yaml:"dump_failed_tests" envconfig:"DUMP_FAILED_TESTS"`
+	DryRun               bool           `yaml:"dry_run" envconfig:"DRY_RUN"`
+	KubernetesRolePrefix string         `yaml:"kubernetes_role_prefix" envconfig:"KUBERNETES_ROLE_PREFIX"`
+	WorkerLabelEnvVar    string         `yaml:"worker_label" envconfig:"WORKER_LABEL"`
+	ControlPlaneLabel    string         `yaml:"control_plane_label" envconfig:"CONTROL_PLANE_LABEL"`
+
+	// The labels below are derived from the fields above by readEnv, so neither a profile nor env vars set them.
+	WorkerLabel          string            `yaml:"-" ignored:"true"`
+	WorkerLabelMap       map[string]string `yaml:"-" ignored:"true"`
+	ControlPlaneLabelMap map[string]string `yaml:"-" ignored:"true"`
 }
 
 // NewConfig returns instance of GeneralConfig config type.
@@ -49,6 +52,14 @@ func NewConfig() *GeneralConfig {
 		return nil
 	}
 
+	err = ReadProfileSection(ProfileSectionGeneral, &conf)
+
+	if err != nil {
+		log.Printf("Error to read profile file: %s", err.Error())
+
+		return nil
+	}
+
 	err = readEnv(&conf)
 
 	if err != nil {
@@ -65,6 +76,13 @@ func NewConfig() *GeneralConfig {
 		return nil
 	}
 
+	err = conf.WriteEffectiveConfig(ProfileSectionGeneral, &conf)
+
+	if err != nil {
+		log.Printf("Error to write effective config to report directory %s due to %s",
+			conf.ReportsDirAbsPath, err.Error())
+	}
+
 	return &conf
 }
 
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	yaml "sigs.k8s.io/yaml/goyaml.v2"
+)
+
+const (
+	// ProfileFileEnvVar is the env var holding the path to the optional profile file.
+	ProfileFileEnvVar = "NVIDIACI_PROFILE_FILE"
+	// ProfileSectionGeneral is the profile section holding GeneralConfig parameters.
+	ProfileSectionGeneral = "general"
+	// EffectiveConfigFileFormat is the name format of the effective config files written in the report directory.
+	EffectiveConfigFileFormat = "effective-config-%s.yaml"
+)
+
+// ReadProfileSection decodes the given top level section of the profile file referenced by NVIDIACI_PROFILE_FILE
+// into cfg. Nothing is done when the env var is not set or the section is missing from the profile file.
+// Unknown keys in the section are reported as errors so that typos are caught before any test is run.
+func ReadProfileSection(section string, cfg interface{}) error {
+	profileFile, ok := os.LookupEnv(ProfileFileEnvVar)
+	if !ok || profileFile == "" {
+		return nil
+	}
+
+	log.Printf("Reading section '%s' of profile file %s", section, profileFile)
+
+	content, err := os.ReadFile(profileFile)
+	if err != nil {
+		return fmt.Errorf("failed to read profile file %s: %w", profileFile, err)
+	}
+
+	profile := map[string]interface{}{}
+
+	if err := yaml.Unmarshal(content, &profile); err != nil {
+		return fmt.Errorf("failed to parse profile file %s: %w", profileFile, err)
+	}
+
+	sectionContent, ok := profile[section]
+	if !ok || sectionContent == nil {
+		return nil
+	}
+
+	sectionYAML, err := yaml.Marshal(sectionContent)
+	if err != nil {
+		return fmt.Errorf("failed to marshal section '%s' of profile file %s: %w", section, profileFile, err)
+	}
+
+	if err := yaml.UnmarshalStrict(sectionYAML, cfg); err != nil {
+		return fmt.Errorf("invalid section '%s' in profile file %s: %w", section, profileFile, err)
+	}
+
+	return nil
+}
+
+// WriteEffectiveConfig writes the merged configuration of the given section into the report directory.
+func (cfg *GeneralConfig) WriteEffectiveConfig(section string, sectionConfig interface{}) error {
+	content, err := yaml.Marshal(sectionConfig)
+	if err != nil {
+		return fmt.Errorf("failed to marshal effective config of section '%s': %w", section, err)
+	}
+
+	return cfg.WriteReport(fmt.Sprintf(EffectiveConfigFileFormat, section), content)
+}
@@ -0,0 +1,120 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestReadProfileSection(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		profile  string
+		expected GeneralConfig
+		err      string
+	}{
+		{
+			name:     "section set",
+			profile:  "general:\n  verbose_level: \"100\"\n  dry_run: true\n  worker_label: gpu\n",
+			expected: GeneralConfig{VerboseLevel: "100", DryRun: true, WorkerLabelEnvVar: "gpu"},
+		},
+		{
+			name:    "section missing",
+			profile: "nvidiagpu:\n  burn_seconds: 60\n",
+		},
+		{
+			name:    "unknown key",
+			profile: "general:\n  verbose_levle: \"100\"\n",
+			err:     "field verbose_levle not found",
+		},
+		{
+			name:    "derived label",
+			profile: "general:\n  workerlabel: node-role.kubernetes.io/gpu\n",
+			err:     "field workerlabel not found",
+		},
+		{
+			name:    "type error",
+			profile: "general:\n  dry_run: sometimes\n",
+			err:     "cannot unmarshal !!str `sometimes` into bool",
+		},
+		{
+			name:    "invalid YAML",
+			profile: "general: [\n",
+			err:     "failed to parse profile file",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			profileFile := filepath.Join(t.TempDir(), "profile.yaml")
+			if err := os.WriteFile(profileFile, []byte(test.profile), 0600); err != nil {
+				t.Fatalf("failed to write profile file: %v", err)
+			}
+
+			t.Setenv(ProfileFileEnvVar, profileFile)
+
+			var cfg GeneralConfig
+
+			err := ReadProfileSection(ProfileSectionGeneral, &cfg)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Errorf("expected error containing %q, got %v", test.err, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error reading the profile section: %v", err)
+			}
+
+			if cfg.VerboseLevel != test.expected.VerboseLevel || cfg.DryRun != test.expected.DryRun ||
+				cfg.WorkerLabelEnvVar != test.expected.WorkerLabelEnvVar {
+				t.Errorf("expected %+v, got %+v", test.expected, cfg)
+			}
+		})
+	}
+}
+
+func TestReadProfileSectionWithoutProfile(t *testing.T) {
+	t.Setenv(ProfileFileEnvVar, "")
+
+	cfg := GeneralConfig{VerboseLevel: "1"}
+	if err := ReadProfileSection(ProfileSectionGeneral, &cfg); err != nil || cfg.VerboseLevel != "1" {
+		t.Errorf("expected the config untouched without profile file, got %+v, %v", cfg, err)
+	}
+
+	t.Setenv(ProfileFileEnvVar, filepath.Join(t.TempDir(), "missing.yaml"))
+
+	if err := ReadProfileSection(ProfileSectionGeneral, &cfg); err == nil {
+		t.Errorf("expected error reading a missing profile file")
+	}
+}
+
+func TestReadEnvOverridesProfile(t *testing.T) {
+	profileFile := filepath.Join(t.TempDir(), "profile.yaml")
+
+	err := os.WriteFile(profileFile, []byte("general:\n  kubernetes_role_prefix: node-role.kubernetes.io\n"+
+		"  worker_label: gpu\n  control_plane_label: master\n  dry_run: true\n"), 0600)
+	if err != nil {
+		t.Fatalf("failed to write profile file: %v", err)
+	}
+
+	t.Setenv(ProfileFileEnvVar, profileFile)
+	t.Setenv("WORKER_LABEL", "worker")
+	t.Setenv("DRY_RUN", "false")
+
+	var cfg GeneralConfig
+	if err := ReadProfileSection(ProfileSectionGeneral, &cfg); err != nil {
+		t.Fatalf("unexpected error reading the profile section: %v", err)
+	}
+
+	if err := readEnv(&cfg); err != nil {
+		t.Fatalf("unexpected error reading env variables: %v", err)
+	}
+
+	if cfg.DryRun || cfg.WorkerLabel != "node-role.kubernetes.io/worker" ||
+		cfg.ControlPlaneLabel != "node-role.kubernetes.io/master" ||
+		cfg.WorkerLabelMap["node-role.kubernetes.io/worker"] != "" || len(cfg.WorkerLabelMap) != 1 {
+		t.Errorf("expected the env variables to override the profile, got %+v", cfg)
+	}
+}
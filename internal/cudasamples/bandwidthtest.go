@@ -0,0 +1,78 @@
+package cudasamples
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// HostToDevice is the direction of the copies from the host memory to the GPUs.
+	HostToDevice = "host_to_device"
+	// DeviceToHost is the direction of the copies from the GPUs to the host memory.
+	DeviceToHost = "device_to_host"
+	// DeviceToDevice is the direction of the copies within the GPU memory.
+	DeviceToDevice = "device_to_device"
+
+	// mibPerSecond is a MiB/s in GB/s, the bandwidth unit of the CUDA samples before 11.6.
+	mibPerSecond = float64(1<<20) / 1e9
+)
+
+var transferRegexp = regexp.MustCompile(`^([0-9]+)\s+([0-9]+(?:\.[0-9]+)?)$`)
+
+// BandwidthResult is the result of bandwidthTest.
+type BandwidthResult struct {
+	// Bandwidths maps the copy directions to the highest bandwidth measured in GB/s.
+	Bandwidths map[string]float64
+	Passed     bool
+}
+
+// ParseBandwidthTest parses the output of bandwidthTest, in GB/s or, before CUDA 11.6, in MB/s.
+func ParseBandwidthTest(output string) (*BandwidthResult, error) {
+	result := &BandwidthResult{Bandwidths: map[string]float64{}}
+	resultFound := false
+	direction, scale := "", 1.0
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(line, "Host to Device Bandwidth"):
+			direction = HostToDevice
+		case strings.HasPrefix(line, "Device to Host Bandwidth"):
+			direction = DeviceToHost
+		case strings.HasPrefix(line, "Device to Device Bandwidth"):
+			direction = DeviceToDevice
+		case strings.Contains(line, "Bandwidth(MB/s)"):
+			scale = mibPerSecond
+		case strings.Contains(line, "Bandwidth(GB/s)"):
+			scale = 1
+		}
+
+		if match := resultRegexp.FindStringSubmatch(line); match != nil {
+			result.Passed = match[1] == "PASS"
+			resultFound = true
+
+			continue
+		}
+
+		match := transferRegexp.FindStringSubmatch(line)
+		if match == nil || direction == "" {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(match[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse bandwidthTest bandwidth '%s': %w", match[2], err)
+		}
+
+		result.Bandwidths[direction] = max(result.Bandwidths[direction], value*scale)
+	}
+
+	if !resultFound {
+		return nil, fmt.Errorf("no result found in bandwidthTest output")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,123 @@
+package cudasamples
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func readFixture(t *testing.T, name string) string {
+	t.Helper()
+
+	fixture, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %v", name, err)
+	}
+
+	return string(fixture)
+}
+
+func TestParseDeviceQuery(t *testing.T) {
+	deviceQuery, err := ParseDeviceQuery(readFixture(t, "devicequery-a100x2.txt"))
+	if err != nil {
+		t.Fatalf("unexpected error parsing deviceQuery output: %v", err)
+	}
+
+	if !deviceQuery.Passed || deviceQuery.DeviceCount != 2 || deviceQuery.DriverVersion != "12.4" ||
+		deviceQuery.RuntimeVersion != "12.2" || len(deviceQuery.Devices) != 2 {
+		t.Fatalf("unexpected deviceQuery result %+v", deviceQuery)
+	}
+
+	expectedDevice := Device{Index: 1, Name: "NVIDIA A100-SXM4-40GB", ComputeCapability: "8.0",
+		GlobalMemoryMiB: 40338, Multiprocessors: 108}
+	if deviceQuery.Devices[1] != expectedDevice {
+		t.Errorf("unexpected device %+v", deviceQuery.Devices[1])
+	}
+
+	expectedAccess := PeerAccess{{From: 0, To: 1}: true, {From: 1, To: 0}: true}
+	if !reflect.DeepEqual(deviceQuery.PeerAccess, expectedAccess) {
+		t.Errorf("unexpected peer access %v", deviceQuery.PeerAccess)
+	}
+
+	deviceQuery, err = ParseDeviceQuery(readFixture(t, "devicequery-nodevice.txt"))
+	if err != nil {
+		t.Fatalf("unexpected error parsing deviceQuery output: %v", err)
+	}
+
+	if deviceQuery.Passed || deviceQuery.DeviceCount != 0 || len(deviceQuery.Devices) != 0 {
+		t.Errorf("expected deviceQuery to fail without device, got %+v", deviceQuery)
+	}
+
+	if _, err := ParseDeviceQuery("deviceQuery Starting...\n"); err == nil {
+		t.Errorf("expected error parsing truncated deviceQuery output")
+	}
+}
+
+func TestParseVectorAdd(t *testing.T) {
+	vectorAdd, err := ParseVectorAdd(readFixture(t, "vectoradd.txt"))
+	if err != nil || !vectorAdd.Passed || len(vectorAdd.Failures) != 0 {
+		t.Errorf("expected vectorAdd to pass, got %+v, %v", vectorAdd, err)
+	}
+
+	vectorAdd, err = ParseVectorAdd("[Vector addition of 50000 elements]\n" +
+		"Failed to launch vectorAdd kernel (error code no CUDA-capable device is detected)!\n")
+	if err != nil || vectorAdd.Passed || len(vectorAdd.Failures) != 1 {
+		t.Errorf("expected vectorAdd to fail, got %+v, %v", vectorAdd, err)
+	}
+
+	if _, err := ParseVectorAdd("[Vector addition of 50000 elements]\n"); err == nil {
+		t.Errorf("expected error parsing truncated vectorAdd output")
+	}
+}
+
+func TestParseBandwidthTest(t *testing.T) {
+	bandwidthTest, err := ParseBandwidthTest(readFixture(t, "bandwidthtest-a10g.txt"))
+	if err != nil {
+		t.Fatalf("unexpected error parsing bandwidthTest output: %v", err)
+	}
+
+	expectedBandwidths := map[string]float64{HostToDevice: 12.5, DeviceToHost: 13.1, DeviceToDevice: 380.2}
+	if !bandwidthTest.Passed || !reflect.DeepEqual(bandwidthTest.Bandwidths, expectedBandwidths) {
+		t.Errorf("unexpected bandwidthTest result %+v", bandwidthTest)
+	}
+
+	bandwidthTest, err = ParseBandwidthTest(" Host to Device Bandwidth, 1 Device(s)\n" +
+		"   Transfer Size (Bytes)\tBandwidth(MB/s)\n   33554432\t\t\t10000.0\n\nResult = FAIL\n")
+	if err != nil {
+		t.Fatalf("unexpected error parsing bandwidthTest output: %v", err)
+	}
+
+	if bandwidthTest.Passed || bandwidthTest.Bandwidths[HostToDevice] != 10000*mibPerSecond {
+		t.Errorf("expected MB/s converted to GB/s, got %+v", bandwidthTest)
+	}
+}
+
+func TestParseSimpleP2P(t *testing.T) {
+	simpleP2P, err := ParseSimpleP2P(readFixture(t, "simplep2p-a100x2.txt"))
+	if err != nil {
+		t.Fatalf("unexpected error parsing simpleP2P output: %v", err)
+	}
+
+	if !simpleP2P.Passed || simpleP2P.Waived || simpleP2P.DeviceCount != 2 || simpleP2P.Bandwidth != 19.74 ||
+		len(simpleP2P.PeerAccess) != 2 || len(simpleP2P.PeerAccess.Unsupported()) != 0 {
+		t.Errorf("unexpected simpleP2P result %+v", simpleP2P)
+	}
+
+	simpleP2P, err = ParseSimpleP2P(readFixture(t, "simplep2p-waived.txt"))
+	if err != nil || !simpleP2P.Waived || simpleP2P.Passed || simpleP2P.DeviceCount != 1 {
+		t.Errorf("expected simpleP2P to be waived, got %+v, %v", simpleP2P, err)
+	}
+
+	simpleP2P, err = ParseSimpleP2P("CUDA-capable device count: 2\n" +
+		"> Peer access from Tesla T4 (GPU0) -> Tesla T4 (GPU1) : No\n" +
+		"> Peer access from Tesla T4 (GPU1) -> Tesla T4 (GPU0) : No\n" +
+		"Peer to Peer access is not available amongst GPUs in the system, waiving test.\n")
+	if err != nil || !simpleP2P.Waived {
+		t.Fatalf("expected simpleP2P to be waived, got %+v, %v", simpleP2P, err)
+	}
+
+	expectedLinks := []PeerLink{{From: 0, To: 1}, {From: 1, To: 0}}
+	if links := simpleP2P.PeerAccess.Unsupported(); !reflect.DeepEqual(links, expectedLinks) {
+		t.Errorf("unexpected unsupported links %v", links)
+	}
+}
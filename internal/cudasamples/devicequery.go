@@ -0,0 +1,141 @@
+package cudasamples
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	detectedRegexp   = regexp.MustCompile(`^Detected ([0-9]+) CUDA Capable device`)
+	deviceRegexp     = regexp.MustCompile(`^Device ([0-9]+): "(.*)"$`)
+	versionsRegexp   = regexp.MustCompile(`^CUDA Driver Version / Runtime Version\s+(\S+) / (\S+)$`)
+	capabilityRegexp = regexp.MustCompile(`^CUDA Capability Major/Minor version number:\s+([0-9]+\.[0-9]+)$`)
+	memoryRegexp     = regexp.MustCompile(`^Total amount of global memory:\s+([0-9]+) MBytes`)
+	smRegexp         = regexp.MustCompile(`^\(\s*([0-9]+)\) Multiprocessors`)
+	peerAccessRegexp = regexp.MustCompile(`^> Peer access from .* \(GPU([0-9]+)\) -> .* \(GPU([0-9]+)\) : (Yes|No)$`)
+	resultRegexp     = regexp.MustCompile(`^Result = (PASS|FAIL)$`)
+)
+
+// Device is a CUDA device reported by deviceQuery.
+type Device struct {
+	Index             int
+	Name              string
+	ComputeCapability string
+	GlobalMemoryMiB   int64
+	Multiprocessors   int
+}
+
+// PeerLink is a pair of GPUs, peer memory access going from the first to the second.
+type PeerLink struct {
+	From int
+	To   int
+}
+
+// PeerAccess is the peer to peer memory access support matrix of the GPUs of a node.
+type PeerAccess map[PeerLink]bool
+
+// DeviceQueryResult is the result of deviceQuery.
+type DeviceQueryResult struct {
+	DriverVersion  string
+	RuntimeVersion string
+	// DeviceCount is the number of CUDA devices deviceQuery detected.
+	DeviceCount int
+	Devices     []Device
+	PeerAccess  PeerAccess
+	Passed      bool
+}
+
+// ParseDeviceQuery parses the output of deviceQuery.
+func ParseDeviceQuery(output string) (*DeviceQueryResult, error) {
+	result := &DeviceQueryResult{PeerAccess: PeerAccess{}}
+	resultFound := false
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		if match := detectedRegexp.FindStringSubmatch(line); match != nil {
+			result.DeviceCount, _ = strconv.Atoi(match[1])
+
+			continue
+		}
+
+		if match := deviceRegexp.FindStringSubmatch(line); match != nil {
+			index, _ := strconv.Atoi(match[1])
+			result.Devices = append(result.Devices, Device{Index: index, Name: match[2]})
+
+			continue
+		}
+
+		if parsePeerAccess(line, result.PeerAccess) {
+			continue
+		}
+
+		if match := resultRegexp.FindStringSubmatch(line); match != nil {
+			result.Passed = match[1] == "PASS"
+			resultFound = true
+
+			continue
+		}
+
+		if len(result.Devices) == 0 {
+			continue
+		}
+
+		device := &result.Devices[len(result.Devices)-1]
+
+		if match := versionsRegexp.FindStringSubmatch(line); match != nil {
+			result.DriverVersion, result.RuntimeVersion = match[1], match[2]
+		} else if match := capabilityRegexp.FindStringSubmatch(line); match != nil {
+			device.ComputeCapability = match[1]
+		} else if match := memoryRegexp.FindStringSubmatch(line); match != nil {
+			device.GlobalMemoryMiB, _ = strconv.ParseInt(match[1], 10, 64)
+		} else if match := smRegexp.FindStringSubmatch(line); match != nil {
+			device.Multiprocessors, _ = strconv.Atoi(match[1])
+		}
+	}
+
+	if !resultFound {
+		return nil, fmt.Errorf("no result found in deviceQuery output")
+	}
+
+	return result, nil
+}
+
+// Unsupported returns the sorted links between GPUs without peer to peer memory access.
+func (access PeerAccess) Unsupported() []PeerLink {
+	var links []PeerLink
+
+	for link, supported := range access {
+		if !supported {
+			links = append(links, link)
+		}
+	}
+
+	sort.Slice(links, func(i, j int) bool {
+		if links[i].From != links[j].From {
+			return links[i].From < links[j].From
+		}
+
+		return links[i].To < links[j].To
+	})
+
+	return links
+}
+
+// parsePeerAccess records the support of a "> Peer access from ... (GPU0) -> ... (GPU1) : Yes" line in access.
+// It returns false if the line does not report a peer access.
+func parsePeerAccess(line string, access PeerAccess) bool {
+	match := peerAccessRegexp.FindStringSubmatch(line)
+	if match == nil {
+		return false
+	}
+
+	from, _ := strconv.Atoi(match[1])
+	to, _ := strconv.Atoi(match[2])
+	access[PeerLink{From: from, To: to}] = match[3] == "Yes"
+
+	return true
+}
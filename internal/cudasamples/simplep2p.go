@@ -0,0 +1,72 @@
+package cudasamples
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	deviceCountRegexp  = regexp.MustCompile(`^CUDA-capable device count: ([0-9]+)$`)
+	p2pBandwidthRegexp = regexp.MustCompile(`^cudaMemcpyPeer / cudaMemcpy between .*: ([0-9]+(?:\.[0-9]+)?)GB/s$`)
+)
+
+// P2PResult is the result of simpleP2P.
+type P2PResult struct {
+	DeviceCount int
+	PeerAccess  PeerAccess
+	// Bandwidth is the peer to peer copy bandwidth in GB/s, 0 if the test was waived.
+	Bandwidth float64
+	// Waived is true if the node has fewer than two GPUs or no GPUs with peer to peer memory access.
+	Waived bool
+	Passed bool
+}
+
+// ParseSimpleP2P parses the output of simpleP2P.
+func ParseSimpleP2P(output string) (*P2PResult, error) {
+	result := &P2PResult{PeerAccess: PeerAccess{}}
+	resultFound := false
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		if match := deviceCountRegexp.FindStringSubmatch(line); match != nil {
+			result.DeviceCount, _ = strconv.Atoi(match[1])
+
+			continue
+		}
+
+		if parsePeerAccess(line, result.PeerAccess) {
+			continue
+		}
+
+		if match := p2pBandwidthRegexp.FindStringSubmatch(line); match != nil {
+			bandwidth, err := strconv.ParseFloat(match[1], 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse simpleP2P bandwidth '%s': %w", match[1], err)
+			}
+
+			result.Bandwidth = bandwidth
+
+			continue
+		}
+
+		switch {
+		case strings.Contains(strings.ToLower(line), "waiving test"):
+			result.Waived = true
+			resultFound = true
+		case line == "Test passed":
+			result.Passed = true
+			resultFound = true
+		case strings.HasPrefix(line, "Test failed"):
+			resultFound = true
+		}
+	}
+
+	if !resultFound {
+		return nil, fmt.Errorf("no result found in simpleP2P output")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,35 @@
+package cudasamples
+
+import (
+	"fmt"
+	"strings"
+)
+
+// VectorAddResult is the result of vectorAdd.
+type VectorAddResult struct {
+	Passed bool
+	// Failures are the lines reporting a failed CUDA call or a wrong result.
+	Failures []string
+}
+
+// ParseVectorAdd parses the output of vectorAdd.
+func ParseVectorAdd(output string) (*VectorAddResult, error) {
+	result := &VectorAddResult{}
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		switch {
+		case line == "Test PASSED":
+			result.Passed = true
+		case strings.Contains(line, "Failed") || strings.Contains(line, "failed"):
+			result.Failures = append(result.Failures, line)
+		}
+	}
+
+	if !result.Passed && len(result.Failures) == 0 {
+		return nil, fmt.Errorf("no result found in vectorAdd output")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,366 @@
+package cudasamples
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/runsummary"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/workload"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DeviceQueryWorkload is the name the deviceQuery workload is registered by.
+	DeviceQueryWorkload = "cuda-device-query"
+	// VectorAddWorkload is the name the vectorAdd workload is registered by.
+	VectorAddWorkload = "cuda-vector-add"
+	// BandwidthTestWorkload is the name the bandwidthTest workload is registered by.
+	BandwidthTestWorkload = "cuda-bandwidth-test"
+	// SimpleP2PWorkload is the name the simpleP2P workload is registered by.
+	SimpleP2PWorkload = "cuda-simple-p2p"
+
+	// ContainerName is the name of the CUDA samples container.
+	ContainerName = "cuda-samples-ctr"
+	// ExitStatusMarker prefixes the exit status of the CUDA sample, printed after its output.
+	ExitStatusMarker = "CUDA_SAMPLE_EXIT_STATUS="
+	// SampleDuration is how long a CUDA sample runs at most.
+	SampleDuration = time.Minute
+
+	// exitWaived is the exit status of the CUDA samples waiving their test.
+	exitWaived = 2
+)
+
+var (
+	// WorkloadNames are the names of the CUDA samples workloads, in the order they are meant to run.
+	WorkloadNames = []string{DeviceQueryWorkload, VectorAddWorkload, BandwidthTestWorkload, SimpleP2PWorkload}
+
+	isFalse = false
+	isTrue  = true
+
+	samples = map[string]sample{
+		DeviceQueryWorkload:   {command: "deviceQuery", validate: validateDeviceQuery},
+		VectorAddWorkload:     {command: "vectorAdd", validate: validateVectorAdd},
+		BandwidthTestWorkload: {command: "bandwidthTest --device=all --memory=pinned", validate: validateBandwidthTest},
+		SimpleP2PWorkload:     {command: "simpleP2P", validate: validateSimpleP2P, waivable: true},
+	}
+)
+
+func init() {
+	for _, name := range WorkloadNames {
+		workload.Register(name, newFactory(name))
+	}
+}
+
+// Thresholds are the CUDA samples pass thresholds.
+type Thresholds struct {
+	// MinBandwidth is the minimum host to device and device to host bandwidth in GB/s, 0 disabling the check.
+	MinBandwidth float64
+	// RequireP2P requires peer to peer memory access between all the GPUs of a node.
+	RequireP2P bool
+}
+
+// Workload runs a CUDA sample on the GPUs of the target.
+type Workload struct {
+	// SampleName is the name the workload is registered by.
+	SampleName   string
+	Image        string
+	NodeSelector map[string]string
+	Thresholds   Thresholds
+}
+
+// sample is a CUDA sample and its output validation.
+type sample struct {
+	command  string
+	validate func(output string, thresholds Thresholds, expectedGPUs int) (*workload.Result, error)
+	// waivable is true if the sample may waive its test when the node lacks the hardware it needs.
+	waivable bool
+}
+
+// newFactory returns the factory of the CUDA sample workload registered by name.
+func newFactory(name string) workload.Factory {
+	return func(params workload.Params) (workload.GPUWorkload, error) {
+		if params.Config.CUDASamplesImage == "" {
+			return nil, errors.New("NVIDIAGPU_CUDA_SAMPLES_IMAGE is required to run the CUDA samples")
+		}
+
+		image, err := params.Images.Rewrite(params.Config.CUDASamplesImage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Workload{
+			SampleName:   name,
+			Image:        image,
+			NodeSelector: params.NodeSelector,
+			Thresholds: Thresholds{
+				MinBandwidth: params.Config.CUDASamplesMinBandwidth,
+				RequireP2P:   params.Config.CUDASamplesRequireP2P,
+			},
+		}, nil
+	}
+}
+
+// Name returns the name the CUDA sample workload is registered by.
+func (cuda *Workload) Name() string {
+	return cuda.SampleName
+}
+
+// ConfigMaps returns no ConfigMap, the CUDA samples being run from their image.
+func (cuda *Workload) ConfigMaps() map[string]map[string]string {
+	return nil
+}
+
+// Pod returns the pod running the CUDA sample on the GPUs of the target.
+func (cuda *Workload) Pod(namespace string, target workload.Target) (*corev1.Pod, error) {
+	cudaSample, found := samples[cuda.SampleName]
+	if !found {
+		return nil, fmt.Errorf("unknown CUDA sample workload '%s'", cuda.SampleName)
+	}
+
+	nodeSelector := map[string]string{}
+	for key, value := range cuda.NodeSelector {
+		nodeSelector[key] = value
+	}
+
+	if target.NodeName != "" {
+		nodeSelector[corev1.LabelHostname] = target.NodeName
+	}
+
+	gpus := strconv.Itoa(max(target.GPUs, 1))
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      target.Name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app": "cuda-samples-app",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsNonRoot:   &isTrue,
+				SeccompProfile: &corev1.SeccompProfile{Type: "RuntimeDefault"},
+			},
+			Tolerations: []corev1.Toleration{
+				{
+					Key:      "nvidia.com/gpu",
+					Effect:   corev1.TaintEffectNoSchedule,
+					Operator: corev1.TolerationOpExists,
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name:            ContainerName,
+					Image:           cuda.Image,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					SecurityContext: &corev1.SecurityContext{
+						AllowPrivilegeEscalation: &isFalse,
+						Capabilities: &corev1.Capabilities{
+							Drop: []corev1.Capability{
+								"ALL",
+							},
+						},
+					},
+					// The exit status is printed so that a failed sample still completes its pod.
+					Command: []string{"/bin/sh", "-c", fmt.Sprintf(`%s; echo "%s$?"`, cudaSample.command,
+						ExitStatusMarker)},
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							"nvidia.com/gpu": resource.MustParse(gpus),
+						},
+					},
+				},
+			},
+			NodeSelector: nodeSelector,
+		},
+	}, nil
+}
+
+// Container returns the name of the CUDA samples container.
+func (cuda *Workload) Container() string {
+	return ContainerName
+}
+
+// Duration returns how long the CUDA sample runs at most.
+func (cuda *Workload) Duration() time.Duration {
+	return SampleDuration
+}
+
+// Validate parses the output of the CUDA sample and checks it against the thresholds.
+func (cuda *Workload) Validate(output string, target workload.Target) (*workload.Result, error) {
+	cudaSample, found := samples[cuda.SampleName]
+	if !found {
+		return nil, fmt.Errorf("unknown CUDA sample workload '%s'", cuda.SampleName)
+	}
+
+	exitStatus, err := ParseExitStatus(output)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := cudaSample.validate(output, cuda.Thresholds, target.GPUs)
+	if err != nil {
+		return nil, err
+	}
+
+	if exitStatus != 0 && (exitStatus != exitWaived || !cudaSample.waivable) {
+		result.Problems = append(result.Problems, fmt.Sprintf("%s exited with status %d", cudaSample.command,
+			exitStatus))
+	}
+
+	return result, nil
+}
+
+// ParseExitStatus returns the exit status the CUDA sample pod printed after the sample output.
+func ParseExitStatus(output string) (int, error) {
+	index := strings.LastIndex(output, ExitStatusMarker)
+	if index < 0 {
+		return 0, fmt.Errorf("no exit status found in CUDA sample output")
+	}
+
+	fields := strings.Fields(output[index+len(ExitStatusMarker):])
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("empty exit status in CUDA sample output")
+	}
+
+	exitStatus, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse CUDA sample exit status '%s': %w", fields[0], err)
+	}
+
+	return exitStatus, nil
+}
+
+// validateDeviceQuery checks that deviceQuery passed and detected the expected GPUs.
+func validateDeviceQuery(output string, _ Thresholds, expectedGPUs int) (*workload.Result, error) {
+	deviceQuery, err := ParseDeviceQuery(output)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &workload.Result{}
+
+	if !deviceQuery.Passed {
+		result.Problems = append(result.Problems, "deviceQuery result is FAIL")
+	}
+
+	if expectedGPUs > 0 && deviceQuery.DeviceCount != expectedGPUs {
+		result.Problems = append(result.Problems, fmt.Sprintf("deviceQuery detected %d devices instead of %d",
+			deviceQuery.DeviceCount, expectedGPUs))
+	}
+
+	for _, device := range deviceQuery.Devices {
+		if device.ComputeCapability == "" {
+			result.Problems = append(result.Problems, fmt.Sprintf("deviceQuery reported no compute capability "+
+				"for device %d", device.Index))
+		}
+
+		result.Metrics = append(result.Metrics, runsummary.Metric{
+			Name:  "cuda-device-memory",
+			Value: float64(device.GlobalMemoryMiB),
+			Unit:  "MiB",
+			Labels: map[string]string{
+				"gpu":                strconv.Itoa(device.Index),
+				"product":            device.Name,
+				"compute_capability": device.ComputeCapability,
+			},
+		})
+	}
+
+	return result, nil
+}
+
+// validateVectorAdd checks that vectorAdd passed.
+func validateVectorAdd(output string, _ Thresholds, _ int) (*workload.Result, error) {
+	vectorAdd, err := ParseVectorAdd(output)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &workload.Result{}
+
+	if !vectorAdd.Passed || len(vectorAdd.Failures) > 0 {
+		result.Problems = append(result.Problems, fmt.Sprintf("vectorAdd failed: %s",
+			strings.Join(vectorAdd.Failures, "; ")))
+	}
+
+	return result, nil
+}
+
+// validateBandwidthTest checks that bandwidthTest passed and measured the minimum bandwidth.
+func validateBandwidthTest(output string, thresholds Thresholds, _ int) (*workload.Result, error) {
+	bandwidthTest, err := ParseBandwidthTest(output)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &workload.Result{}
+
+	if !bandwidthTest.Passed {
+		result.Problems = append(result.Problems, "bandwidthTest result is FAIL")
+	}
+
+	for _, direction := range []string{HostToDevice, DeviceToHost, DeviceToDevice} {
+		bandwidth := bandwidthTest.Bandwidths[direction]
+
+		switch {
+		case bandwidth <= 0:
+			result.Problems = append(result.Problems, fmt.Sprintf("bandwidthTest measured no %s bandwidth",
+				direction))
+		case direction != DeviceToDevice && bandwidth < thresholds.MinBandwidth:
+			result.Problems = append(result.Problems, fmt.Sprintf("bandwidthTest measured %g GB/s %s, less "+
+				"than %g GB/s", bandwidth, direction, thresholds.MinBandwidth))
+		}
+
+		result.Metrics = append(result.Metrics, runsummary.Metric{
+			Name:   "cuda-bandwidth",
+			Value:  bandwidth,
+			Unit:   "GB/s",
+			Labels: map[string]string{"direction": direction},
+		})
+	}
+
+	return result, nil
+}
+
+// validateSimpleP2P checks that simpleP2P passed, or was waived on a single GPU or when peer to peer access is not
+// required.
+func validateSimpleP2P(output string, thresholds Thresholds, _ int) (*workload.Result, error) {
+	simpleP2P, err := ParseSimpleP2P(output)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &workload.Result{}
+
+	switch {
+	case simpleP2P.Waived && thresholds.RequireP2P && simpleP2P.DeviceCount > 1:
+		result.Problems = append(result.Problems, fmt.Sprintf("simpleP2P was waived on %d devices",
+			simpleP2P.DeviceCount))
+	case !simpleP2P.Waived && !simpleP2P.Passed:
+		result.Problems = append(result.Problems, "simpleP2P failed")
+	}
+
+	if thresholds.RequireP2P {
+		for _, link := range simpleP2P.PeerAccess.Unsupported() {
+			result.Problems = append(result.Problems, fmt.Sprintf("no peer access from GPU %d to GPU %d",
+				link.From, link.To))
+		}
+	}
+
+	if simpleP2P.Bandwidth > 0 {
+		result.Metrics = append(result.Metrics, runsummary.Metric{
+			Name:  "cuda-p2p-bandwidth",
+			Value: simpleP2P.Bandwidth,
+			Unit:  "GB/s",
+		})
+	}
+
+	return result, nil
+}
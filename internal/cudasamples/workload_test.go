@@ -0,0 +1,78 @@
+package cudasamples
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/nvidiagpuconfig"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/workload"
+)
+
+func TestWorkload(t *testing.T) {
+	config := &nvidiagpuconfig.NvidiaGPUConfig{}
+
+	if _, err := workload.New(DeviceQueryWorkload, workload.Params{Config: config}); err == nil {
+		t.Errorf("expected error building a CUDA sample workload without image")
+	}
+
+	config.CUDASamplesImage = "quay.io/example/cuda-samples:12.4"
+	config.CUDASamplesMinBandwidth = 20
+
+	bandwidthWorkload, err := workload.New(BandwidthTestWorkload, workload.Params{Config: config})
+	if err != nil {
+		t.Fatalf("unexpected error building the bandwidthTest workload: %v", err)
+	}
+
+	target := workload.Target{Name: "cuda-bandwidth-test-0", NodeName: "worker-0", GPUs: 2}
+
+	bandwidthPod, err := bandwidthWorkload.Pod("test-cuda-samples", target)
+	if err != nil {
+		t.Fatalf("unexpected error rendering the bandwidthTest pod: %v", err)
+	}
+
+	container := bandwidthPod.Spec.Containers[0]
+	limit := container.Resources.Limits["nvidia.com/gpu"]
+
+	if bandwidthPod.Spec.NodeSelector["kubernetes.io/hostname"] != "worker-0" || limit.Value() != 2 ||
+		!strings.HasPrefix(container.Command[2], "bandwidthTest --device=all") {
+		t.Errorf("unexpected bandwidthTest pod %+v", bandwidthPod.Spec)
+	}
+
+	result, err := bandwidthWorkload.Validate(readFixture(t, "bandwidthtest-a10g.txt"), target)
+	if err != nil {
+		t.Fatalf("unexpected error validating the bandwidthTest output: %v", err)
+	}
+
+	if len(result.Problems) != 2 || len(result.Metrics) != 3 {
+		t.Errorf("expected the host to device and device to host bandwidths below 20 GB/s, got %+v", result)
+	}
+
+	for _, test := range []struct {
+		name     string
+		fixture  string
+		gpus     int
+		problems int
+	}{
+		{name: DeviceQueryWorkload, fixture: "devicequery-a100x2.txt", gpus: 2},
+		{name: DeviceQueryWorkload, fixture: "devicequery-a100x2.txt", gpus: 4, problems: 1},
+		{name: DeviceQueryWorkload, fixture: "devicequery-nodevice.txt", gpus: 1, problems: 3},
+		{name: VectorAddWorkload, fixture: "vectoradd.txt", gpus: 1},
+		{name: SimpleP2PWorkload, fixture: "simplep2p-a100x2.txt", gpus: 2},
+		{name: SimpleP2PWorkload, fixture: "simplep2p-waived.txt", gpus: 1},
+	} {
+		gpuWorkload, err := workload.New(test.name, workload.Params{Config: config})
+		if err != nil {
+			t.Fatalf("unexpected error building the %s workload: %v", test.name, err)
+		}
+
+		result, err := gpuWorkload.Validate(readFixture(t, test.fixture), workload.Target{GPUs: test.gpus})
+		if err != nil {
+			t.Fatalf("unexpected error validating %s: %v", test.fixture, err)
+		}
+
+		if len(result.Problems) != test.problems {
+			t.Errorf("expected %d problems validating %s on %d GPUs, got %v", test.problems, test.fixture,
+				test.gpus, result.Problems)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package dcgm
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Sample is a single sample of the Prometheus text exposition format.
+type Sample struct {
+	Name   string
+	Labels map[string]string
+	Value  float64
+}
+
+// ParseText parses the samples of the Prometheus text exposition format, skipping the comments and the
+// HELP and TYPE lines. The sample timestamps are ignored.
+func ParseText(text string) ([]Sample, error) {
+	var samples []Sample
+
+	for lineNumber, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		sample, err := parseSample(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber+1, err)
+		}
+
+		samples = append(samples, sample)
+	}
+
+	return samples, nil
+}
+
+// parseSample parses a sample line made of the metric name, optional labels, value and optional timestamp.
+func parseSample(line string) (Sample, error) {
+	sample := Sample{Labels: map[string]string{}}
+
+	nameEnd := strings.IndexAny(line, "{ \t")
+	if nameEnd <= 0 {
+		return sample, fmt.Errorf("sample '%s' has no value", line)
+	}
+
+	sample.Name = line[:nameEnd]
+	rest := line[nameEnd:]
+
+	if strings.HasPrefix(rest, "{") {
+		var err error
+
+		rest, err = parseLabels(rest[1:], sample.Labels)
+		if err != nil {
+			return sample, fmt.Errorf("invalid labels of sample %s: %w", sample.Name, err)
+		}
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 || len(fields) > 2 {
+		return sample, fmt.Errorf("sample %s must have a value and an optional timestamp", sample.Name)
+	}
+
+	value, err := parseValue(fields[0])
+	if err != nil {
+		return sample, fmt.Errorf("invalid value of sample %s: %w", sample.Name, err)
+	}
+
+	sample.Value = value
+
+	return sample, nil
+}
+
+// parseLabels parses the labels following the opening brace into labels and returns the rest of the line.
+func parseLabels(text string, labels map[string]string) (string, error) {
+	for {
+		text = strings.TrimLeft(text, " \t")
+		if strings.HasPrefix(text, "}") {
+			return text[1:], nil
+		}
+
+		equal := strings.Index(text, "=")
+		if equal <= 0 || len(text) < equal+2 || text[equal+1] != '"' {
+			return "", fmt.Errorf("label '%s' is not name=\"value\"", text)
+		}
+
+		name := strings.TrimSpace(text[:equal])
+		text = text[equal+2:]
+
+		var value strings.Builder
+
+		closed := false
+
+		for index := 0; index < len(text); index++ {
+			switch char := text[index]; {
+			case char == '\\' && index+1 < len(text):
+				index++
+
+				switch text[index] {
+				case 'n':
+					value.WriteByte('\n')
+				default:
+					value.WriteByte(text[index])
+				}
+			case char == '"':
+				text = text[index+1:]
+				closed = true
+			default:
+				value.WriteByte(char)
+			}
+
+			if closed {
+				break
+			}
+		}
+
+		if !closed {
+			return "", fmt.Errorf("label %s value is not closed", name)
+		}
+
+		labels[name] = value.String()
+
+		text = strings.TrimLeft(text, " \t")
+		text = strings.TrimPrefix(text, ",")
+	}
+}
+
+// parseValue parses a sample value, including the NaN and infinite values.
+func parseValue(text string) (float64, error) {
+	switch text {
+	case "NaN":
+		return math.NaN(), nil
+	case "+Inf":
+		return math.Inf(1), nil
+	case "-Inf":
+		return math.Inf(-1), nil
+	default:
+		return strconv.ParseFloat(text, 64)
+	}
+}
@@ -0,0 +1,70 @@
+package dcgm
+
+import (
+	"math"
+	"testing"
+)
+
+const testExposition = `# HELP DCGM_FI_DEV_GPU_UTIL GPU utilization (in %).
+# TYPE DCGM_FI_DEV_GPU_UTIL gauge
+DCGM_FI_DEV_GPU_UTIL{gpu="0",UUID="GPU-5c89852c",Hostname="worker-0",pod="gpu-burn",namespace="gpu-burn-ns"} 97
+DCGM_FI_DEV_GPU_UTIL{gpu="1",UUID="GPU-1d4a0b6e",device="nvidia1",modelName="NVIDIA A10G",Hostname="worker-1"} 0
+# HELP DCGM_FI_DEV_FB_USED Framebuffer memory used (in MiB).
+# TYPE DCGM_FI_DEV_FB_USED gauge
+DCGM_FI_DEV_FB_USED{gpu="0",UUID="GPU-5c89852c",Hostname="worker-0",pod="gpu-burn",namespace="gpu-burn-ns"} 21504
+DCGM_FI_DEV_FB_USED{gpu="1",UUID="GPU-1d4a0b6e",Hostname="worker-1"} 0
+# HELP DCGM_FI_DEV_GPU_TEMP GPU temperature (in C).
+# TYPE DCGM_FI_DEV_GPU_TEMP gauge
+DCGM_FI_DEV_GPU_TEMP{gpu="0",UUID="GPU-5c89852c",Hostname="worker-0",pod="gpu-burn",namespace="gpu-burn-ns"} 71
+DCGM_FI_DEV_GPU_TEMP{gpu="1",UUID="GPU-1d4a0b6e",Hostname="worker-1"} 32
+# HELP DCGM_FI_DEV_POWER_USAGE Power draw (in W).
+# TYPE DCGM_FI_DEV_POWER_USAGE gauge
+DCGM_FI_DEV_POWER_USAGE{gpu="0",UUID="GPU-5c89852c",Hostname="worker-0",pod="gpu-burn",namespace="gpu-burn-ns"} 298.4
+DCGM_FI_DEV_POWER_USAGE{gpu="1",UUID="GPU-1d4a0b6e",Hostname="worker-1"} 26.5 1712345678000
+`
+
+func TestParseText(t *testing.T) {
+	samples, err := ParseText(testExposition)
+	if err != nil {
+		t.Fatalf("unexpected error parsing exposition text: %v", err)
+	}
+
+	if len(samples) != 8 {
+		t.Fatalf("expected 8 samples, got %d", len(samples))
+	}
+
+	first := samples[0]
+	if first.Name != GPUUtilMetric || first.Value != 97 ||
+		first.Labels[HostnameLabel] != "worker-0" {
+		t.Errorf("unexpected first sample %+v", first)
+	}
+
+	if last := samples[len(samples)-1]; last.Value != 26.5 {
+		t.Errorf("expected the timestamp to be ignored, got value %g", last.Value)
+	}
+}
+
+func TestParseTextValues(t *testing.T) {
+	samples, err := ParseText("up 1\nnan_metric NaN\ninf_metric{le=\"+Inf\"} +Inf\n" +
+		"escaped{path=\"C:\\\\dir\",quote=\"say \\\"hi\\\"\",multi=\"a\\nb\",} -1.5e3\n")
+	if err != nil {
+		t.Fatalf("unexpected error parsing exposition text: %v", err)
+	}
+
+	if len(samples) != 4 || samples[0].Value != 1 || len(samples[0].Labels) != 0 ||
+		!math.IsNaN(samples[1].Value) || !math.IsInf(samples[2].Value, 1) || samples[2].Labels["le"] != "+Inf" {
+		t.Fatalf("unexpected samples %+v", samples)
+	}
+
+	escaped := samples[3]
+	if escaped.Value != -1500 || escaped.Labels["path"] != `C:\dir` || escaped.Labels["quote"] != `say "hi"` ||
+		escaped.Labels["multi"] != "a\nb" {
+		t.Errorf("unexpected escaped sample %+v", escaped)
+	}
+
+	for _, invalid := range []string{"no_value", "bad_value abc", "unclosed{gpu=\"0} 1", "bad_label{gpu=0} 1"} {
+		if _, err := ParseText(invalid); err == nil {
+			t.Errorf("expected error parsing '%s'", invalid)
+		}
+	}
+}
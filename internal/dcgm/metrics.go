@@ -0,0 +1,226 @@
+package dcgm
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ExporterService is the name of the DCGM exporter service the GPU operator creates.
+	ExporterService = "nvidia-dcgm-exporter"
+	// ExporterPodLabel selects the DCGM exporter pods.
+	ExporterPodLabel = "app=nvidia-dcgm-exporter"
+	// ExporterPort is the port the DCGM exporter serves the metrics on.
+	ExporterPort = "9400"
+	// MetricsPath is the path the DCGM exporter serves the metrics on.
+	MetricsPath = "/metrics"
+
+	// GPUUtilMetric is the GPU utilization in percent.
+	GPUUtilMetric = "DCGM_FI_DEV_GPU_UTIL"
+	// FBUsedMetric is the used frame buffer memory in MiB.
+	FBUsedMetric = "DCGM_FI_DEV_FB_USED"
+	// GPUTempMetric is the GPU temperature in degrees Celsius.
+	GPUTempMetric = "DCGM_FI_DEV_GPU_TEMP"
+	// PowerUsageMetric is the GPU power draw in watts.
+	PowerUsageMetric = "DCGM_FI_DEV_POWER_USAGE"
+
+	// UUIDLabel is the label holding the UUID of the GPU of a DCGM metric.
+	UUIDLabel = "UUID"
+	// HostnameLabel is the label holding the node name of a DCGM metric.
+	HostnameLabel = "Hostname"
+	// PodLabel is the label holding the name of the pod the GPU of a DCGM metric is allocated to.
+	PodLabel = "pod"
+	// NamespaceLabel is the label holding the namespace of the pod the GPU of a DCGM metric is allocated to.
+	NamespaceLabel = "namespace"
+)
+
+var logger = logging.Logger(logging.GPU)
+
+// MetricRange is the range of sane values of a DCGM metric.
+type MetricRange struct {
+	Name string
+	Min  float64
+	Max  float64
+}
+
+var (
+	// CoreMetrics are the sane ranges of the core metrics every GPU must export.
+	CoreMetrics = []MetricRange{
+		{Name: GPUUtilMetric, Min: 0, Max: 100},
+		{Name: FBUsedMetric, Min: 0, Max: math.MaxFloat64},
+		{Name: GPUTempMetric, Min: 1, Max: 120},
+		{Name: PowerUsageMetric, Min: 1, Max: 2000},
+	}
+
+	// BusyMetrics are the sane ranges of the core metrics of a GPU running a workload such as gpu-burn.
+	BusyMetrics = []MetricRange{
+		{Name: GPUUtilMetric, Min: 1, Max: 100},
+		{Name: FBUsedMetric, Min: 1, Max: math.MaxFloat64},
+		{Name: GPUTempMetric, Min: 1, Max: 120},
+		{Name: PowerUsageMetric, Min: 1, Max: 2000},
+	}
+)
+
+// GPUMetrics returns the values of the DCGM samples keyed by GPU UUID and metric name. The samples without UUID
+// label are skipped.
+func GPUMetrics(samples []Sample) map[string]map[string]float64 {
+	gpus := make(map[string]map[string]float64)
+
+	for _, sample := range samples {
+		uuid := sample.Labels[UUIDLabel]
+		if uuid == "" {
+			continue
+		}
+
+		if gpus[uuid] == nil {
+			gpus[uuid] = make(map[string]float64)
+		}
+
+		gpus[uuid][sample.Name] = sample.Value
+	}
+
+	return gpus
+}
+
+// FilterLabels returns the samples having all the given label values.
+func FilterLabels(samples []Sample, labels map[string]string) []Sample {
+	var filtered []Sample
+
+	for _, sample := range samples {
+		matches := true
+
+		for name, value := range labels {
+			if sample.Labels[name] != value {
+				matches = false
+
+				break
+			}
+		}
+
+		if matches {
+			filtered = append(filtered, sample)
+		}
+	}
+
+	return filtered
+}
+
+// CheckMetrics returns the problems of the samples: no GPU found, or a GPU missing one of the metrics or with a
+// value out of its range.
+func CheckMetrics(samples []Sample, ranges []MetricRange) []string {
+	gpus := GPUMetrics(samples)
+	if len(gpus) == 0 {
+		return []string{"no GPU metric found"}
+	}
+
+	uuids := make([]string, 0, len(gpus))
+	for uuid := range gpus {
+		uuids = append(uuids, uuid)
+	}
+
+	sort.Strings(uuids)
+
+	var problems []string
+
+	for _, uuid := range uuids {
+		for _, metricRange := range ranges {
+			value, found := gpus[uuid][metricRange.Name]
+
+			switch {
+			case !found:
+				problems = append(problems, fmt.Sprintf("GPU %s has no %s metric", uuid, metricRange.Name))
+			case math.IsNaN(value) || value < metricRange.Min || value > metricRange.Max:
+				problems = append(problems, fmt.Sprintf("GPU %s metric %s value %g is out of range [%g, %g]", uuid,
+					metricRange.Name, value, metricRange.Min, metricRange.Max))
+			}
+		}
+	}
+
+	return problems
+}
+
+// CheckGPUCount returns the problems of the samples of a node with the given number of GPUs: a metric reported by
+// another number of GPUs.
+func CheckGPUCount(samples []Sample, ranges []MetricRange, count int) []string {
+	gpus := GPUMetrics(samples)
+
+	var problems []string
+
+	for _, metricRange := range ranges {
+		reported := 0
+
+		for _, metrics := range gpus {
+			if _, found := metrics[metricRange.Name]; found {
+				reported++
+			}
+		}
+
+		if reported != count {
+			problems = append(problems, fmt.Sprintf("metric %s is reported by %d GPUs instead of %d",
+				metricRange.Name, reported, count))
+		}
+	}
+
+	return problems
+}
+
+// Scrape returns the samples of the DCGM exporter service of the namespace, reached through the API server
+// service proxy.
+func Scrape(apiClient *clients.Settings, namespace string) ([]Sample, error) {
+	return ScrapeWithContext(context.TODO(), apiClient, namespace)
+}
+
+// ScrapeWithContext is the context-aware variant of Scrape.
+func ScrapeWithContext(ctx context.Context, apiClient *clients.Settings, namespace string) ([]Sample, error) {
+	logger.V(logging.LevelDebug).Info("Scraping DCGM exporter service", "name", ExporterService,
+		"namespace", namespace)
+
+	body, err := apiClient.Services(namespace).ProxyGet("http", ExporterService, ExporterPort, MetricsPath, nil).
+		DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scrape service %s in namespace %s: %w", ExporterService, namespace, err)
+	}
+
+	return ParseText(string(body))
+}
+
+// ScrapeNode returns the samples of the DCGM exporter pod running on the node, reached through the API server pod
+// proxy.
+func ScrapeNode(apiClient *clients.Settings, namespace, nodeName string) ([]Sample, error) {
+	return ScrapeNodeWithContext(context.TODO(), apiClient, namespace, nodeName)
+}
+
+// ScrapeNodeWithContext is the context-aware variant of ScrapeNode.
+func ScrapeNodeWithContext(ctx context.Context, apiClient *clients.Settings, namespace,
+	nodeName string) ([]Sample, error) {
+	pods, err := apiClient.Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: ExporterPodLabel})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DCGM exporter pods in namespace %s: %w", namespace, err)
+	}
+
+	for _, exporterPod := range pods.Items {
+		if exporterPod.Spec.NodeName != nodeName || exporterPod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+
+		logger.V(logging.LevelDebug).Info("Scraping DCGM exporter pod", "name", exporterPod.Name,
+			"namespace", namespace, "node", nodeName)
+
+		body, err := apiClient.Pods(namespace).ProxyGet("http", exporterPod.Name, ExporterPort, MetricsPath, nil).
+			DoRaw(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scrape pod %s in namespace %s: %w", exporterPod.Name, namespace, err)
+		}
+
+		return ParseText(string(body))
+	}
+
+	return nil, fmt.Errorf("no running DCGM exporter pod found on node %s", nodeName)
+}
@@ -0,0 +1,68 @@
+package dcgm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckMetrics(t *testing.T) {
+	samples, err := ParseText(testExposition)
+	if err != nil {
+		t.Fatalf("unexpected error parsing exposition text: %v", err)
+	}
+
+	if problems := CheckMetrics(samples, CoreMetrics); len(problems) != 0 {
+		t.Errorf("unexpected problems %v", problems)
+	}
+
+	busySamples := FilterLabels(samples, map[string]string{HostnameLabel: "worker-0"})
+	if problems := CheckMetrics(busySamples, BusyMetrics); len(problems) != 0 {
+		t.Errorf("unexpected busy GPU problems %v", problems)
+	}
+
+	problems := CheckMetrics(FilterLabels(samples, map[string]string{HostnameLabel: "worker-1"}), BusyMetrics)
+	if len(problems) != 2 || !strings.Contains(problems[0], GPUUtilMetric) ||
+		!strings.Contains(problems[1], FBUsedMetric) {
+		t.Errorf("expected idle GPU utilization and memory problems, got %v", problems)
+	}
+
+	burnSamples := FilterLabels(samples, map[string]string{PodLabel: "gpu-burn", NamespaceLabel: "gpu-burn-ns"})
+	if len(burnSamples) != 4 || len(CheckMetrics(burnSamples, BusyMetrics)) != 0 {
+		t.Errorf("expected the busy gpu-burn pod samples, got %+v", burnSamples)
+	}
+
+	noSamples := FilterLabels(samples, map[string]string{HostnameLabel: "worker-2"})
+	if problems := CheckMetrics(noSamples, CoreMetrics); len(problems) != 1 {
+		t.Errorf("expected a no GPU problem, got %v", problems)
+	}
+
+	withoutPower := make([]Sample, 0, len(samples))
+
+	for _, sample := range samples {
+		if sample.Name != PowerUsageMetric {
+			withoutPower = append(withoutPower, sample)
+		}
+	}
+
+	if problems := CheckMetrics(withoutPower, CoreMetrics); len(problems) != 2 ||
+		!strings.Contains(problems[0], "has no "+PowerUsageMetric) {
+		t.Errorf("expected missing power metric problems, got %v", problems)
+	}
+}
+
+func TestCheckGPUCount(t *testing.T) {
+	samples, err := ParseText(testExposition)
+	if err != nil {
+		t.Fatalf("unexpected error parsing exposition text: %v", err)
+	}
+
+	nodeSamples := FilterLabels(samples, map[string]string{HostnameLabel: "worker-0"})
+	if problems := CheckGPUCount(nodeSamples, CoreMetrics, 1); len(problems) != 0 {
+		t.Errorf("unexpected problems %v", problems)
+	}
+
+	if problems := CheckGPUCount(nodeSamples, CoreMetrics, 2); len(problems) != len(CoreMetrics) ||
+		!strings.Contains(problems[0], "reported by 1 GPUs instead of 2") {
+		t.Errorf("expected a problem per core metric, got %v", problems)
+	}
+}
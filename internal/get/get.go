@@ -2,9 +2,8 @@ package get
 
 import (
 	"fmt"
-	"github.com/golang/glog"
-	"github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nodes"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/olm"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/pod"
@@ -12,22 +11,23 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 )
 
+var logger = logging.Logger(logging.GPU)
+
 // InstalledCSVFromSubscription returns installedCSV from Subscription.
 func InstalledCSVFromSubscription(apiClient *clients.Settings, gpuSubscriptionName,
 	gpuSubscriptionNamespace string) (string, error) {
 	subPulled, err := olm.PullSubscription(apiClient, gpuSubscriptionName, gpuSubscriptionNamespace)
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof(
-			"error pulling Subscription %s from cluster in namespace %s", gpuSubscriptionName,
-			gpuSubscriptionNamespace)
+		logger.V(logging.LevelDebug).Info("Error pulling Subscription from cluster",
+			"subscription", gpuSubscriptionName, "namespace", gpuSubscriptionNamespace)
 
 		return "", err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof(
-		"InstalledCSV %s extracted from Subscription %s from cluster in namespace %s",
-		subPulled.Object.Status.InstalledCSV, gpuSubscriptionName, gpuSubscriptionNamespace)
+	logger.V(logging.LevelDebug).Info("InstalledCSV extracted from Subscription",
+		"installedCSV", subPulled.Object.Status.InstalledCSV, "subscription", gpuSubscriptionName,
+		"namespace", gpuSubscriptionNamespace)
 
 	return subPulled.Object.Status.InstalledCSV, nil
 }
@@ -38,16 +38,15 @@ func CurrentCSVFromSubscription(apiClient *clients.Settings, gpuSubscriptionName
 	subPulled, err := olm.PullSubscription(apiClient, gpuSubscriptionName, gpuSubscriptionNamespace)
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof(
-			"error pulling Subscription %s from cluster in namespace %s", gpuSubscriptionName,
-			gpuSubscriptionNamespace)
+		logger.V(logging.LevelDebug).Info("Error pulling Subscription from cluster",
+			"subscription", gpuSubscriptionName, "namespace", gpuSubscriptionNamespace)
 
 		return "", err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof(
-		"CurrentCSV %s extracted from Subscription %s from cluster in namespace %s",
-		subPulled.Object.Status.CurrentCSV, gpuSubscriptionName, gpuSubscriptionNamespace)
+	logger.V(logging.LevelDebug).Info("CurrentCSV extracted from Subscription",
+		"currentCSV", subPulled.Object.Status.CurrentCSV, "subscription", gpuSubscriptionName,
+		"namespace", gpuSubscriptionNamespace)
 
 	return subPulled.Object.Status.CurrentCSV, nil
 }
@@ -56,9 +55,8 @@ func CurrentCSVFromSubscription(apiClient *clients.Settings, gpuSubscriptionName
 func GetFirstPodNameWithLabel(apiClient *clients.Settings, podNamespace, podLabelSelector string) (string, error) {
 	podList, err := pod.List(apiClient, podNamespace, v1.ListOptions{LabelSelector: podLabelSelector})
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Length of podList matching podLabelSelector is '%v'", len(podList))
-	glog.V(gpuparams.GpuLogLevel).Infof("podList[0] matching podLabelSelector is '%v'",
-		podList[0].Definition.Name)
+	logger.V(logging.LevelDebug).Info("Listed pods matching podLabelSelector", "count", len(podList))
+	logger.V(logging.LevelDebug).Info("First pod matching podLabelSelector", "name", podList[0].Definition.Name)
 
 	return podList[0].Definition.Name, err
 }
@@ -70,7 +68,7 @@ func GetClusterArchitecture(apiClient *clients.Settings, nodeSelector map[string
 	// Check if at least one node matching the nodeSelector has the specific nodeLabel label set to true
 	// For example, look in all the worker nodes for specific label
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("could not discover %v nodes", nodeSelector)
+		logger.V(logging.LevelDebug).Info("Could not discover nodes", "nodeSelector", nodeSelector)
 
 		return "", err
 	}
@@ -81,8 +79,8 @@ func GetClusterArchitecture(apiClient *clients.Settings, nodeSelector map[string
 		labelValue, ok := node.Object.Labels[nodeLabel]
 
 		if ok {
-			glog.V(gpuparams.GpuLogLevel).Infof("Found label '%v' with label value '%v' on node '%v'",
-				nodeLabel, labelValue, node.Object.Name)
+			logger.V(logging.LevelDebug).Info("Found label with label value on node",
+				"label", nodeLabel, "labelValue", labelValue, "node", node.Object.Name)
 
 			return labelValue, nil
 		}
@@ -1,12 +1,15 @@
 package gpuburn
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/golang/glog"
-	"github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/configmap"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiasmi"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -14,59 +17,155 @@ import (
 
 // github.com/rh-ecosystem-edge/nvidia-ci/tests
 
+const (
+	// DefaultSeconds is how long gpu-burn loads the GPUs by default.
+	DefaultSeconds = 300
+	// HostnameLabel is the node label used to run a gpu-burn pod on a given node.
+	HostnameLabel = corev1.LabelHostname
+	// ExpectedGPUsEnv is the environment variable holding the number of GPUs the entrypoint expects.
+	ExpectedGPUsEnv = "EXPECTED_GPUS"
+	// EntrypointConfigMapName is the name of the ConfigMap holding the gpu-burn entrypoint.
+	EntrypointConfigMapName = "gpu-burn-entrypoint"
+	// ContainerName is the name of the gpu-burn container.
+	ContainerName = "gpu-burn-ctr"
+)
+
 var (
 	isFalse bool = false
 	isTrue  bool = true
 
-	gpuBurnConfigMapData = map[string]string{
-		"entrypoint.sh": `#!/bin/bash
-		NUM_GPUS=$(nvidia-smi -L | wc -l)
+	logger = logging.Logger(logging.GPU)
+
+	// gpuBurnEntrypoint counts the GPUs of the container with the nvidia-smi XML query, whose attached GPUs do not
+	// include the MIG devices nvidia-smi -L lists.
+	gpuBurnEntrypoint = `#!/bin/bash
+		NUM_GPUS=$(%s | sed -n 's:.*<attached_gpus>\(.*\)</attached_gpus>.*:\1:p')
 		if [ $NUM_GPUS -eq 0 ]; then
   			echo "ERROR No GPUs found"
 			exit 1
 		fi
-		./gpu_burn 300
+		if [ $NUM_GPUS -ne $%s ]; then
+			echo "ERROR Found $NUM_GPUS GPUs instead of $%s"
+			exit 1
+		fi
+		./gpu_burn %s
 
 		if [ ! $? -eq 0 ]; then
 		  exit 1
-		fi`,
-	}
+		fi`
 )
 
-// CreateGPUBurnConfigMap returns a configmap with data field populated.
+// Config parameterises the gpu-burn workload and the nodes it runs on.
+type Config struct {
+	// Seconds is how long gpu-burn loads the GPUs.
+	Seconds int
+	// GPUs is the number of GPUs each gpu-burn pod requests.
+	GPUs int
+	// TensorCores makes gpu-burn use the tensor cores.
+	TensorCores bool
+	// DoublePrecision makes gpu-burn use double precision floating point operations.
+	DoublePrecision bool
+	// NodeSelector selects the nodes the gpu-burn pods run on.
+	NodeSelector map[string]string
+}
+
+// NewDefaultConfig returns the configuration of a 300 seconds burn of a single GPU of any GPU worker node.
+func NewDefaultConfig() Config {
+	return Config{
+		Seconds: DefaultSeconds,
+		GPUs:    1,
+		NodeSelector: map[string]string{
+			"nvidia.com/gpu.present":         "true",
+			"node-role.kubernetes.io/worker": "",
+		},
+	}
+}
+
+// Validate checks that the gpu-burn configuration is consistent.
+func (config Config) Validate() error {
+	if config.Seconds < 1 {
+		return fmt.Errorf("gpu-burn duration must be at least 1 second, got %d", config.Seconds)
+	}
+
+	if config.GPUs < 1 {
+		return fmt.Errorf("gpu-burn pods must request at least 1 GPU, got %d", config.GPUs)
+	}
+
+	return nil
+}
+
+// Args returns the gpu_burn command line arguments.
+func (config Config) Args() []string {
+	var args []string
+
+	if config.TensorCores {
+		args = append(args, "-tc")
+	}
+
+	if config.DoublePrecision {
+		args = append(args, "-d")
+	}
+
+	return append(args, strconv.Itoa(config.Seconds))
+}
+
+// OnNode returns a copy of the configuration running on the given node and requesting the given number of GPUs.
+func (config Config) OnNode(nodeName string, gpus int) Config {
+	nodeSelector := map[string]string{HostnameLabel: nodeName}
+
+	for key, value := range config.NodeSelector {
+		nodeSelector[key] = value
+	}
+
+	config.NodeSelector = nodeSelector
+	config.GPUs = gpus
+
+	return config
+}
+
+// CreateGPUBurnConfigMap returns a configmap with data field populated with the entrypoint running gpu-burn
+// with the given configuration.
 func CreateGPUBurnConfigMap(apiClient *clients.Settings,
-	configMapName, configMapNamespace string) (*corev1.ConfigMap, error) {
+	configMapName, configMapNamespace string, config Config) (*corev1.ConfigMap, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
 	configMapBuilder := configmap.NewBuilder(apiClient, configMapName, configMapNamespace)
 
-	configMapBuilderWithData := configMapBuilder.WithData(gpuBurnConfigMapData)
+	configMapBuilderWithData := configMapBuilder.WithData(entrypointData(config))
 
 	createdConfigMapBuilderWithData, err := configMapBuilderWithData.Create()
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof(
-			"error creating ConfigMap with Data named %s and for namespace %s",
-			createdConfigMapBuilderWithData.Object.Name, createdConfigMapBuilderWithData.Object.Namespace)
+		logger.V(logging.LevelDebug).Info("Error creating ConfigMap with Data",
+			"name", createdConfigMapBuilderWithData.Object.Name,
+			"namespace", createdConfigMapBuilderWithData.Object.Namespace)
 
 		return nil, err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof(
-		"Created ConfigMap with Data named %s and for namespace %s",
-		createdConfigMapBuilderWithData.Object.Name, createdConfigMapBuilderWithData.Object.Namespace)
+	logger.V(logging.LevelDebug).Info("Created ConfigMap with Data",
+		"name", createdConfigMapBuilderWithData.Object.Name,
+		"namespace", createdConfigMapBuilderWithData.Object.Namespace)
 
 	return createdConfigMapBuilderWithData.Object, nil
 }
 
-// CreateGPUBurnPod returns a Pod after it is Ready after a timeout periods.
+// CreateGPUBurnPod returns a Pod requesting the GPUs and running on the nodes of the given configuration.
 func CreateGPUBurnPod(apiClient *clients.Settings, podName, podNamespace string,
-	gpuBurnImage string, timeout time.Duration) (*corev1.Pod, error) {
+	gpuBurnImage string, config Config, timeout time.Duration) (*corev1.Pod, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
 	var volumeDefaultMode int32 = 0777
 
 	configMapVolumeSource := &corev1.ConfigMapVolumeSource{}
-	configMapVolumeSource.Name = "gpu-burn-entrypoint"
+	configMapVolumeSource.Name = EntrypointConfigMapName
 	configMapVolumeSource.DefaultMode = &volumeDefaultMode
 
-	var err error = nil
+	gpus := strconv.Itoa(config.GPUs)
 
 	return &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -104,13 +203,19 @@ func CreateGPUBurnPod(apiClient *clients.Settings, podName, podNamespace string,
 							},
 						},
 					},
-					Name: "gpu-burn-ctr",
+					Name: ContainerName,
 					Command: []string{
 						"/bin/entrypoint.sh",
 					},
+					Env: []corev1.EnvVar{
+						{
+							Name:  ExpectedGPUsEnv,
+							Value: gpus,
+						},
+					},
 					Resources: corev1.ResourceRequirements{
 						Limits: corev1.ResourceList{
-							"nvidia.com/gpu": resource.MustParse("1"),
+							"nvidia.com/gpu": resource.MustParse(gpus),
 						},
 					},
 					VolumeMounts: []corev1.VolumeMount{
@@ -131,10 +236,15 @@ func CreateGPUBurnPod(apiClient *clients.Settings, podName, podNamespace string,
 					},
 				},
 			},
-			NodeSelector: map[string]string{
-				"nvidia.com/gpu.present":         "true",
-				"node-role.kubernetes.io/worker": "",
-			},
+			NodeSelector: config.NodeSelector,
 		},
-	}, err
+	}, nil
+}
+
+// entrypointData returns the ConfigMap data holding the entrypoint running gpu-burn with the given configuration.
+func entrypointData(config Config) map[string]string {
+	return map[string]string{
+		"entrypoint.sh": fmt.Sprintf(gpuBurnEntrypoint, strings.Join(nvidiasmi.QueryCommand, " "), ExpectedGPUsEnv,
+			ExpectedGPUsEnv, strings.Join(config.Args(), " ")),
+	}
 }
@@ -0,0 +1,177 @@
+package gpuburn
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// StatusOK is the final status of a GPU which computed without error.
+	StatusOK = "OK"
+	// StatusFaulty is the final status of a GPU which computed with errors.
+	StatusFaulty = "FAULTY"
+)
+
+var (
+	gflopsRegexp = regexp.MustCompile(`\(([0-9]+(?:\.[0-9]+)?) Gflop/s\)`)
+	statusRegexp = regexp.MustCompile(`GPU ([0-9]+): (` + StatusOK + `|` + StatusFaulty + `)`)
+)
+
+// GPUResult is the gpu-burn result of a GPU.
+type GPUResult struct {
+	Index       int
+	Gflops      float64
+	Errors      int64
+	Temperature int
+	// Status is the final status of the GPU, empty if gpu-burn did not report it.
+	Status string
+}
+
+// Thresholds are the gpu-burn pass thresholds of every GPU. The zero thresholds are not checked, except for
+// MaxErrors.
+type Thresholds struct {
+	MinGflops      float64
+	MaxErrors      int64
+	MaxTemperature int
+}
+
+// ParseResults returns the result of every GPU reported by the last progress line and the final status lines
+// of the gpu-burn logs.
+func ParseResults(gpuBurnLogs string) ([]GPUResult, error) {
+	lines := strings.Split(gpuBurnLogs, "\n")
+
+	for index := len(lines) - 1; index >= 0; index-- {
+		if !strings.Contains(lines[index], "proc'd:") {
+			continue
+		}
+
+		results, err := parseProgressLine(lines[index])
+		if err != nil {
+			return nil, err
+		}
+
+		if len(results) == 0 {
+			continue
+		}
+
+		for _, match := range statusRegexp.FindAllStringSubmatch(gpuBurnLogs, -1) {
+			gpuIndex, _ := strconv.Atoi(match[1])
+			if gpuIndex < len(results) {
+				results[gpuIndex].Status = match[2]
+			}
+		}
+
+		return results, nil
+	}
+
+	return nil, fmt.Errorf("no Gflop/s value found in gpu-burn logs")
+}
+
+// ParseGflops returns the Gflop/s of every GPU reported by the last progress line of the gpu-burn logs.
+func ParseGflops(gpuBurnLogs string) ([]float64, error) {
+	results, err := ParseResults(gpuBurnLogs)
+	if err != nil {
+		return nil, err
+	}
+
+	gflops := make([]float64, 0, len(results))
+
+	for _, result := range results {
+		gflops = append(gflops, result.Gflops)
+	}
+
+	return gflops, nil
+}
+
+// CheckResults returns the problems of the gpu-burn results: a number of GPUs different from expectedGPUs when it
+// is not zero, or a GPU which is not OK or does not meet the thresholds.
+func CheckResults(results []GPUResult, thresholds Thresholds, expectedGPUs int) []string {
+	var problems []string
+
+	if expectedGPUs > 0 && len(results) != expectedGPUs {
+		problems = append(problems, fmt.Sprintf("gpu-burn reported %d GPUs instead of %d", len(results),
+			expectedGPUs))
+	}
+
+	for _, result := range results {
+		if result.Status != StatusOK {
+			problems = append(problems, fmt.Sprintf("GPU %d status is '%s' instead of '%s'", result.Index,
+				result.Status, StatusOK))
+		}
+
+		if result.Errors > thresholds.MaxErrors {
+			problems = append(problems, fmt.Sprintf("GPU %d has %d errors, more than %d", result.Index,
+				result.Errors, thresholds.MaxErrors))
+		}
+
+		if thresholds.MinGflops > 0 && result.Gflops < thresholds.MinGflops {
+			problems = append(problems, fmt.Sprintf("GPU %d computed %g Gflop/s, less than %g", result.Index,
+				result.Gflops, thresholds.MinGflops))
+		}
+
+		if thresholds.MaxTemperature > 0 && result.Temperature > thresholds.MaxTemperature {
+			problems = append(problems, fmt.Sprintf("GPU %d temperature is %d C, more than %d C", result.Index,
+				result.Temperature, thresholds.MaxTemperature))
+		}
+	}
+
+	return problems
+}
+
+// parseProgressLine parses a gpu-burn progress line such as
+// "100.0%  proc'd: 6184 (18542 Gflop/s) - 6184 (18469 Gflop/s)   errors: 0 - 3 (WARNING!)   temps: 54 C - 49 C".
+func parseProgressLine(line string) ([]GPUResult, error) {
+	matches := gflopsRegexp.FindAllStringSubmatch(line, -1)
+	results := make([]GPUResult, 0, len(matches))
+
+	for index, match := range matches {
+		value, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse gpu-burn Gflop/s value '%s': %w", match[1], err)
+		}
+
+		results = append(results, GPUResult{Index: index, Gflops: value})
+	}
+
+	errorsField, tempsField := "", ""
+
+	if _, after, found := strings.Cut(line, "errors:"); found {
+		errorsField, tempsField, _ = strings.Cut(after, "temps:")
+	}
+
+	for index, field := range splitPerGPU(errorsField, len(results)) {
+		value, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse gpu-burn errors value '%s': %w", field, err)
+		}
+
+		results[index].Errors = value
+	}
+
+	for index, field := range splitPerGPU(tempsField, len(results)) {
+		// The temperature is reported as -- when it cannot be read.
+		if value, err := strconv.Atoi(field); err == nil {
+			results[index].Temperature = value
+		}
+	}
+
+	return results, nil
+}
+
+// splitPerGPU returns the first word of the at most count values of a field separated by " - ".
+func splitPerGPU(field string, count int) []string {
+	var values []string
+
+	for _, value := range strings.Split(field, " - ") {
+		words := strings.Fields(value)
+		if len(words) == 0 || len(values) == count {
+			break
+		}
+
+		values = append(values, words[0])
+	}
+
+	return values
+}
@@ -0,0 +1,123 @@
+package gpuburn
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseGflops(t *testing.T) {
+	testCases := []struct {
+		name          string
+		logs          string
+		expected      []float64
+		expectedError bool
+	}{
+		{
+			name: "single GPU",
+			logs: "Burning for 300 seconds.\n" +
+				"10.0%  proc'd: 1024 (11234 Gflop/s)   errors: 0   temps: 45 C\n" +
+				"100.0%  proc'd: 9216 (12345.5 Gflop/s)   errors: 0   temps: 61 C\n" +
+				"GPU 0: OK\n",
+			expected: []float64{12345.5},
+		},
+		{
+			name:     "two GPUs",
+			logs:     "100.0%  proc'd: 6184 (18542 Gflop/s) - 6184 (18469 Gflop/s)   errors: 0 - 0   temps: 54 C - 49 C\n",
+			expected: []float64{18542, 18469},
+		},
+		{
+			name:          "no progress line",
+			logs:          "Failed to initialize NVML\n",
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			gflops, err := ParseGflops(testCase.logs)
+
+			if testCase.expectedError {
+				if err == nil {
+					t.Errorf("expected error, got %v", gflops)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(gflops, testCase.expected) {
+				t.Errorf("expected %v, got %v", testCase.expected, gflops)
+			}
+		})
+	}
+}
+
+func TestParseResults(t *testing.T) {
+	logs := "GPU 0: NVIDIA A10G (UUID: GPU-5c89852c)\n" +
+		"GPU 1: NVIDIA A10G (UUID: GPU-1d4a0b6e)\n" +
+		"50.0%  proc'd: 3092 (18320 Gflop/s) - 3092 (18102 Gflop/s)   errors: 0 - 0   temps: 51 C - 47 C\n" +
+		"100.0%  proc'd: 6184 (18542 Gflop/s) - 6184 (18469 Gflop/s)   errors: 0 - 3 (WARNING!)   " +
+		"temps: 54 C - -- \n" +
+		"Tested 2 GPUs:\n" +
+		"\tGPU 0: OK\n" +
+		"\tGPU 1: FAULTY\n"
+
+	results, err := ParseResults(logs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []GPUResult{
+		{Index: 0, Gflops: 18542, Errors: 0, Temperature: 54, Status: StatusOK},
+		{Index: 1, Gflops: 18469, Errors: 3, Temperature: 0, Status: StatusFaulty},
+	}
+	if !reflect.DeepEqual(results, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, results)
+	}
+
+	problems := CheckResults(results, Thresholds{}, 2)
+	if len(problems) != 2 {
+		t.Errorf("expected the faulty GPU status and errors problems, got %v", problems)
+	}
+
+	problems = CheckResults(results[:1], Thresholds{MinGflops: 20000, MaxTemperature: 50}, 2)
+	if len(problems) != 3 {
+		t.Errorf("expected the GPU count, Gflop/s and temperature problems, got %v", problems)
+	}
+
+	if problems := CheckResults(results[:1], Thresholds{MinGflops: 18000, MaxTemperature: 85}, 1); len(problems) != 0 {
+		t.Errorf("unexpected problems %v", problems)
+	}
+}
+
+func TestConfigArgs(t *testing.T) {
+	config := NewDefaultConfig()
+	if err := config.Validate(); err != nil {
+		t.Fatalf("unexpected default config error: %v", err)
+	}
+
+	if args := config.Args(); !reflect.DeepEqual(args, []string{"300"}) {
+		t.Errorf("unexpected default arguments %v", args)
+	}
+
+	config.TensorCores = true
+	config.DoublePrecision = true
+	config.Seconds = 60
+
+	if args := config.Args(); !reflect.DeepEqual(args, []string{"-tc", "-d", "60"}) {
+		t.Errorf("unexpected arguments %v", args)
+	}
+
+	nodeConfig := config.OnNode("worker-0", 8)
+	if nodeConfig.GPUs != 8 || nodeConfig.NodeSelector[HostnameLabel] != "worker-0" ||
+		len(nodeConfig.NodeSelector) != 3 || len(config.NodeSelector) != 2 {
+		t.Errorf("unexpected node config %+v of config %+v", nodeConfig, config)
+	}
+
+	if err := config.OnNode("worker-0", 0).Validate(); err == nil {
+		t.Errorf("expected error validating a config without GPU")
+	}
+}
@@ -0,0 +1,113 @@
+package gpuburn
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/imagecatalog"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/runsummary"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/workload"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// WorkloadName is the name the gpu-burn workload is registered by.
+const WorkloadName = "gpu-burn"
+
+func init() {
+	workload.Register(WorkloadName, newWorkload)
+}
+
+// Workload is the gpu-burn GPU workload.
+type Workload struct {
+	Config     Config
+	Thresholds Thresholds
+	Image      string
+}
+
+// newWorkload returns the gpu-burn workload configured by the NVIDIAGPU_BURN_* parameters.
+func newWorkload(params workload.Params) (workload.GPUWorkload, error) {
+	image, err := params.Images.Image(imagecatalog.GPUBurn, params.Architecture)
+	if err != nil {
+		return nil, err
+	}
+
+	config := NewDefaultConfig()
+	config.Seconds = params.Config.BurnSeconds
+	config.GPUs = params.Config.BurnGPUsPerPod
+	config.TensorCores = params.Config.BurnTensorCores
+	config.DoublePrecision = params.Config.BurnDoublePrecision
+
+	if params.NodeSelector != nil {
+		config.NodeSelector = params.NodeSelector
+	}
+
+	return &Workload{
+		Config: config,
+		Thresholds: Thresholds{
+			MinGflops:      params.Config.BurnMinGflops,
+			MaxTemperature: params.Config.BurnMaxTemperature,
+		},
+		Image: image,
+	}, nil
+}
+
+// Name returns the name the gpu-burn workload is registered by.
+func (burn *Workload) Name() string {
+	return WorkloadName
+}
+
+// ConfigMaps returns the gpu-burn entrypoint ConfigMap data.
+func (burn *Workload) ConfigMaps() map[string]map[string]string {
+	return map[string]map[string]string{EntrypointConfigMapName: entrypointData(burn.Config)}
+}
+
+// Pod returns the gpu-burn pod of the target.
+func (burn *Workload) Pod(namespace string, target workload.Target) (*corev1.Pod, error) {
+	config := burn.Config
+
+	if target.GPUs > 0 {
+		config.GPUs = target.GPUs
+	}
+
+	if target.NodeName != "" {
+		config = config.OnNode(target.NodeName, config.GPUs)
+	}
+
+	return CreateGPUBurnPod(nil, target.Name, namespace, burn.Image, config, 0)
+}
+
+// Container returns the name of the gpu-burn container.
+func (burn *Workload) Container() string {
+	return ContainerName
+}
+
+// Duration returns how long gpu-burn loads the GPUs.
+func (burn *Workload) Duration() time.Duration {
+	return time.Duration(burn.Config.Seconds) * time.Second
+}
+
+// Validate parses the gpu-burn logs of the target and checks the result of every GPU against the thresholds.
+func (burn *Workload) Validate(output string, target workload.Target) (*workload.Result, error) {
+	results, err := ParseResults(output)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedGPUs := burn.Config.GPUs
+	if target.GPUs > 0 {
+		expectedGPUs = target.GPUs
+	}
+
+	result := &workload.Result{Problems: CheckResults(results, burn.Thresholds, expectedGPUs)}
+
+	for _, gpuResult := range results {
+		result.Metrics = append(result.Metrics, runsummary.Metric{
+			Name:   WorkloadName,
+			Value:  gpuResult.Gflops,
+			Unit:   "Gflop/s",
+			Labels: map[string]string{"gpu": strconv.Itoa(gpuResult.Index)},
+		})
+	}
+
+	return result, nil
+}
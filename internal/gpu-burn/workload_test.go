@@ -0,0 +1,66 @@
+package gpuburn
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/nvidiagpuconfig"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/workload"
+)
+
+func TestWorkload(t *testing.T) {
+	params := workload.Params{
+		Config: &nvidiagpuconfig.NvidiaGPUConfig{BurnSeconds: 60, BurnGPUsPerPod: 2, BurnTensorCores: true,
+			BurnMinGflops: 15000},
+		Architecture: "arm64",
+		NodeSelector: map[string]string{"nvidia.com/gpu.present": "true"},
+	}
+
+	gpuWorkload, err := workload.New(WorkloadName, params)
+	if err != nil {
+		t.Fatalf("unexpected error building the gpu-burn workload: %v", err)
+	}
+
+	if entrypoint := gpuWorkload.ConfigMaps()[EntrypointConfigMapName]["entrypoint.sh"]; entrypoint == "" ||
+		!containsLine(entrypoint, "./gpu_burn -tc 60") ||
+		!strings.Contains(entrypoint, "NUM_GPUS=$(nvidia-smi -q -x |") {
+		t.Errorf("unexpected entrypoint %s", entrypoint)
+	}
+
+	burnPod, err := gpuWorkload.Pod("test-gpu-burn", workload.Target{Name: "burn-0", NodeName: "worker-0", GPUs: 4})
+	if err != nil {
+		t.Fatalf("unexpected error rendering the gpu-burn pod: %v", err)
+	}
+
+	limit := burnPod.Spec.Containers[0].Resources.Limits["nvidia.com/gpu"]
+	if burnPod.Name != "burn-0" || burnPod.Spec.Containers[0].Image != "quay.io/wabouham/gpu_burn_arm64:ubi9" ||
+		limit.Value() != 4 || burnPod.Spec.NodeSelector[HostnameLabel] != "worker-0" ||
+		len(burnPod.Spec.NodeSelector) != 2 {
+		t.Errorf("unexpected gpu-burn pod %+v", burnPod)
+	}
+
+	result, err := gpuWorkload.Validate("100.0%  proc'd: 6184 (18542 Gflop/s) - 6184 (14469 Gflop/s)   "+
+		"errors: 0 - 0   temps: 54 C - 49 C\n\tGPU 0: OK\n\tGPU 1: OK\n", workload.Target{Name: "burn-0"})
+	if err != nil {
+		t.Fatalf("unexpected error validating the gpu-burn output: %v", err)
+	}
+
+	if len(result.Metrics) != 2 || len(result.Problems) != 1 {
+		t.Errorf("expected 2 metrics and the GPU 1 Gflop/s problem, got %+v", result)
+	}
+
+	params.Architecture = "ppc64le"
+	if _, err := workload.New(WorkloadName, params); err == nil {
+		t.Errorf("expected error building the gpu-burn workload for an unknown architecture")
+	}
+}
+
+func containsLine(text, line string) bool {
+	for _, textLine := range strings.Split(text, "\n") {
+		if strings.TrimSpace(textLine) == line {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,301 @@
+package imagecatalog
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/kelseyhightower/envconfig"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/config"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/get"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+)
+
+var logger = logging.Logger(logging.GPU)
+
+const (
+	// ProfileSection is the profile file section holding the image catalog.
+	ProfileSection = "images"
+	// MultiArch is the catalog entry of a multi-architecture image, used by the architectures without their own
+	// entry.
+	MultiArch = "multiarch"
+
+	// GPUBurn is the gpu-burn image.
+	GPUBurn = "gpu-burn"
+	// MPSPyTorch is the PyTorch image of the MPS workers.
+	MPSPyTorch = "mps-pytorch"
+	// CUDABase is the CUDA base image of the time-slicing and MIG test pods.
+	CUDABase = "cuda-base"
+	// RdmaTools is the image running ib_write_bw in the RDMA test pods.
+	RdmaTools = "rdma-tools"
+	// DebugTools is the image of the privileged pods running commands on the nodes.
+	DebugTools = "debug-tools"
+)
+
+var digestRegexp = regexp.MustCompile(`@sha256:[0-9a-f]{64}$`)
+
+// Image maps the CPU architectures, or MultiArch, to the references of a test image. A reference may be pinned by
+// digest, as in quay.io/org/image:tag@sha256:<digest>.
+type Image map[string]string
+
+// ReferenceMap is a map of strings decoded from a "key=value,key=value" env variable, as image references hold the
+// colons the envconfig map format separates keys and values with.
+type ReferenceMap map[string]string
+
+// Catalog is the catalog of the test images.
+type Catalog struct {
+	// Images maps the test image names to their references.
+	Images map[string]Image `yaml:"catalog" ignored:"true"`
+	// Overrides replaces the reference of a test image for every architecture when keyed by image name, or for one
+	// architecture when keyed by "name/arch".
+	Overrides ReferenceMap `yaml:"overrides" envconfig:"IMAGES_OVERRIDES"`
+	// Mirrors maps registry or repository prefixes, such as quay.io/org, to the prefix of their mirror in a
+	// disconnected cluster.
+	Mirrors ReferenceMap `yaml:"mirrors" envconfig:"IMAGES_MIRRORS"`
+	// RequireDigest fails the resolution of the images not pinned by digest.
+	RequireDigest bool `yaml:"require_digest" envconfig:"IMAGES_REQUIRE_DIGEST"`
+}
+
+// Default returns the catalog of the default test images, without overrides or mirrors.
+func Default() *Catalog {
+	return &Catalog{
+		Images: map[string]Image{
+			GPUBurn: {
+				"amd64": "quay.io/wabouham/gpu_burn_amd64:ubi9",
+				"arm64": "quay.io/wabouham/gpu_burn_arm64:ubi9",
+			},
+			MPSPyTorch: {MultiArch: "nvcr.io/nvidia/pytorch:23.12-py3"},
+			CUDABase:   {MultiArch: "nvcr.io/nvidia/cuda:12.8.1-base-ubi9"},
+			RdmaTools: {
+				"amd64": "quay.io/wabouham/ecosys-nvidia/rdma-tools:0.0.3",
+				"arm64": "quay.io/wabouham/ecosys-nvidia/rdma-tools-aarch64:0.0.3",
+			},
+			DebugTools: {
+				"amd64": "quay.io/wabouham/ecosys-nvidia/ubi9-tools:0.0.1",
+				"arm64": "quay.io/wabouham/ecosys-nvidia/ubi9-tools-arm64:0.0.1",
+			},
+		},
+	}
+}
+
+// NewCatalog returns the image catalog.
+// The default images are merged with the 'images' section of the profile file, per image and architecture, and the
+// overrides and mirrors are in turn overridden by the IMAGES_* env vars.
+func NewCatalog() (*Catalog, error) {
+	logger.V(logging.LevelTrace).Info("Creating new image Catalog")
+
+	catalog := Default()
+	profile := &Catalog{}
+
+	if err := config.ReadProfileSection(ProfileSection, profile); err != nil {
+		return nil, err
+	}
+
+	catalog.Merge(profile)
+
+	if err := envconfig.Process("images", catalog); err != nil {
+		return nil, fmt.Errorf("failed to read IMAGES_* env variables: %w", err)
+	}
+
+	if err := catalog.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid image catalog: %w", err)
+	}
+
+	return catalog, nil
+}
+
+// Merge adds the images, per architecture, the overrides and the mirrors of other to the catalog, replacing the
+// entries both hold.
+func (catalog *Catalog) Merge(other *Catalog) {
+	if catalog.Images == nil {
+		catalog.Images = map[string]Image{}
+	}
+
+	for name, image := range other.Images {
+		if catalog.Images[name] == nil {
+			catalog.Images[name] = Image{}
+		}
+
+		for arch, reference := range image {
+			catalog.Images[name][arch] = reference
+		}
+	}
+
+	catalog.Overrides = mergeReferences(catalog.Overrides, other.Overrides)
+	catalog.Mirrors = mergeReferences(catalog.Mirrors, other.Mirrors)
+	catalog.RequireDigest = catalog.RequireDigest || other.RequireDigest
+}
+
+// Validate checks that the catalog references are well formed and that the overrides are keyed by known images.
+func (catalog *Catalog) Validate() error {
+	for name, image := range catalog.Images {
+		for arch, reference := range image {
+			if err := validateReference(reference); err != nil {
+				return fmt.Errorf("image '%s' for architecture '%s': %w", name, arch, err)
+			}
+		}
+	}
+
+	for key, reference := range catalog.Overrides {
+		name, _, _ := strings.Cut(key, "/")
+		if _, found := catalog.Images[name]; !found {
+			return fmt.Errorf("override '%s' of unknown image '%s', known images are %v", key, name, catalog.Names())
+		}
+
+		if err := validateReference(reference); err != nil {
+			return fmt.Errorf("override '%s': %w", key, err)
+		}
+	}
+
+	for prefix, mirror := range catalog.Mirrors {
+		if prefix == "" || mirror == "" {
+			return fmt.Errorf("invalid mirror '%s' of prefix '%s'", mirror, prefix)
+		}
+	}
+
+	return nil
+}
+
+// Names returns the sorted names of the catalog images.
+func (catalog *Catalog) Names() []string {
+	names := make([]string, 0, len(catalog.Images))
+	for name := range catalog.Images {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Image returns the reference of the named image for the CPU architecture, rewritten for the mirrors.
+func (catalog *Catalog) Image(name, arch string) (string, error) {
+	image, found := catalog.Images[name]
+	if !found {
+		return "", fmt.Errorf("unknown image '%s', known images are %v", name, catalog.Names())
+	}
+
+	reference, found := catalog.Overrides[name+"/"+arch]
+	if !found {
+		reference, found = catalog.Overrides[name]
+	}
+
+	if !found {
+		reference, found = image[arch]
+	}
+
+	if !found {
+		reference, found = image[MultiArch]
+	}
+
+	if !found {
+		return "", fmt.Errorf("image '%s' has no entry for architecture '%s', add it to the '%s' section of the "+
+			"profile file or to IMAGES_OVERRIDES", name, arch, ProfileSection)
+	}
+
+	return catalog.Rewrite(reference)
+}
+
+// Resolve returns the reference of the named image for the CPU architecture of the nodes matching nodeSelector.
+func (catalog *Catalog) Resolve(apiClient *clients.Settings, name string,
+	nodeSelector map[string]string) (string, error) {
+	arch, err := get.GetClusterArchitecture(apiClient, nodeSelector)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the architecture of image '%s': %w", name, err)
+	}
+
+	return catalog.Image(name, arch)
+}
+
+// Rewrite returns the reference with its longest registry or repository prefix replaced by its mirror. It fails if
+// the reference is not pinned by digest while the catalog requires it. It applies to the images set outside the
+// catalog, such as NVIDIAGPU_CUDA_SAMPLES_IMAGE, as well.
+func (catalog *Catalog) Rewrite(reference string) (string, error) {
+	if err := validateReference(reference); err != nil {
+		return "", err
+	}
+
+	if catalog.RequireDigest && !Pinned(reference) {
+		return "", fmt.Errorf("image '%s' is not pinned by digest", reference)
+	}
+
+	longestPrefix := ""
+
+	for prefix := range catalog.Mirrors {
+		if len(prefix) > len(longestPrefix) && strings.HasPrefix(reference, strings.TrimSuffix(prefix, "/")+"/") {
+			longestPrefix = prefix
+		}
+	}
+
+	if longestPrefix == "" {
+		return reference, nil
+	}
+
+	mirrored := strings.TrimSuffix(catalog.Mirrors[longestPrefix], "/") + "/" +
+		strings.TrimPrefix(reference, strings.TrimSuffix(longestPrefix, "/")+"/")
+
+	logger.V(logging.LevelTrace).Info("Rewriting image to mirror", "image", reference, "mirror", mirrored)
+
+	return mirrored, nil
+}
+
+// Pinned returns true if the reference is pinned by a sha256 digest.
+func Pinned(reference string) bool {
+	return digestRegexp.MatchString(reference)
+}
+
+// Decode parses a "key=value,key=value" env variable.
+func (references *ReferenceMap) Decode(value string) error {
+	decoded := ReferenceMap{}
+
+	for _, pair := range strings.Split(value, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		key, reference, found := strings.Cut(pair, "=")
+		if !found {
+			return fmt.Errorf("invalid item '%s', expected key=value", pair)
+		}
+
+		decoded[strings.TrimSpace(key)] = strings.TrimSpace(reference)
+	}
+
+	*references = decoded
+
+	return nil
+}
+
+// validateReference checks that the reference is not empty, holds no whitespace and, when pinned, holds a sha256
+// digest.
+func validateReference(reference string) error {
+	switch {
+	case reference == "":
+		return fmt.Errorf("empty image reference")
+	case strings.ContainsAny(reference, " \t\n"):
+		return fmt.Errorf("invalid image reference '%s'", reference)
+	case strings.Contains(reference, "@") && !Pinned(reference):
+		return fmt.Errorf("invalid digest in image reference '%s', expected @sha256:<64 hex digits>", reference)
+	}
+
+	return nil
+}
+
+// mergeReferences returns references with the entries of other added, creating it when needed.
+func mergeReferences(references, other ReferenceMap) ReferenceMap {
+	if len(other) == 0 {
+		return references
+	}
+
+	if references == nil {
+		references = ReferenceMap{}
+	}
+
+	for key, reference := range other {
+		references[key] = reference
+	}
+
+	return references
+}
@@ -0,0 +1,94 @@
+package imagecatalog
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/config"
+)
+
+const testDigest = "@sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945"
+
+func TestImage(t *testing.T) {
+	catalog := Default()
+
+	for _, test := range []struct {
+		name     string
+		arch     string
+		expected string
+	}{
+		{name: GPUBurn, arch: "arm64", expected: "quay.io/wabouham/gpu_burn_arm64:ubi9"},
+		{name: MPSPyTorch, arch: "arm64", expected: "nvcr.io/nvidia/pytorch:23.12-py3"},
+		{name: RdmaTools, arch: "amd64", expected: "quay.io/wabouham/ecosys-nvidia/rdma-tools:0.0.3"},
+	} {
+		if image, err := catalog.Image(test.name, test.arch); err != nil || image != test.expected {
+			t.Errorf("expected %s image %s for %s, got %s, %v", test.name, test.expected, test.arch, image, err)
+		}
+	}
+
+	if _, err := catalog.Image(GPUBurn, "ppc64le"); err == nil ||
+		!strings.Contains(err.Error(), "no entry for architecture 'ppc64le'") {
+		t.Errorf("expected error resolving gpu-burn for ppc64le, got %v", err)
+	}
+
+	catalog.Overrides = ReferenceMap{GPUBurn + "/ppc64le": "quay.io/example/gpu-burn:ppc64le" + testDigest}
+	catalog.Mirrors = ReferenceMap{"quay.io": "mirror.example.com:5000/quay", "quay.io/example": "mirror.example.com"}
+	catalog.RequireDigest = true
+
+	image, err := catalog.Image(GPUBurn, "ppc64le")
+	if err != nil || image != "mirror.example.com/gpu-burn:ppc64le"+testDigest {
+		t.Errorf("expected the overridden image rewritten for the longest mirror prefix, got %s, %v", image, err)
+	}
+
+	if _, err := catalog.Image(GPUBurn, "amd64"); err == nil {
+		t.Errorf("expected error resolving an image not pinned by digest")
+	}
+
+	catalog.RequireDigest = false
+
+	if image, _ := catalog.Rewrite("quay.io.example.com/org/image:1"); image != "quay.io.example.com/org/image:1" {
+		t.Errorf("expected no mirror for a registry sharing the quay.io prefix, got %s", image)
+	}
+}
+
+func TestNewCatalog(t *testing.T) {
+	profileFile := filepath.Join(t.TempDir(), "profile.yaml")
+
+	err := os.WriteFile(profileFile, []byte("images:\n  catalog:\n    gpu-burn:\n"+
+		"      ppc64le: quay.io/example/gpu-burn:ppc64le\n  mirrors:\n    nvcr.io: mirror.example.com/nvcr\n"), 0600)
+	if err != nil {
+		t.Fatalf("failed to write profile file: %v", err)
+	}
+
+	t.Setenv(config.ProfileFileEnvVar, profileFile)
+	t.Setenv("IMAGES_OVERRIDES", "rdma-tools/arm64=quay.io/example/rdma-tools:arm64,cuda-base=registry:5000/cuda:12")
+
+	catalog, err := NewCatalog()
+	if err != nil {
+		t.Fatalf("unexpected error loading the image catalog: %v", err)
+	}
+
+	for _, test := range []struct {
+		name     string
+		arch     string
+		expected string
+	}{
+		{name: GPUBurn, arch: "ppc64le", expected: "quay.io/example/gpu-burn:ppc64le"},
+		{name: GPUBurn, arch: "amd64", expected: "quay.io/wabouham/gpu_burn_amd64:ubi9"},
+		{name: MPSPyTorch, arch: "amd64", expected: "mirror.example.com/nvcr/nvidia/pytorch:23.12-py3"},
+		{name: RdmaTools, arch: "arm64", expected: "quay.io/example/rdma-tools:arm64"},
+		{name: CUDABase, arch: "arm64", expected: "registry:5000/cuda:12"},
+	} {
+		if image, err := catalog.Image(test.name, test.arch); err != nil || image != test.expected {
+			t.Errorf("expected %s image %s for %s, got %s, %v", test.name, test.expected, test.arch, image, err)
+		}
+	}
+
+	t.Setenv("IMAGES_OVERRIDES", "gpu-burn=quay.io/example/gpu-burn@sha256:invalid")
+
+	if _, err := NewCatalog(); err == nil {
+		t.Errorf("expected error loading an override with an invalid digest")
+	}
+}
@@ -3,11 +3,18 @@ package inittools
 import (
 	"context"
 	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/golang/glog"
 	ginkgo "github.com/onsi/ginkgo/v2"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/config"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/global"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilversion "k8s.io/apimachinery/pkg/util/version"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -34,13 +41,40 @@ func init() {
 	_ = flag.Lookup("logtostderr").Value.Set("true")
 	_ = flag.Lookup("v").Value.Set(GeneralConfig.VerboseLevel)
 
+	verboseLevel, err := strconv.Atoi(GeneralConfig.VerboseLevel)
+	if err != nil {
+		glog.Fatalf("invalid verbose level '%s': %v", GeneralConfig.VerboseLevel, err)
+	}
+
+	if err := logging.Configure(verboseLevel, GeneralConfig.LogVerbosity); err != nil {
+		glog.Fatalf("invalid log verbosity: %v", err)
+	}
+
 	if APIClient = clients.New(""); APIClient == nil {
-		if GeneralConfig.DryRun {
-			return
+		if !GeneralConfig.DryRun {
+			glog.Fatalf("can not load ApiClient. Please check your KUBECONFIG env var")
 		}
 
-		glog.Fatalf("can not load ApiClient. Please check your KUBECONFIG env var")
+		glog.V(100).Infof("Using an empty fake ApiClient in dry-run mode")
+
+		APIClient = clients.NewFakeSettings()
 	}
+
+	if GeneralConfig.DryRun {
+		recorder, err := clients.NewDryRunRecorder(GeneralConfig.GetReportPath(
+			fmt.Sprintf(global.DryRunPlanFileFormat, suiteName())))
+		if err != nil {
+			glog.Fatalf("can not create dry-run recorder: %v", err)
+		}
+
+		APIClient.DryRunRecorder = recorder
+	}
+}
+
+// suiteName returns the name of the suite the process runs, from its test binary such as nvidiagpu.test, so that
+// the suites run by a single ginkgo command do not overwrite each other's reports.
+func suiteName() string {
+	return strings.TrimSuffix(filepath.Base(os.Args[0]), ".test")
 }
 
 func GetOpenShiftVersion() (string, error) {
@@ -3,11 +3,9 @@ package mps
 import (
 	"fmt"
 
-	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
-	"github.com/golang/glog"
-	"github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/configmap"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/olm"
 	corev1 "k8s.io/api/core/v1"
@@ -21,6 +19,8 @@ var (
 	isTrue              bool = true
 	workerConfigMapName      = "mps-test-entrypoint"
 
+	logger = logging.Logger(logging.GPU)
+
 	// WorkerPodConfigMapData contains the entrypoint script for worker pods
 	WorkerPodConfigMapData = map[string]string{
 		"entrypoint.sh": `#!/bin/bash
@@ -83,15 +83,15 @@ func CreateWorkerPodConfigMap(apiClient *clients.Settings,
 
 	createdConfigMapBuilderWithData, err := configMapBuilderWithData.Create()
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof(
-			"error creating Worker Pod ConfigMap with Data named %s and for namespace %s",
-			createdConfigMapBuilderWithData.Object.Name, createdConfigMapBuilderWithData.Object.Namespace)
+		logger.V(logging.LevelDebug).Info("Error creating Worker Pod ConfigMap with Data",
+			"name", createdConfigMapBuilderWithData.Object.Name,
+			"namespace", createdConfigMapBuilderWithData.Object.Namespace)
 		return nil, err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof(
-		"Created Worker Pod ConfigMap with Data named %s and for namespace %s",
-		createdConfigMapBuilderWithData.Object.Name, createdConfigMapBuilderWithData.Object.Namespace)
+	logger.V(logging.LevelDebug).Info("Created Worker Pod ConfigMap with Data",
+		"name", createdConfigMapBuilderWithData.Object.Name,
+		"namespace", createdConfigMapBuilderWithData.Object.Namespace)
 
 	return createdConfigMapBuilderWithData, nil
 }
@@ -141,7 +141,7 @@ func CreateMPSTestPod(apiClient *clients.Settings, podName, podNamespace string,
 							},
 						},
 					},
-					Name: "mps-test-ctr",
+					Name: WorkerContainerName,
 					Command: []string{
 						"/bin/entrypoint.sh",
 					},
@@ -196,7 +196,7 @@ func CreateDevicePluginConfigMap(apiClient *clients.Settings, replicas int, conf
 
 	yamlData, err := yaml.Marshal(config)
 	if err != nil {
-		glog.Error("unable to marshal map %v", err)
+		logger.Error(err, "Unable to marshal map")
 	}
 	devicePluginConfig := map[string]string{
 		"plugin-config.yaml": string(yamlData),
@@ -207,22 +207,23 @@ func CreateDevicePluginConfigMap(apiClient *clients.Settings, replicas int, conf
 
 	createdConfigMapBuilderWithData, err := configMapBuilderWithData.Create()
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof(
-			"error creating Device Plugin ConfigMap with Data named %s and for namespace %s",
-			createdConfigMapBuilderWithData.Object.Name, createdConfigMapBuilderWithData.Object.Namespace)
+		logger.V(logging.LevelDebug).Info("Error creating Device Plugin ConfigMap with Data",
+			"name", createdConfigMapBuilderWithData.Object.Name,
+			"namespace", createdConfigMapBuilderWithData.Object.Namespace)
 		return nil, err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof(
-		"Created Device Plugin ConfigMap with Data named %s and for namespace %s",
-		createdConfigMapBuilderWithData.Object.Name, createdConfigMapBuilderWithData.Object.Namespace)
+	logger.V(logging.LevelDebug).Info("Created Device Plugin ConfigMap with Data",
+		"name", createdConfigMapBuilderWithData.Object.Name,
+		"namespace", createdConfigMapBuilderWithData.Object.Namespace)
 
 	return createdConfigMapBuilderWithData, nil
 }
 
 // CreateClusterPolicyFromCSV creates a new cluster policy from the CSV ALM example
 func CreateClusterPolicyFromCSV(apiClient *clients.Settings, GPUOperatorNamespace, clusterPolicyName string) (*nvidiagpu.Builder, error) {
-	glog.V(gpuparams.GpuLogLevel).Infof("Creating new ClusterPolicy %s from CSV ALM example", clusterPolicyName)
+	logger.V(logging.LevelDebug).Info("Creating new ClusterPolicy from CSV ALM example",
+		"name", clusterPolicyName)
 
 	// Get the CSV containing the ALM example
 	csvList, err := olm.ListClusterServiceVersion(apiClient, GPUOperatorNamespace, metav1.ListOptions{
@@ -242,37 +243,25 @@ func CreateClusterPolicyFromCSV(apiClient *clients.Settings, GPUOperatorNamespac
 		return nil, fmt.Errorf("CSV does not contain alm-examples annotation")
 	}
 
-	// Create cluster policy from ALM example string
-	clusterPolicy := nvidiagpu.NewBuilderFromObjectString(apiClient, almExample)
+	// Create cluster policy from ALM example string, with the device plugin enabled and MPS enabled in its
+	// configuration
+	clusterPolicy := nvidiagpu.NewBuilderFromObjectString(apiClient, almExample).
+		WithDevicePlugin(true).
+		WithDevicePluginMPSRoot("/run/nvidia/mps").
+		WithDevicePluginConfig("plugin-config", "plugin-config.yaml")
 
 	// Set the name
 	clusterPolicy.Definition.Name = clusterPolicyName
-	// Enable device plugin
-	enabled := true
-	clusterPolicy.Definition.Spec.DevicePlugin.Enabled = &enabled
-
-	// Enable MPS in the device plugin configuration
-	if clusterPolicy.Definition.Spec.DevicePlugin.MPS == nil {
-		clusterPolicy.Definition.Spec.DevicePlugin.MPS = &nvidiagpuv1.MPSConfig{}
-	}
-
-	// Set MPS root directory
-	clusterPolicy.Definition.Spec.DevicePlugin.MPS.Root = "/run/nvidia/mps"
-
-	// Set device plugin configuration
-	if clusterPolicy.Definition.Spec.DevicePlugin.Config == nil {
-		clusterPolicy.Definition.Spec.DevicePlugin.Config = &nvidiagpuv1.DevicePluginConfig{}
-	}
-	clusterPolicy.Definition.Spec.DevicePlugin.Config.Name = "plugin-config"
-	clusterPolicy.Definition.Spec.DevicePlugin.Config.Default = "plugin-config.yaml"
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Creating ClusterPolicy %s from CSV ALM example", clusterPolicyName)
+	logger.V(logging.LevelDebug).Info("Creating ClusterPolicy from CSV ALM example",
+		"name", clusterPolicyName)
 	// Create the cluster policy
 	createdPolicy, err := clusterPolicy.Create()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cluster policy: %v", err)
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Successfully created ClusterPolicy %s from CSV ALM example", clusterPolicyName)
+	logger.V(logging.LevelDebug).Info("Successfully created ClusterPolicy from CSV ALM example",
+		"name", clusterPolicyName)
 	return createdPolicy, nil
 }
@@ -0,0 +1,134 @@
+package mps
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/imagecatalog"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/runsummary"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/workload"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// WorkloadName is the name the MPS PyTorch worker workload is registered by.
+	WorkloadName = "mps-pytorch"
+	// WorkerContainerName is the name of the MPS worker container.
+	WorkerContainerName = "mps-test-ctr"
+	// WorkerDuration is how long the MPS worker runs its matrix multiplications.
+	WorkerDuration = 5 * time.Minute
+)
+
+var iterationRegexp = regexp.MustCompile(`Iteration [0-9]+: Matrix multiplication completed in ([0-9.]+) seconds`)
+
+func init() {
+	workload.Register(WorkloadName, newWorkload)
+}
+
+// Workload is the MPS PyTorch worker GPU workload, running matrix multiplications as an MPS client.
+type Workload struct {
+	Image        string
+	NodeSelector map[string]string
+}
+
+// newWorkload returns the MPS PyTorch worker workload.
+func newWorkload(params workload.Params) (workload.GPUWorkload, error) {
+	image, err := params.Images.Image(imagecatalog.MPSPyTorch, params.Architecture)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Workload{Image: image, NodeSelector: params.NodeSelector}, nil
+}
+
+// Name returns the name the MPS PyTorch worker workload is registered by.
+func (worker *Workload) Name() string {
+	return WorkloadName
+}
+
+// ConfigMaps returns the MPS worker entrypoint ConfigMap data.
+func (worker *Workload) ConfigMaps() map[string]map[string]string {
+	return map[string]map[string]string{workerConfigMapName: WorkerPodConfigMapData}
+}
+
+// Pod returns the MPS worker pod of the target.
+func (worker *Workload) Pod(namespace string, target workload.Target) (*corev1.Pod, error) {
+	workerPod, err := CreateMPSTestPod(nil, target.Name, namespace, worker.Image)
+	if err != nil {
+		return nil, err
+	}
+
+	if worker.NodeSelector != nil {
+		workerPod.Spec.NodeSelector = worker.NodeSelector
+	}
+
+	if target.NodeName != "" {
+		nodeSelector := map[string]string{corev1.LabelHostname: target.NodeName}
+		for key, value := range workerPod.Spec.NodeSelector {
+			nodeSelector[key] = value
+		}
+
+		workerPod.Spec.NodeSelector = nodeSelector
+	}
+
+	if target.GPUs > 0 {
+		workerPod.Spec.Containers[0].Resources.Limits["nvidia.com/gpu"] =
+			resource.MustParse(strconv.Itoa(target.GPUs))
+	}
+
+	return workerPod, nil
+}
+
+// Container returns the name of the MPS worker container.
+func (worker *Workload) Container() string {
+	return WorkerContainerName
+}
+
+// Duration returns how long the MPS worker runs.
+func (worker *Workload) Duration() time.Duration {
+	return WorkerDuration
+}
+
+// Validate checks that the MPS worker completed matrix multiplications without error and reports their mean
+// duration.
+func (worker *Workload) Validate(output string, target workload.Target) (*workload.Result, error) {
+	result := &workload.Result{}
+
+	for _, failure := range []string{"ERROR", "Traceback", "CUDA error"} {
+		if strings.Contains(output, failure) {
+			result.Problems = append(result.Problems, fmt.Sprintf("MPS worker %s output holds '%s'", target.Name,
+				failure))
+		}
+	}
+
+	matches := iterationRegexp.FindAllStringSubmatch(output, -1)
+	if len(matches) == 0 {
+		result.Problems = append(result.Problems, fmt.Sprintf("MPS worker %s completed no matrix multiplication",
+			target.Name))
+
+		return result, nil
+	}
+
+	total := 0.0
+
+	for _, match := range matches {
+		seconds, err := strconv.ParseFloat(match[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse MPS worker iteration duration '%s': %w", match[1], err)
+		}
+
+		total += seconds
+	}
+
+	result.Metrics = append(result.Metrics, runsummary.Metric{
+		Name:  WorkloadName,
+		Value: total / float64(len(matches)),
+		Unit:  "s",
+	})
+
+	return result, nil
+}
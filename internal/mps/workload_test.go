@@ -0,0 +1,43 @@
+package mps
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/workload"
+)
+
+func TestWorkloadValidate(t *testing.T) {
+	worker := &Workload{Image: "nvcr.io/nvidia/pytorch:23.12-py3"}
+	target := workload.Target{Name: "mps-worker-0"}
+
+	result, err := worker.Validate("Found 1 GPUs\nProcess ID: 42\n"+
+		"Iteration 1: Matrix multiplication completed in 0.2500 seconds\n"+
+		"Iteration 2: Matrix multiplication completed in 0.0500 seconds\n", target)
+	if err != nil {
+		t.Fatalf("unexpected error validating the MPS worker output: %v", err)
+	}
+
+	if len(result.Problems) != 0 || len(result.Metrics) != 1 || result.Metrics[0].Value != 0.15 {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	result, err = worker.Validate("Traceback (most recent call last):\nRuntimeError: CUDA error: "+
+		"all CUDA-capable devices are busy or unavailable\n", target)
+	if err != nil {
+		t.Fatalf("unexpected error validating the MPS worker output: %v", err)
+	}
+
+	if len(result.Problems) != 3 || len(result.Metrics) != 0 {
+		t.Errorf("expected the traceback, CUDA error and no iteration problems, got %+v", result)
+	}
+
+	workerPod, err := worker.Pod("test-mps", workload.Target{Name: "mps-worker-1", NodeName: "worker-1", GPUs: 2})
+	if err != nil {
+		t.Fatalf("unexpected error rendering the MPS worker pod: %v", err)
+	}
+
+	limit := workerPod.Spec.Containers[0].Resources.Limits["nvidia.com/gpu"]
+	if workerPod.Spec.NodeSelector["kubernetes.io/hostname"] != "worker-1" || limit.Value() != 2 {
+		t.Errorf("unexpected MPS worker pod %+v", workerPod.Spec)
+	}
+}
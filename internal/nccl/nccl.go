@@ -0,0 +1,463 @@
+package nccl
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	rdmatest "github.com/rh-ecosystem-edge/nvidia-ci/internal/rdma"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/wait"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/configmap"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DefaultTest is the NCCL test run when none is configured.
+	DefaultTest = "all_reduce_perf"
+	// DefaultMaxBytes is the default largest message size of the NCCL tests.
+	DefaultMaxBytes = "1G"
+	// NetworkInterface is the interface of the RDMA network in the NCCL pods.
+	NetworkInterface = "net1"
+	// SSHPort is the port the sshd of the NCCL workers listens on.
+	SSHPort = 2222
+	// ServiceAccountName is the privileged service account the NCCL pods run as, shared with the RDMA pods.
+	ServiceAccountName = "rdma"
+
+	// EntrypointConfigMapName is the name of the ConfigMap holding the NCCL worker and launcher entrypoints.
+	EntrypointConfigMapName = "nccl-entrypoint"
+	// SSHSecretName is the name of the Secret holding the SSH key mpirun connects to the workers with.
+	SSHSecretName = "nccl-ssh"
+	// WorkerContainerName is the name of the NCCL worker container.
+	WorkerContainerName = "nccl-worker-ctr"
+	// LauncherContainerName is the name of the NCCL launcher container.
+	LauncherContainerName = "nccl-launcher-ctr"
+
+	networksAnnotation = "k8s.v1.cni.cncf.io/networks"
+	entrypointPath     = "/etc/nccl"
+	sshKeyPath         = "/etc/nccl-ssh"
+)
+
+var (
+	logger = logging.Logger(logging.Network)
+
+	workerEntrypoint = `#!/bin/bash
+set -e
+mkdir -p /root/.ssh
+cp %[1]s/authorized_keys /root/.ssh/authorized_keys
+chmod 700 /root/.ssh && chmod 600 /root/.ssh/authorized_keys
+ssh-keygen -A
+exec /usr/sbin/sshd -D -e -p %[2]d
+`
+
+	// NCCL_NET=IB makes the NCCL tests fail rather than silently fall back to TCP sockets without RDMA device.
+	launcherEntrypoint = `#!/bin/bash
+set -e
+mkdir -p /root/.ssh
+cp %[1]s/id_rsa /root/.ssh/id_rsa
+chmod 700 /root/.ssh && chmod 600 /root/.ssh/id_rsa
+hosts=""
+for host in ${NCCL_HOSTS//,/ }; do
+  hosts="${hosts:+${hosts},}${host}:${NCCL_GPUS_PER_NODE}"
+done
+ranks=$(( $(echo ${NCCL_HOSTS//,/ } | wc -w) * NCCL_GPUS_PER_NODE ))
+echo "Running ${NCCL_TEST} ${NCCL_ARGS} with ${ranks} ranks on ${hosts}"
+exec mpirun --allow-run-as-root -np "${ranks}" -H "${hosts}" \
+  -mca plm_rsh_args "-p %[2]d -o StrictHostKeyChecking=no -o UserKnownHostsFile=/dev/null" \
+  -mca oob_tcp_if_include %[3]s -mca btl_tcp_if_include %[3]s \
+  -x NCCL_SOCKET_IFNAME=%[3]s ${NCCL_IB_HCA:+-x NCCL_IB_HCA} -x NCCL_NET=IB -x NCCL_DEBUG=WARN \
+  "${NCCL_TEST}" ${NCCL_ARGS}
+`
+)
+
+// Config is the configuration of an NCCL tests benchmark.
+type Config struct {
+	// Image is the image holding the NCCL tests built with MPI, OpenMPI and OpenSSH.
+	Image string
+	// NetworkName is the Macvlan, IPoIB or SR-IOV network the NCCL pods are attached to.
+	NetworkName string
+	// Nodes are the GPU nodes running one NCCL worker each.
+	Nodes []string
+	// GPUsPerNode is the number of GPUs, and of NCCL ranks, of every worker.
+	GPUsPerNode int
+	// RDMAResource is the RDMA shared device or SR-IOV resource the NCCL pods request one of.
+	RDMAResource corev1.ResourceName
+	// Device is the RDMA device NCCL uses, such as mlx5_2, empty letting NCCL pick the RDMA devices.
+	Device string
+	// MaxBytes is the largest message size, such as 1G, the message sizes doubling from 8 bytes.
+	MaxBytes string
+}
+
+// Validate checks that the configuration can run an NCCL tests benchmark.
+func (config Config) Validate() error {
+	switch {
+	case config.Image == "":
+		return errors.New("NCCL tests image is not set")
+	case config.NetworkName == "":
+		return errors.New("NCCL tests network is not set")
+	case config.RDMAResource == "":
+		return errors.New("NCCL tests RDMA resource is not set")
+	case len(config.Nodes) < 2:
+		return fmt.Errorf("NCCL tests need at least 2 nodes, got %d", len(config.Nodes))
+	case config.GPUsPerNode < 1:
+		return fmt.Errorf("NCCL tests need at least 1 GPU per node, got %d", config.GPUsPerNode)
+	case config.MaxBytes == "":
+		return errors.New("NCCL tests largest message size is not set")
+	}
+
+	return nil
+}
+
+// Args returns the arguments of the NCCL tests: message sizes doubling from 8 bytes to MaxBytes, one GPU per rank.
+func (config Config) Args() []string {
+	return []string{"-b", "8", "-e", config.MaxBytes, "-f", "2", "-g", "1"}
+}
+
+// Benchmark is an NCCL tests benchmark: one worker pod per node running sshd, and launcher pods running the NCCL
+// tests on the workers with mpirun, all attached to the RDMA network.
+type Benchmark struct {
+	Config    Config
+	Namespace string
+	ConfigMap *configmap.Builder
+	Workers   []*pod.Builder
+	Launchers []*pod.Builder
+	// Hosts are the RDMA network addresses of the workers, in the order of the nodes.
+	Hosts []string
+
+	apiClient  *clients.Settings
+	secretName string
+}
+
+// TestResult is the result of an NCCL test run by the benchmark.
+type TestResult struct {
+	Test   string
+	Output string
+	Result *Result
+}
+
+// Deploy creates the SSH key Secret, the entrypoint ConfigMap and the worker pods of the benchmark in the namespace.
+// On failure the returned benchmark holds the resources created so far, which Cleanup removes.
+func Deploy(apiClient *clients.Settings, config Config, namespace string) (*Benchmark, error) {
+	return DeployWithContext(context.TODO(), apiClient, config, namespace)
+}
+
+// DeployWithContext is the context-aware variant of Deploy.
+func DeployWithContext(ctx context.Context, apiClient *clients.Settings, config Config,
+	namespace string) (*Benchmark, error) {
+	benchmark := &Benchmark{Config: config, Namespace: namespace, apiClient: apiClient}
+
+	if err := config.Validate(); err != nil {
+		return benchmark, err
+	}
+
+	privateKey, authorizedKey, err := GenerateSSHKeys()
+	if err != nil {
+		return benchmark, err
+	}
+
+	logger.V(logging.LevelDebug).Info("Creating NCCL SSH key Secret", "name", SSHSecretName, "namespace", namespace)
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: SSHSecretName, Namespace: namespace}}
+
+	if apiClient.IsDryRun() {
+		// The keys are left out of the dry-run plan.
+		err = apiClient.RecordDryRun(clients.DryRunCreate, secret)
+	} else {
+		secret.StringData = map[string]string{"id_rsa": privateKey, "authorized_keys": authorizedKey}
+		_, err = apiClient.Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{})
+	}
+
+	if err != nil {
+		return benchmark, fmt.Errorf("failed to create NCCL SSH key Secret: %w", err)
+	}
+
+	benchmark.secretName = SSHSecretName
+
+	benchmark.ConfigMap, err = configmap.NewBuilder(apiClient, EntrypointConfigMapName, namespace).
+		WithData(EntrypointData()).CreateWithContext(ctx)
+	if err != nil {
+		return benchmark, fmt.Errorf("failed to create NCCL entrypoint ConfigMap: %w", err)
+	}
+
+	for index, nodeName := range config.Nodes {
+		workerPod := WorkerPod(config, fmt.Sprintf("nccl-worker-%d", index), namespace, nodeName)
+
+		logger.V(logging.LevelDebug).Info("Creating NCCL worker pod", "name", workerPod.Name,
+			"namespace", namespace, "node", nodeName)
+
+		workerBuilder, err := pod.NewBuilderFromDefinition(apiClient, workerPod).CreateWithContext(ctx)
+		if err != nil {
+			return benchmark, fmt.Errorf("failed to create NCCL worker pod %s: %w", workerPod.Name, err)
+		}
+
+		benchmark.Workers = append(benchmark.Workers, workerBuilder)
+	}
+
+	return benchmark, nil
+}
+
+// WaitForWorkers waits for up to timeout for every worker to be running, then collects their RDMA network
+// addresses.
+func (benchmark *Benchmark) WaitForWorkers(timeout time.Duration) error {
+	return benchmark.WaitForWorkersWithContext(context.TODO(), timeout)
+}
+
+// WaitForWorkersWithContext is the context-aware variant of WaitForWorkers.
+func (benchmark *Benchmark) WaitForWorkersWithContext(ctx context.Context, timeout time.Duration) error {
+	benchmark.Hosts = nil
+
+	for _, worker := range benchmark.Workers {
+		if err := worker.WaitUntilInStatusWithContext(ctx, corev1.PodRunning, timeout); err != nil {
+			return fmt.Errorf("NCCL worker pod %s is not running: %w", worker.Definition.Name, err)
+		}
+
+		host, err := rdmatest.GetMyServerIP(benchmark.apiClient, worker.Definition.Name, benchmark.Namespace,
+			NetworkInterface)
+		if err != nil {
+			return fmt.Errorf("failed to get NCCL worker pod %s %s address: %w", worker.Definition.Name,
+				NetworkInterface, err)
+		}
+
+		logger.V(logging.LevelDebug).Info("NCCL worker pod is running", "name", worker.Definition.Name,
+			"address", host)
+
+		benchmark.Hosts = append(benchmark.Hosts, host)
+	}
+
+	return nil
+}
+
+// RunTest runs the NCCL test, such as all_reduce_perf, on the workers and parses its output. The launcher pod
+// running the test is waited for up to timeout.
+func (benchmark *Benchmark) RunTest(test string, timeout time.Duration) (*TestResult, error) {
+	return benchmark.RunTestWithContext(context.TODO(), test, timeout)
+}
+
+// RunTestWithContext is the context-aware variant of RunTest.
+func (benchmark *Benchmark) RunTestWithContext(ctx context.Context, test string,
+	timeout time.Duration) (*TestResult, error) {
+	if len(benchmark.Hosts) != len(benchmark.Workers) || len(benchmark.Hosts) == 0 {
+		return nil, fmt.Errorf("NCCL workers are not running, cannot run %s", test)
+	}
+
+	launcherPod := LauncherPod(benchmark.Config, "nccl-"+strings.ReplaceAll(test, "_", "-"), benchmark.Namespace,
+		test, benchmark.Hosts)
+
+	logger.V(logging.LevelDebug).Info("Creating NCCL launcher pod", "name", launcherPod.Name,
+		"namespace", benchmark.Namespace, "test", test, "hosts", benchmark.Hosts)
+
+	launcher, err := pod.NewBuilderFromDefinition(benchmark.apiClient, launcherPod).CreateWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create NCCL launcher pod %s: %w", launcherPod.Name, err)
+	}
+
+	benchmark.Launchers = append(benchmark.Launchers, launcher)
+
+	err = wait.WatchPodPhase(ctx, benchmark.apiClient, launcherPod.Name, benchmark.Namespace, timeout,
+		corev1.PodSucceeded, corev1.PodFailed)
+	if err != nil {
+		return nil, fmt.Errorf("NCCL launcher pod %s did not complete: %w", launcherPod.Name, err)
+	}
+
+	output, err := launcher.GetFullLogWithContext(ctx, LauncherContainerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get NCCL launcher pod %s logs: %w", launcherPod.Name, err)
+	}
+
+	logger.V(logging.LevelDebug).Info("Collected NCCL launcher pod logs", "name", launcherPod.Name, "logs", output)
+
+	result, err := ParseResult(output)
+	if err != nil {
+		return &TestResult{Test: test, Output: output}, fmt.Errorf("failed to parse %s output: %w", test, err)
+	}
+
+	return &TestResult{Test: test, Output: output, Result: result}, nil
+}
+
+// Cleanup deletes the launcher and worker pods, the entrypoint ConfigMap and the SSH key Secret of the benchmark.
+func (benchmark *Benchmark) Cleanup() error {
+	return benchmark.CleanupWithContext(context.TODO())
+}
+
+// CleanupWithContext is the context-aware variant of Cleanup.
+func (benchmark *Benchmark) CleanupWithContext(ctx context.Context) error {
+	var errs []error
+
+	for _, ncclPod := range append(benchmark.Launchers, benchmark.Workers...) {
+		if _, err := ncclPod.DeleteWithContext(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete NCCL pod %s: %w", ncclPod.Definition.Name, err))
+		}
+	}
+
+	if benchmark.ConfigMap != nil {
+		if err := benchmark.ConfigMap.DeleteWithContext(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete NCCL entrypoint ConfigMap: %w", err))
+		}
+	}
+
+	if benchmark.secretName != "" {
+		var err error
+		if benchmark.apiClient.IsDryRun() {
+			err = benchmark.apiClient.RecordDryRun(clients.DryRunDelete, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+				Name: benchmark.secretName, Namespace: benchmark.Namespace}})
+		} else {
+			err = benchmark.apiClient.Secrets(benchmark.Namespace).Delete(ctx, benchmark.secretName,
+				metav1.DeleteOptions{})
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete NCCL SSH key Secret: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// EntrypointData returns the ConfigMap data holding the NCCL worker and launcher entrypoints.
+func EntrypointData() map[string]string {
+	return map[string]string{
+		"worker.sh":   fmt.Sprintf(workerEntrypoint, sshKeyPath, SSHPort),
+		"launcher.sh": fmt.Sprintf(launcherEntrypoint, sshKeyPath, SSHPort, NetworkInterface),
+	}
+}
+
+// WorkerPod returns the NCCL worker pod running sshd on the node.
+func WorkerPod(config Config, name, namespace, nodeName string) *corev1.Pod {
+	ncclPod := newPod(config, name, namespace, nodeName, WorkerContainerName, "worker.sh")
+	ncclPod.Spec.Containers[0].Resources.Limits["nvidia.com/gpu"] =
+		resource.MustParse(strconv.Itoa(config.GPUsPerNode))
+
+	return ncclPod
+}
+
+// LauncherPod returns the NCCL launcher pod running the test with mpirun on the hosts. It runs on the first node.
+func LauncherPod(config Config, name, namespace, test string, hosts []string) *corev1.Pod {
+	ncclPod := newPod(config, name, namespace, config.Nodes[0], LauncherContainerName, "launcher.sh")
+	ncclPod.Spec.Containers[0].Env = []corev1.EnvVar{
+		{Name: "NCCL_TEST", Value: test},
+		{Name: "NCCL_ARGS", Value: strings.Join(config.Args(), " ")},
+		{Name: "NCCL_HOSTS", Value: strings.Join(hosts, ",")},
+		{Name: "NCCL_GPUS_PER_NODE", Value: strconv.Itoa(config.GPUsPerNode)},
+	}
+
+	if config.Device != "" {
+		ncclPod.Spec.Containers[0].Env = append(ncclPod.Spec.Containers[0].Env,
+			corev1.EnvVar{Name: "NCCL_IB_HCA", Value: config.Device})
+	}
+
+	return ncclPod
+}
+
+// GenerateSSHKeys returns a new RSA private key in PEM format and its public key in authorized_keys format.
+func GenerateSSHKeys() (string, string, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 3072)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate NCCL SSH key: %w", err)
+	}
+
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	// The public key is encoded as the "ssh-rsa" string followed by the exponent and modulus multiple precision
+	// integers, each prefixed with its length.
+	var publicKey bytes.Buffer
+
+	for _, field := range [][]byte{[]byte("ssh-rsa"), mpint(big.NewInt(int64(key.E))), mpint(key.N)} {
+		_ = binary.Write(&publicKey, binary.BigEndian, uint32(len(field)))
+		publicKey.Write(field)
+	}
+
+	return string(privateKey), "ssh-rsa " + base64.StdEncoding.EncodeToString(publicKey.Bytes()) + " nccl-tests\n",
+		nil
+}
+
+// mpint returns the SSH multiple precision integer encoding of a positive integer.
+func mpint(value *big.Int) []byte {
+	encoded := value.Bytes()
+	if len(encoded) > 0 && encoded[0]&0x80 != 0 {
+		encoded = append([]byte{0}, encoded...)
+	}
+
+	return encoded
+}
+
+// newPod returns a privileged pod attached to the RDMA network on the node, running the entrypoint.
+func newPod(config Config, name, namespace, nodeName, containerName, entrypoint string) *corev1.Pod {
+	var volumeDefaultMode int32 = 0755
+
+	privileged := true
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app": "nccl-tests-app",
+			},
+			Annotations: map[string]string{
+				networksAnnotation: config.NetworkName,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:      corev1.RestartPolicyNever,
+			ServiceAccountName: ServiceAccountName,
+			NodeSelector: map[string]string{
+				corev1.LabelHostname: nodeName,
+			},
+			Containers: []corev1.Container{
+				{
+					Name:            containerName,
+					Image:           config.Image,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Command:         []string{"/bin/bash", entrypointPath + "/" + entrypoint},
+					SecurityContext: &corev1.SecurityContext{
+						Privileged: &privileged,
+						Capabilities: &corev1.Capabilities{
+							Add: []corev1.Capability{"IPC_LOCK"},
+						},
+					},
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							config.RDMAResource: resource.MustParse("1"),
+						},
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "entrypoint", MountPath: entrypointPath, ReadOnly: true},
+						{Name: "ssh-key", MountPath: sshKeyPath, ReadOnly: true},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "entrypoint",
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: EntrypointConfigMapName},
+							DefaultMode:          &volumeDefaultMode,
+						},
+					},
+				},
+				{
+					Name: "ssh-key",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{SecretName: SSHSecretName},
+					},
+				},
+			},
+		},
+	}
+}
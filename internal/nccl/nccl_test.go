@@ -0,0 +1,127 @@
+package nccl
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParseResult(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/all_reduce_perf-2x1.txt")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	result, err := ParseResult(string(fixture))
+	if err != nil {
+		t.Fatalf("unexpected error parsing all_reduce_perf output: %v", err)
+	}
+
+	if len(result.Rows) != 6 || result.OutOfBounds != 0 || result.AvgBusBW != 4.96023 {
+		t.Fatalf("unexpected all_reduce_perf result %+v", result)
+	}
+
+	expectedRow := Row{SizeBytes: 1073741824, Count: 268435456, Type: "float", RedOp: "sum",
+		OutOfPlace: Measurement{TimeMicroseconds: 89350, AlgBW: 12.02, BusBW: 12.02},
+		InPlace:    Measurement{TimeMicroseconds: 89297, AlgBW: 12.02, BusBW: 12.02}}
+	if result.LargestRow() != expectedRow {
+		t.Errorf("unexpected largest row %+v", result.LargestRow())
+	}
+
+	if problems := result.Check(10); len(problems) != 0 {
+		t.Errorf("expected no problems with a 10 GB/s minimum, got %v", problems)
+	}
+
+	if problems := result.Check(20); len(problems) != 1 {
+		t.Errorf("expected the bus bandwidth below 20 GB/s, got %v", problems)
+	}
+
+	// Output of NCCL tests older than 2.10, without the root column and with validation disabled.
+	result, err = ParseResult("#  size  count  type  redop  time  algbw  busbw  error  time  algbw  busbw  error\n" +
+		"   8388608  2097152  float  sum  1290.5  6.50  6.50  N/A  1288.2  6.51  6.51  N/A\n" +
+		"# Out of bounds values : 3 FAILED\n# Avg bus bandwidth    : 6.505\n")
+	if err != nil {
+		t.Fatalf("unexpected error parsing all_reduce_perf output: %v", err)
+	}
+
+	if result.Rows[0].InPlace.Wrong != -1 || result.Rows[0].InPlace.BusBW != 6.51 {
+		t.Errorf("unexpected row %+v", result.Rows[0])
+	}
+
+	if problems := result.Check(0); len(problems) != 1 {
+		t.Errorf("expected the values out of bounds, got %v", problems)
+	}
+
+	if _, err := ParseResult("mpirun noticed that process rank 0 exited on signal 9 (Killed).\n"); err == nil {
+		t.Errorf("expected error parsing output without result")
+	}
+}
+
+func TestBenchmarkPods(t *testing.T) {
+	config := Config{Image: "quay.io/example/nccl-tests:latest", NetworkName: "rdma-shared-macvlan",
+		Nodes: []string{"worker-0", "worker-1"}, GPUsPerNode: 2, RDMAResource: "rdma/rdma_shared_device_eth",
+		Device: "mlx5_2", MaxBytes: DefaultMaxBytes}
+	if err := config.Validate(); err != nil {
+		t.Fatalf("unexpected error validating the configuration: %v", err)
+	}
+
+	if err := (Config{Image: config.Image, NetworkName: config.NetworkName, Nodes: config.Nodes[:1],
+		GPUsPerNode: 1, RDMAResource: config.RDMAResource, MaxBytes: DefaultMaxBytes}).Validate(); err == nil {
+		t.Errorf("expected error validating a single node configuration")
+	}
+
+	if err := (Config{Image: config.Image, NetworkName: config.NetworkName, Nodes: config.Nodes,
+		GPUsPerNode: 1, MaxBytes: DefaultMaxBytes}).Validate(); err == nil ||
+		!strings.Contains(err.Error(), "RDMA resource") {
+		t.Errorf("expected error validating a configuration without RDMA resource, got %v", err)
+	}
+
+	workerPod := WorkerPod(config, "nccl-worker-1", "test-nccl", "worker-1")
+	gpus := workerPod.Spec.Containers[0].Resources.Limits["nvidia.com/gpu"]
+
+	if workerPod.Spec.NodeSelector["kubernetes.io/hostname"] != "worker-1" || gpus.Value() != 2 ||
+		workerPod.Annotations[networksAnnotation] != "rdma-shared-macvlan" {
+		t.Errorf("unexpected worker pod %+v", workerPod)
+	}
+
+	launcherPod := LauncherPod(config, "nccl-all-reduce-perf", "test-nccl", DefaultTest,
+		[]string{"192.168.2.10", "192.168.2.11"})
+	env := map[string]string{}
+
+	for _, envVar := range launcherPod.Spec.Containers[0].Env {
+		env[envVar.Name] = envVar.Value
+	}
+
+	if _, found := launcherPod.Spec.Containers[0].Resources.Limits["nvidia.com/gpu"]; found ||
+		env["NCCL_HOSTS"] != "192.168.2.10,192.168.2.11" || env["NCCL_ARGS"] != "-b 8 -e 1G -f 2 -g 1" ||
+		env["NCCL_IB_HCA"] != "mlx5_2" {
+		t.Errorf("unexpected launcher pod %+v", launcherPod)
+	}
+
+	if launcher := EntrypointData()["launcher.sh"]; !strings.Contains(launcher, "-x NCCL_NET=IB") {
+		t.Errorf("expected the launcher to force the NCCL IB transport, got:\n%s", launcher)
+	}
+}
+
+func TestGenerateSSHKeys(t *testing.T) {
+	privateKey, authorizedKey, err := GenerateSSHKeys()
+	if err != nil {
+		t.Fatalf("unexpected error generating SSH keys: %v", err)
+	}
+
+	if block, _ := pem.Decode([]byte(privateKey)); block == nil || block.Type != "RSA PRIVATE KEY" {
+		t.Errorf("unexpected private key %s", privateKey)
+	}
+
+	fields := strings.Fields(authorizedKey)
+	if len(fields) != 3 || fields[0] != "ssh-rsa" {
+		t.Fatalf("unexpected authorized key %s", authorizedKey)
+	}
+
+	publicKey, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil || !strings.HasPrefix(string(publicKey), "\x00\x00\x00\x07ssh-rsa\x00\x00\x00\x03\x01\x00\x01") {
+		t.Errorf("unexpected public key %q, %v", publicKey, err)
+	}
+}
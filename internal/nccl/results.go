@@ -0,0 +1,185 @@
+package nccl
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	outOfBoundsRegexp = regexp.MustCompile(`^# Out of bounds values : ([0-9]+) (OK|FAILED)`)
+	avgBusBWRegexp    = regexp.MustCompile(`^# Avg bus bandwidth\s+: ([0-9]+(?:\.[0-9]+)?)`)
+)
+
+// Measurement is the out-of-place or in-place measurement of a message size.
+type Measurement struct {
+	// TimeMicroseconds is the duration of the collective operation.
+	TimeMicroseconds float64
+	// AlgBW is the algorithm bandwidth in GB/s, the message size divided by the time.
+	AlgBW float64
+	// BusBW is the bus bandwidth in GB/s, the algorithm bandwidth corrected by the operation and rank count so
+	// that it can be compared to the hardware bandwidth.
+	BusBW float64
+	// Wrong is the number of wrong values, -1 when the results are not validated.
+	Wrong int64
+}
+
+// Row is the measurements of a message size.
+type Row struct {
+	SizeBytes  int64
+	Count      int64
+	Type       string
+	RedOp      string
+	OutOfPlace Measurement
+	InPlace    Measurement
+}
+
+// Result is the result of an NCCL test such as all_reduce_perf.
+type Result struct {
+	Rows []Row
+	// OutOfBounds is the number of values out of bounds reported by the test.
+	OutOfBounds int64
+	// AvgBusBW is the average bus bandwidth in GB/s over all the message sizes.
+	AvgBusBW float64
+}
+
+// ParseResult parses the output of an NCCL test into the measurements of every message size.
+func ParseResult(output string) (*Result, error) {
+	result := &Result{}
+	avgFound := false
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		if match := outOfBoundsRegexp.FindStringSubmatch(line); match != nil {
+			result.OutOfBounds, _ = strconv.ParseInt(match[1], 10, 64)
+
+			continue
+		}
+
+		if match := avgBusBWRegexp.FindStringSubmatch(line); match != nil {
+			result.AvgBusBW, _ = strconv.ParseFloat(match[1], 64)
+			avgFound = true
+
+			continue
+		}
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		row, err := parseRow(strings.Fields(line))
+		if err != nil {
+			return nil, err
+		}
+
+		if row != nil {
+			result.Rows = append(result.Rows, *row)
+		}
+	}
+
+	if len(result.Rows) == 0 || !avgFound {
+		return nil, fmt.Errorf("no NCCL test result found in output")
+	}
+
+	return result, nil
+}
+
+// LargestRow returns the row of the largest message size, which reaches the highest bus bandwidth.
+func (result *Result) LargestRow() Row {
+	largest := result.Rows[0]
+
+	for _, row := range result.Rows[1:] {
+		if row.SizeBytes > largest.SizeBytes {
+			largest = row
+		}
+	}
+
+	return largest
+}
+
+// Check returns the problems of the NCCL test result: wrong or out of bounds values, or a bus bandwidth of the
+// largest message size lower than minBusBW GB/s when minBusBW is not zero.
+func (result *Result) Check(minBusBW float64) []string {
+	var problems []string
+
+	if result.OutOfBounds > 0 {
+		problems = append(problems, fmt.Sprintf("%d values out of bounds", result.OutOfBounds))
+	}
+
+	for _, row := range result.Rows {
+		if row.OutOfPlace.Wrong > 0 || row.InPlace.Wrong > 0 {
+			problems = append(problems, fmt.Sprintf("%d out-of-place and %d in-place wrong values for %d bytes",
+				max(row.OutOfPlace.Wrong, 0), max(row.InPlace.Wrong, 0), row.SizeBytes))
+		}
+	}
+
+	largest := result.LargestRow()
+	busBW := max(largest.OutOfPlace.BusBW, largest.InPlace.BusBW)
+
+	if minBusBW > 0 && busBW < minBusBW {
+		problems = append(problems, fmt.Sprintf("bus bandwidth is %g GB/s for %d bytes, less than %g GB/s",
+			busBW, largest.SizeBytes, minBusBW))
+	}
+
+	return problems
+}
+
+// parseRow parses the fields of a result line, with the root column of the NCCL tests since 2.10:
+// "size count type redop [root] time algbw busbw #wrong time algbw busbw #wrong".
+// It returns nil if the line is not a result line.
+func parseRow(fields []string) (*Row, error) {
+	if len(fields) == 13 {
+		fields = append(fields[:4], fields[5:]...)
+	}
+
+	if len(fields) != 12 {
+		return nil, nil
+	}
+
+	size, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, nil
+	}
+
+	row := &Row{SizeBytes: size, Type: fields[2], RedOp: fields[3]}
+
+	if row.Count, err = strconv.ParseInt(fields[1], 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid NCCL test element count '%s': %w", fields[1], err)
+	}
+
+	if row.OutOfPlace, err = parseMeasurement(fields[4:8]); err != nil {
+		return nil, err
+	}
+
+	if row.InPlace, err = parseMeasurement(fields[8:12]); err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+// parseMeasurement parses the time, algbw, busbw and #wrong fields of a measurement.
+func parseMeasurement(fields []string) (Measurement, error) {
+	var (
+		measurement Measurement
+		err         error
+	)
+
+	values := []*float64{&measurement.TimeMicroseconds, &measurement.AlgBW, &measurement.BusBW}
+
+	for index, value := range values {
+		if *value, err = strconv.ParseFloat(fields[index], 64); err != nil {
+			return measurement, fmt.Errorf("invalid NCCL test measurement '%s': %w", fields[index], err)
+		}
+	}
+
+	// The wrong values are reported as N/A when the test runs without validation.
+	measurement.Wrong = -1
+	if wrong, err := strconv.ParseInt(fields[3], 10, 64); err == nil {
+		measurement.Wrong = wrong
+	}
+
+	return measurement, nil
+}
@@ -5,19 +5,27 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/kelseyhightower/envconfig"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/config"
 )
 
+// ProfileSection is the profile file section holding NFDConfig parameters.
+const ProfileSection = "nfd"
+
 // NFDConfig contains only the fallback catalog source index image for NFD.
 type NFDConfig struct {
-	FallbackCatalogSourceIndexImage string `envconfig:"NFD_FALLBACK_CATALOGSOURCE_INDEX_IMAGE"`
+	FallbackCatalogSourceIndexImage string `yaml:"fallback_catalogsource_index_image" envconfig:"NFD_FALLBACK_CATALOGSOURCE_INDEX_IMAGE"`
 }
 
-// NewNFDConfig attempts to load NFDConfig from the environment.
+// NewNFDConfig attempts to load NFDConfig from the 'nfd' section of the profile file and the environment.
 // Logs at V(100) and returns (*NFDConfig, nil) on success, or (nil, error) on failure.
 func NewNFDConfig() (*NFDConfig, error) {
 	glog.V(100).Info("Creating new NFDConfig")
 
 	cfg := &NFDConfig{}
+	if err := config.ReadProfileSection(ProfileSection, cfg); err != nil {
+		return nil, fmt.Errorf("failed to read NFD profile section: %w", err)
+	}
+
 	if err := envconfig.Process("NFD_", cfg); err != nil {
 		return nil, fmt.Errorf("failed to process NFD_ env vars: %w", err)
 	}
@@ -1,35 +1,138 @@
 package nvidiagpuconfig
 
 import (
+	"errors"
+	"time"
+
 	"github.com/golang/glog"
 	"github.com/kelseyhightower/envconfig"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/config"
 )
 
+// ProfileSection is the profile file section holding NvidiaGPUConfig parameters.
+const ProfileSection = "nvidiagpu"
+
 // NvidiaGPUConfig contains environment information related to nvidiagpu tests.
 type NvidiaGPUConfig struct {
-	InstanceType                       string `envconfig:"NVIDIAGPU_GPU_MACHINESET_INSTANCE_TYPE"`
-	CatalogSource                      string `envconfig:"NVIDIAGPU_CATALOGSOURCE"`
-	SubscriptionChannel                string `envconfig:"NVIDIAGPU_SUBSCRIPTION_CHANNEL"`
-	CleanupAfterTest                   bool   `envconfig:"NVIDIAGPU_CLEANUP" default:"true"`
-	DeployFromBundle                   bool   `envconfig:"NVIDIAGPU_DEPLOY_FROM_BUNDLE" default:"false"`
-	BundleImage                        string `envconfig:"NVIDIAGPU_BUNDLE_IMAGE"`
-	OperatorUpgradeToChannel           string `envconfig:"NVIDIAGPU_SUBSCRIPTION_UPGRADE_TO_CHANNEL"`
-	GPUFallbackCatalogsourceIndexImage string `envconfig:"NVIDIAGPU_GPU_FALLBACK_CATALOGSOURCE_INDEX_IMAGE"`
-	ClusterPolicyPatch                 string `envconfig:"NVIDIAGPU_GPU_CLUSTER_POLICY_PATCH"`
+	InstanceType                       string `yaml:"gpu_machineset_instance_type" envconfig:"NVIDIAGPU_GPU_MACHINESET_INSTANCE_TYPE"`
+	CatalogSource                      string `yaml:"catalogsource" envconfig:"NVIDIAGPU_CATALOGSOURCE"`
+	SubscriptionChannel                string `yaml:"subscription_channel" envconfig:"NVIDIAGPU_SUBSCRIPTION_CHANNEL"`
+	CleanupAfterTest                   bool   `yaml:"cleanup" envconfig:"NVIDIAGPU_CLEANUP"`
+	DeployFromBundle                   bool   `yaml:"deploy_from_bundle" envconfig:"NVIDIAGPU_DEPLOY_FROM_BUNDLE"`
+	BundleImage                        string `yaml:"bundle_image" envconfig:"NVIDIAGPU_BUNDLE_IMAGE"`
+	OperatorUpgradeToChannel           string `yaml:"subscription_upgrade_to_channel" envconfig:"NVIDIAGPU_SUBSCRIPTION_UPGRADE_TO_CHANNEL"`
+	GPUFallbackCatalogsourceIndexImage string `yaml:"gpu_fallback_catalogsource_index_image" envconfig:"NVIDIAGPU_GPU_FALLBACK_CATALOGSOURCE_INDEX_IMAGE"`
+	ClusterPolicyPatch                 string `yaml:"gpu_cluster_policy_patch" envconfig:"NVIDIAGPU_GPU_CLUSTER_POLICY_PATCH"`
+	UseNvidiaDriverCRD                 bool   `yaml:"use_nvidia_driver_crd" envconfig:"NVIDIAGPU_USE_NVIDIA_DRIVER_CRD"`
+	NvidiaDriverVersion                string `yaml:"nvidia_driver_version" envconfig:"NVIDIAGPU_NVIDIA_DRIVER_VERSION"`
+	NvidiaDriverRepository             string `yaml:"nvidia_driver_repository" envconfig:"NVIDIAGPU_NVIDIA_DRIVER_REPOSITORY"`
+	NvidiaDriverPrecompiled            bool   `yaml:"nvidia_driver_precompiled" envconfig:"NVIDIAGPU_NVIDIA_DRIVER_PRECOMPILED"`
+	MIGConfig                          string `yaml:"mig_config" envconfig:"NVIDIAGPU_MIG_CONFIG"`
+
+	// DriverPoolVersions maps the driver pool names to the driver version of their NVIDIADriver.
+	DriverPoolVersions map[string]string `yaml:"driver_pool_versions" envconfig:"NVIDIAGPU_DRIVER_POOL_VERSIONS"`
+
+	// TimeSlicingReplicas is the number of replicas each GPU is shared as by the time-slicing tests.
+	TimeSlicingReplicas int `yaml:"time_slicing_replicas" envconfig:"NVIDIAGPU_TIME_SLICING_REPLICAS"`
+
+	// Workload is the name of the GPU workload the GPU operator deployment is validated with.
+	Workload string `yaml:"workload" envconfig:"NVIDIAGPU_WORKLOAD"`
+
+	// BurnSeconds is how long gpu-burn loads the GPUs.
+	BurnSeconds int `yaml:"burn_seconds" envconfig:"NVIDIAGPU_BURN_SECONDS"`
+	// BurnGPUsPerPod is the number of GPUs each gpu-burn pod requests, 0 requesting all the GPUs of its node.
+	BurnGPUsPerPod int `yaml:"burn_gpus_per_pod" envconfig:"NVIDIAGPU_BURN_GPUS_PER_POD"`
+	// BurnAllNodes runs one gpu-burn pod on every GPU node in parallel instead of a single pod.
+	BurnAllNodes bool `yaml:"burn_all_nodes" envconfig:"NVIDIAGPU_BURN_ALL_NODES"`
+	// BurnTensorCores makes gpu-burn use the tensor cores.
+	BurnTensorCores bool `yaml:"burn_tensor_cores" envconfig:"NVIDIAGPU_BURN_TENSOR_CORES"`
+	// BurnDoublePrecision makes gpu-burn use double precision floating point operations.
+	BurnDoublePrecision bool `yaml:"burn_double_precision" envconfig:"NVIDIAGPU_BURN_DOUBLE_PRECISION"`
+	// BurnMinGflops is the minimum Gflop/s every GPU must compute, 0 disabling the check.
+	BurnMinGflops float64 `yaml:"burn_min_gflops" envconfig:"NVIDIAGPU_BURN_MIN_GFLOPS"`
+	// BurnMaxTemperature is the maximum temperature in Celsius every GPU may reach, 0 disabling the check.
+	BurnMaxTemperature int `yaml:"burn_max_temperature" envconfig:"NVIDIAGPU_BURN_MAX_TEMPERATURE"`
+
+	// CUDASamplesImage is the image holding the CUDA samples on its PATH, empty skipping the CUDA samples tests.
+	CUDASamplesImage string `yaml:"cuda_samples_image" envconfig:"NVIDIAGPU_CUDA_SAMPLES_IMAGE"`
+	// CUDASamplesMinBandwidth is the minimum host to device and device to host bandwidth in GB/s, 0 disabling
+	// the check.
+	CUDASamplesMinBandwidth float64 `yaml:"cuda_samples_min_bandwidth" envconfig:"NVIDIAGPU_CUDA_SAMPLES_MIN_BANDWIDTH"`
+	// CUDASamplesRequireP2P fails the CUDA samples tests when the GPUs of a node lack peer to peer memory access.
+	CUDASamplesRequireP2P bool `yaml:"cuda_samples_require_p2p" envconfig:"NVIDIAGPU_CUDA_SAMPLES_REQUIRE_P2P"`
+
+	// SoakDuration is how long the soak test schedules the GPU workload again and again, 0 skipping the soak test.
+	SoakDuration time.Duration `yaml:"soak_duration" envconfig:"NVIDIAGPU_SOAK_DURATION"`
+	// SoakWorkload is the name of the GPU workload of the soak test, empty for the Workload one.
+	SoakWorkload string `yaml:"soak_workload" envconfig:"NVIDIAGPU_SOAK_WORKLOAD"`
+	// SoakScanInterval is the interval between the scans of the kernel and driver logs for GPU errors.
+	SoakScanInterval time.Duration `yaml:"soak_scan_interval" envconfig:"NVIDIAGPU_SOAK_SCAN_INTERVAL"`
+	// SoakIgnoredXids are the Xids the soak test reports without failing.
+	SoakIgnoredXids []int `yaml:"soak_ignored_xids" envconfig:"NVIDIAGPU_SOAK_IGNORED_XIDS"`
 }
 
 // NewNvidiaGPUConfig returns an instance of NvidiaGPUConfig.
-// Logs at V(100) and returns nil on failure.
+// Defaults are overridden by the 'nvidiagpu' section of the profile file, which is in turn overridden by env vars.
+// Logs the failure reason and returns nil on failure.
 func NewNvidiaGPUConfig() *NvidiaGPUConfig {
 	log := glog.V(100)
 	log.Info("Creating new NvidiaGPUConfig")
 
-	cfg := &NvidiaGPUConfig{}
+	cfg := &NvidiaGPUConfig{CleanupAfterTest: true, TimeSlicingReplicas: 4, Workload: "gpu-burn", BurnSeconds: 300,
+		BurnGPUsPerPod: 1, SoakScanInterval: 5 * time.Minute}
+	if err := config.ReadProfileSection(ProfileSection, cfg); err != nil {
+		glog.Errorf("Failed to read NvidiaGPUConfig profile section: %v", err)
+		return nil
+	}
+
 	if err := envconfig.Process("nvidiagpu_", cfg); err != nil {
 		glog.V(100).Infof("Failed to instantiate NvidiaGPUConfig: %v", err)
 		return nil
 	}
 
+	if err := cfg.Validate(); err != nil {
+		glog.Errorf("Invalid NvidiaGPUConfig: %v", err)
+		return nil
+	}
+
 	log.Info("NvidiaGPUConfig created successfully")
 	return cfg
 }
+
+// Validate checks that the NvidiaGPUConfig fields are consistent.
+func (cfg *NvidiaGPUConfig) Validate() error {
+	if cfg.DeployFromBundle && cfg.BundleImage == "" {
+		return errors.New("NVIDIAGPU_BUNDLE_IMAGE is required when NVIDIAGPU_DEPLOY_FROM_BUNDLE is set")
+	}
+
+	if !cfg.UseNvidiaDriverCRD &&
+		(cfg.NvidiaDriverVersion != "" || cfg.NvidiaDriverRepository != "" || cfg.NvidiaDriverPrecompiled) {
+		return errors.New("NVIDIAGPU_NVIDIA_DRIVER_* parameters require NVIDIAGPU_USE_NVIDIA_DRIVER_CRD to be set")
+	}
+
+	if cfg.TimeSlicingReplicas < 2 {
+		return errors.New("NVIDIAGPU_TIME_SLICING_REPLICAS must be at least 2")
+	}
+
+	if cfg.Workload == "" {
+		return errors.New("NVIDIAGPU_WORKLOAD must name a GPU workload")
+	}
+
+	if cfg.BurnSeconds < 1 {
+		return errors.New("NVIDIAGPU_BURN_SECONDS must be at least 1")
+	}
+
+	if cfg.BurnGPUsPerPod < 0 || (cfg.BurnGPUsPerPod == 0 && !cfg.BurnAllNodes) {
+		return errors.New("NVIDIAGPU_BURN_GPUS_PER_POD must be at least 1, or 0 with NVIDIAGPU_BURN_ALL_NODES set")
+	}
+
+	if cfg.SoakDuration < 0 {
+		return errors.New("NVIDIAGPU_SOAK_DURATION must not be negative")
+	}
+
+	if cfg.SoakDuration > 0 && cfg.SoakScanInterval <= 0 {
+		return errors.New("NVIDIAGPU_SOAK_SCAN_INTERVAL must be positive when NVIDIAGPU_SOAK_DURATION is set")
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package nvidiagpuconfig
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/config"
+)
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		modify func(cfg *NvidiaGPUConfig)
+		err    string
+	}{
+		{name: "defaults", modify: func(cfg *NvidiaGPUConfig) {}},
+		{
+			name:   "bundle image missing",
+			modify: func(cfg *NvidiaGPUConfig) { cfg.DeployFromBundle = true },
+			err:    "NVIDIAGPU_BUNDLE_IMAGE is required",
+		},
+		{
+			name:   "driver version without NVIDIADriver CRD",
+			modify: func(cfg *NvidiaGPUConfig) { cfg.NvidiaDriverVersion = "550.127.08" },
+			err:    "require NVIDIAGPU_USE_NVIDIA_DRIVER_CRD",
+		},
+		{
+			name:   "single time-slicing replica",
+			modify: func(cfg *NvidiaGPUConfig) { cfg.TimeSlicingReplicas = 1 },
+			err:    "NVIDIAGPU_TIME_SLICING_REPLICAS",
+		},
+		{
+			name:   "all GPUs of a single pod",
+			modify: func(cfg *NvidiaGPUConfig) { cfg.BurnGPUsPerPod = 0 },
+			err:    "NVIDIAGPU_BURN_GPUS_PER_POD",
+		},
+		{
+			name:   "all GPUs of every node",
+			modify: func(cfg *NvidiaGPUConfig) { cfg.BurnGPUsPerPod, cfg.BurnAllNodes = 0, true },
+		},
+		{
+			name:   "soak without scan interval",
+			modify: func(cfg *NvidiaGPUConfig) { cfg.SoakDuration, cfg.SoakScanInterval = time.Hour, 0 },
+			err:    "NVIDIAGPU_SOAK_SCAN_INTERVAL",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &NvidiaGPUConfig{TimeSlicingReplicas: 4, Workload: "gpu-burn", BurnSeconds: 300, BurnGPUsPerPod: 1,
+				SoakScanInterval: 5 * time.Minute}
+			test.modify(cfg)
+
+			err := cfg.Validate()
+			if test.err == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+				t.Errorf("expected error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestNewNvidiaGPUConfig(t *testing.T) {
+	profileFile := filepath.Join(t.TempDir(), "profile.yaml")
+
+	err := os.WriteFile(profileFile, []byte("nvidiagpu:\n  workload: mps-pytorch\n  burn_seconds: 600\n"+
+		"  soak_duration: 4h\n"), 0600)
+	if err != nil {
+		t.Fatalf("failed to write profile file: %v", err)
+	}
+
+	t.Setenv(config.ProfileFileEnvVar, profileFile)
+	t.Setenv("NVIDIAGPU_BURN_SECONDS", "120")
+
+	cfg := NewNvidiaGPUConfig()
+	if cfg == nil {
+		t.Fatalf("failed to load NvidiaGPUConfig")
+	}
+
+	if cfg.Workload != "mps-pytorch" || cfg.BurnSeconds != 120 || cfg.SoakDuration != 4*time.Hour ||
+		cfg.TimeSlicingReplicas != 4 {
+		t.Errorf("expected the env variables to override the profile and the profile the defaults, got %+v", cfg)
+	}
+
+	t.Setenv("NVIDIAGPU_BURN_SECONDS", "0")
+
+	if cfg := NewNvidiaGPUConfig(); cfg != nil {
+		t.Errorf("expected an invalid env variable to fail the configuration, got %+v", cfg)
+	}
+}
@@ -1,54 +1,137 @@
 package nvidianetworkconfig
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/golang/glog"
 
 	"github.com/kelseyhightower/envconfig"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/config"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/nccl"
+)
+
+const (
+	// ProfileSection is the profile file section holding NvidiaNetworkConfig parameters.
+	ProfileSection = "nvidianetwork"
+	// RdmaNetworkTypeSriov selects the SR-IOV RDMA network setup.
+	RdmaNetworkTypeSriov = "sriov"
+	// RdmaNetworkTypeSharedDevice selects the RDMA shared device plugin network setup.
+	RdmaNetworkTypeSharedDevice = "shared-device"
 )
 
 // NvidiaNetworkConfig contains environment information related to nvidianetwork tests.
 type NvidiaNetworkConfig struct {
-	CatalogSource                      string `envconfig:"NVIDIANETWORK_CATALOGSOURCE"`
-	SubscriptionChannel                string `envconfig:"NVIDIANETWORK_SUBSCRIPTION_CHANNEL"`
-	CleanupAfterTest                   bool   `envconfig:"NVIDIANETWORK_CLEANUP" default:"true"`
-	DeployFromBundle                   bool   `envconfig:"NVIDIANETWORK_DEPLOY_FROM_BUNDLE" default:"false"`
-	BundleImage                        string `envconfig:"NVIDIANETWORK_BUNDLE_IMAGE"`
-	OfedDriverVersion                  string `envconfig:"NVIDIANETWORK_OFED_DRIVER_VERSION"`
-	OfedDriverRepository               string `envconfig:"NVIDIANETWORK_OFED_REPOSITORY"`
-	RdmaWorkloadNamespace              string `envconfig:"NVIDIANETWORK_RDMA_WORKLOAD_NAMESPACE"`
-	RdmaLinkType                       string `envconfig:"NVIDIANETWORK_RDMA_LINK_TYPE"`
-	RdmaClientHostname                 string `envconfig:"NVIDIANETWORK_RDMA_CLIENT_HOSTNAME"`
-	RdmaServerHostname                 string `envconfig:"NVIDIANETWORK_RDMA_SERVER_HOSTNAME"`
-	RdmaTestImage                      string `envconfig:"NVIDIANETWORK_RDMA_TEST_IMAGE"`
-	RdmaMlxDevice                      string `envconfig:"NVIDIANETWORK_RDMA_MLX_DEVICE"`
-	RdmaNetworkType                    string `envconfig:"NVIDIANETWORK_RDMA_NETWORK_TYPE"`
-	RdmaGPUDirect                      bool   `envconfig:"NVIDIANETWORK_RDMA_GPUDIRECT"`
-	SriovNetworkName                   string `envconfig:"NVIDIANETWORK_RDMA_SRIOV_NETWORK_NAME"`
-	MellanoxEthernetInterfaceName      string `envconfig:"NVIDIANETWORK_MELLANOX_ETH_INTERFACE_NAME"`
-	MellanoxInfinibandInterfaceName    string `envconfig:"NVIDIANETWORK_MELLANOX_IB_INTERFACE_NAME"`
-	MacvlanNetworkName                 string `envconfig:"NVIDIANETWORK_MACVLANNETWORK_NAME"`
-	MacvlanNetworkIPAMRange            string `envconfig:"NVIDIANETWORK_MACVLANNETWORK_IPAM_RANGE"`
-	MacvlanNetworkIPAMGateway          string `envconfig:"NVIDIANETWORK_MACVLANNETWORK_IPAM_GATEWAY"`
-	IPoIBNetworkName                   string `envconfig:"NVIDIANETWORK_IPOIBNETWORK_NAME"`
-	IPoIBNetworkIPAMRange              string `envconfig:"NVIDIANETWORK_IPOIBNETWORK_IPAM_RANGE"`
-	IPoIBNetworkIPAMExcludeIP1         string `envconfig:"NVIDIANETWORK_IPOIBNETWORK_IPAM_EXCLUDEIP1"`
-	IPoIBNetworkIPAMExcludeIP2         string `envconfig:"NVIDIANETWORK_IPOIBNETWORK_IPAM_EXCLUDEIP2"`
-	OperatorUpgradeToChannel           string `envconfig:"NVIDIANETWORK_SUBSCRIPTION_UPGRADE_TO_CHANNEL"`
-	NNOFallbackCatalogsourceIndexImage string `envconfig:"NVIDIANETWORK_NNO_FALLBACK_CATALOGSOURCE_INDEX_IMAGE"`
+	CatalogSource                      string `yaml:"catalogsource" envconfig:"NVIDIANETWORK_CATALOGSOURCE"`
+	SubscriptionChannel                string `yaml:"subscription_channel" envconfig:"NVIDIANETWORK_SUBSCRIPTION_CHANNEL"`
+	CleanupAfterTest                   bool   `yaml:"cleanup" envconfig:"NVIDIANETWORK_CLEANUP"`
+	DeployFromBundle                   bool   `yaml:"deploy_from_bundle" envconfig:"NVIDIANETWORK_DEPLOY_FROM_BUNDLE"`
+	BundleImage                        string `yaml:"bundle_image" envconfig:"NVIDIANETWORK_BUNDLE_IMAGE"`
+	OfedDriverVersion                  string `yaml:"ofed_driver_version" envconfig:"NVIDIANETWORK_OFED_DRIVER_VERSION"`
+	OfedDriverRepository               string `yaml:"ofed_repository" envconfig:"NVIDIANETWORK_OFED_REPOSITORY"`
+	RdmaWorkloadNamespace              string `yaml:"rdma_workload_namespace" envconfig:"NVIDIANETWORK_RDMA_WORKLOAD_NAMESPACE"`
+	RdmaLinkType                       string `yaml:"rdma_link_type" envconfig:"NVIDIANETWORK_RDMA_LINK_TYPE"`
+	RdmaClientHostname                 string `yaml:"rdma_client_hostname" envconfig:"NVIDIANETWORK_RDMA_CLIENT_HOSTNAME"`
+	RdmaServerHostname                 string `yaml:"rdma_server_hostname" envconfig:"NVIDIANETWORK_RDMA_SERVER_HOSTNAME"`
+	RdmaTestImage                      string `yaml:"rdma_test_image" envconfig:"NVIDIANETWORK_RDMA_TEST_IMAGE"`
+	RdmaMlxDevice                      string `yaml:"rdma_mlx_device" envconfig:"NVIDIANETWORK_RDMA_MLX_DEVICE"`
+	RdmaNetworkType                    string `yaml:"rdma_network_type" envconfig:"NVIDIANETWORK_RDMA_NETWORK_TYPE"`
+	RdmaGPUDirect                      bool   `yaml:"rdma_gpudirect" envconfig:"NVIDIANETWORK_RDMA_GPUDIRECT"`
+	SriovNetworkName                   string `yaml:"rdma_sriov_network_name" envconfig:"NVIDIANETWORK_RDMA_SRIOV_NETWORK_NAME"`
+	MellanoxEthernetInterfaceName      string `yaml:"mellanox_eth_interface_name" envconfig:"NVIDIANETWORK_MELLANOX_ETH_INTERFACE_NAME"`
+	MellanoxInfinibandInterfaceName    string `yaml:"mellanox_ib_interface_name" envconfig:"NVIDIANETWORK_MELLANOX_IB_INTERFACE_NAME"`
+	MacvlanNetworkName                 string `yaml:"macvlannetwork_name" envconfig:"NVIDIANETWORK_MACVLANNETWORK_NAME"`
+	MacvlanNetworkIPAMRange            string `yaml:"macvlannetwork_ipam_range" envconfig:"NVIDIANETWORK_MACVLANNETWORK_IPAM_RANGE"`
+	MacvlanNetworkIPAMGateway          string `yaml:"macvlannetwork_ipam_gateway" envconfig:"NVIDIANETWORK_MACVLANNETWORK_IPAM_GATEWAY"`
+	IPoIBNetworkName                   string `yaml:"ipoibnetwork_name" envconfig:"NVIDIANETWORK_IPOIBNETWORK_NAME"`
+	IPoIBNetworkIPAMRange              string `yaml:"ipoibnetwork_ipam_range" envconfig:"NVIDIANETWORK_IPOIBNETWORK_IPAM_RANGE"`
+	IPoIBNetworkIPAMExcludeIP1         string `yaml:"ipoibnetwork_ipam_excludeip1" envconfig:"NVIDIANETWORK_IPOIBNETWORK_IPAM_EXCLUDEIP1"`
+	IPoIBNetworkIPAMExcludeIP2         string `yaml:"ipoibnetwork_ipam_excludeip2" envconfig:"NVIDIANETWORK_IPOIBNETWORK_IPAM_EXCLUDEIP2"`
+	OperatorUpgradeToChannel           string `yaml:"subscription_upgrade_to_channel" envconfig:"NVIDIANETWORK_SUBSCRIPTION_UPGRADE_TO_CHANNEL"`
+	NNOFallbackCatalogsourceIndexImage string `yaml:"nno_fallback_catalogsource_index_image" envconfig:"NVIDIANETWORK_NNO_FALLBACK_CATALOGSOURCE_INDEX_IMAGE"`
+
+	// NcclTestImage is the NCCL tests image of the multi-node NCCL benchmark, which is skipped when it is empty.
+	NcclTestImage string `yaml:"nccl_test_image" envconfig:"NVIDIANETWORK_NCCL_TEST_IMAGE"`
+	// NcclNodeCount is the number of GPU nodes the NCCL benchmark runs on.
+	NcclNodeCount int `yaml:"nccl_node_count" envconfig:"NVIDIANETWORK_NCCL_NODE_COUNT"`
+	// NcclGPUsPerNode is the number of GPUs, and of NCCL ranks, of every node of the NCCL benchmark.
+	NcclGPUsPerNode int `yaml:"nccl_gpus_per_node" envconfig:"NVIDIANETWORK_NCCL_GPUS_PER_NODE"`
+	// NcclTests are the NCCL tests run by the NCCL benchmark, such as all_reduce_perf.
+	NcclTests []string `yaml:"nccl_tests" envconfig:"NVIDIANETWORK_NCCL_TESTS"`
+	// NcclMaxBytes is the largest message size of the NCCL tests, such as 1G.
+	NcclMaxBytes string `yaml:"nccl_max_bytes" envconfig:"NVIDIANETWORK_NCCL_MAX_BYTES"`
+	// NcclMinBusBandwidth is the minimum bus bandwidth in GB/s of the largest message size, 0 disabling the check.
+	NcclMinBusBandwidth float64 `yaml:"nccl_min_bus_bandwidth" envconfig:"NVIDIANETWORK_NCCL_MIN_BUS_BANDWIDTH"`
 }
 
 // NewNvidiaNetworkConfig returns instance of NvidiaNetworkConfig type.
+// Defaults are overridden by the 'nvidianetwork' section of the profile file, which is in turn overridden by env vars.
 func NewNvidiaNetworkConfig() *NvidiaNetworkConfig {
 	glog.V(100).Info("Creating new NvidiaNetworkConfig")
 
-	nvidiaNetworkConfig := new(NvidiaNetworkConfig)
+	nvidiaNetworkConfig := &NvidiaNetworkConfig{
+		CleanupAfterTest: true,
+		RdmaNetworkType:  RdmaNetworkTypeSharedDevice,
+		NcclNodeCount:    2,
+		NcclGPUsPerNode:  1,
+		NcclTests:        []string{nccl.DefaultTest},
+		NcclMaxBytes:     nccl.DefaultMaxBytes,
+	}
 
-	err := envconfig.Process("nvidianetwork", nvidiaNetworkConfig)
+	err := config.ReadProfileSection(ProfileSection, nvidiaNetworkConfig)
+	if err != nil {
+		glog.Errorf("failed to read NvidiaNetworkConfig profile section: %v", err)
+
+		return nil
+	}
+
+	err = envconfig.Process("nvidianetwork", nvidiaNetworkConfig)
 	if err != nil {
 		glog.V(100).Infof("failed to instantiate NvidiaNetworkConfig: %v", err)
 
 		return nil
 	}
 
+	err = nvidiaNetworkConfig.Validate()
+	if err != nil {
+		glog.Errorf("invalid NvidiaNetworkConfig: %v", err)
+
+		return nil
+	}
+
 	return nvidiaNetworkConfig
 }
+
+// Validate checks that the NvidiaNetworkConfig fields hold supported values.
+func (nvidiaNetworkConfig *NvidiaNetworkConfig) Validate() error {
+	var errs []error
+
+	if nvidiaNetworkConfig.RdmaNetworkType != RdmaNetworkTypeSriov &&
+		nvidiaNetworkConfig.RdmaNetworkType != RdmaNetworkTypeSharedDevice {
+		errs = append(errs, fmt.Errorf("NVIDIANETWORK_RDMA_NETWORK_TYPE must be '%s' or '%s', got '%s'",
+			RdmaNetworkTypeSriov, RdmaNetworkTypeSharedDevice, nvidiaNetworkConfig.RdmaNetworkType))
+	}
+
+	if nvidiaNetworkConfig.DeployFromBundle && nvidiaNetworkConfig.BundleImage == "" {
+		errs = append(errs, fmt.Errorf("NVIDIANETWORK_BUNDLE_IMAGE is required when "+
+			"NVIDIANETWORK_DEPLOY_FROM_BUNDLE is set"))
+	}
+
+	if nvidiaNetworkConfig.NcclTestImage != "" {
+		if nvidiaNetworkConfig.NcclNodeCount < 2 {
+			errs = append(errs, fmt.Errorf("NVIDIANETWORK_NCCL_NODE_COUNT must be at least 2, got %d",
+				nvidiaNetworkConfig.NcclNodeCount))
+		}
+
+		if nvidiaNetworkConfig.NcclGPUsPerNode < 1 {
+			errs = append(errs, fmt.Errorf("NVIDIANETWORK_NCCL_GPUS_PER_NODE must be at least 1, got %d",
+				nvidiaNetworkConfig.NcclGPUsPerNode))
+		}
+
+		if len(nvidiaNetworkConfig.NcclTests) == 0 {
+			errs = append(errs, fmt.Errorf("NVIDIANETWORK_NCCL_TESTS must list at least one NCCL test"))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,87 @@
+package nvidianetworkconfig
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/config"
+)
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		modify func(cfg *NvidiaNetworkConfig)
+		err    string
+	}{
+		{name: "defaults", modify: func(cfg *NvidiaNetworkConfig) {}},
+		{
+			name:   "unknown network type",
+			modify: func(cfg *NvidiaNetworkConfig) { cfg.RdmaNetworkType = "macvlan" },
+			err:    "NVIDIANETWORK_RDMA_NETWORK_TYPE must be 'sriov' or 'shared-device'",
+		},
+		{
+			name:   "bundle image missing",
+			modify: func(cfg *NvidiaNetworkConfig) { cfg.DeployFromBundle = true },
+			err:    "NVIDIANETWORK_BUNDLE_IMAGE is required",
+		},
+		{
+			name: "single NCCL node",
+			modify: func(cfg *NvidiaNetworkConfig) {
+				cfg.NcclTestImage, cfg.NcclNodeCount = "quay.io/example/nccl-tests:2.13", 1
+			},
+			err: "NVIDIANETWORK_NCCL_NODE_COUNT",
+		},
+		{
+			name:   "single NCCL node without NCCL image",
+			modify: func(cfg *NvidiaNetworkConfig) { cfg.NcclNodeCount = 1 },
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &NvidiaNetworkConfig{RdmaNetworkType: RdmaNetworkTypeSharedDevice, NcclNodeCount: 2,
+				NcclGPUsPerNode: 1, NcclTests: []string{"all_reduce_perf"}}
+			test.modify(cfg)
+
+			err := cfg.Validate()
+			if test.err == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)) {
+				t.Errorf("expected error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestNewNvidiaNetworkConfig(t *testing.T) {
+	profileFile := filepath.Join(t.TempDir(), "profile.yaml")
+
+	err := os.WriteFile(profileFile, []byte("nvidianetwork:\n  rdma_network_type: sriov\n"+
+		"  rdma_mlx_device: mlx5_0\n"), 0600)
+	if err != nil {
+		t.Fatalf("failed to write profile file: %v", err)
+	}
+
+	t.Setenv(config.ProfileFileEnvVar, profileFile)
+	t.Setenv("NVIDIANETWORK_RDMA_MLX_DEVICE", "mlx5_2")
+
+	cfg := NewNvidiaNetworkConfig()
+	if cfg == nil {
+		t.Fatalf("failed to load NvidiaNetworkConfig")
+	}
+
+	if cfg.RdmaNetworkType != RdmaNetworkTypeSriov || cfg.RdmaMlxDevice != "mlx5_2" || !cfg.CleanupAfterTest {
+		t.Errorf("expected the env variables to override the profile and the profile the defaults, got %+v", cfg)
+	}
+
+	err = os.WriteFile(profileFile, []byte("nvidianetwork:\n  rdma_network_typ: sriov\n"), 0600)
+	if err != nil {
+		t.Fatalf("failed to write profile file: %v", err)
+	}
+
+	if cfg := NewNvidiaNetworkConfig(); cfg != nil {
+		t.Errorf("expected an unknown profile key to fail the configuration, got %+v", cfg)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 var (
@@ -28,20 +29,16 @@ var (
 		"ethernet":   "rdma/rdma_shared_device_eth",
 		"infiniband": "rdma/rdma_shared_device_ib",
 	}
-
-	// image based on cluster architecture
-	debugNodePodImageName = map[string]string{
-		"amd64": "quay.io/wabouham/ecosys-nvidia/ubi9-tools:0.0.1",
-		"arm64": "quay.io/wabouham/ecosys-nvidia/ubi9-tools-arm64:0.0.1",
-	}
 )
 
 const (
 	RdmaLegacySriovResourceName corev1.ResourceName = "openshift.io/sriovlegacy"
 	gpuResourceName             corev1.ResourceName = "nvidia.com/gpu"
+
+	podLogsTimeout = 30 * time.Second
 )
 
-// CreateRdmaWorkloadPod create RDMA worker pod.
+// CreateRdmaWorkloadPod create RDMA worker pod. The pod is never restarted, so it completes once the test is done.
 func CreateRdmaWorkloadPod(name, namespace, withCuda, mode, hostname, device, crName,
 	image, linkType, serverIP string, rdmaNetworkType string) *corev1.Pod {
 
@@ -119,6 +116,7 @@ func CreateRdmaWorkloadPod(name, namespace, withCuda, mode, hostname, device, cr
 				"kubernetes.io/hostname": hostname,
 			},
 			ServiceAccountName: "rdma",
+			RestartPolicy:      corev1.RestartPolicyNever,
 			Containers: []corev1.Container{
 				{
 					Name:            name,
@@ -292,8 +290,8 @@ func ValidateRDMAResults(results map[string]string) (bool, error) {
 	return true, nil
 }
 
-// DeleteMofedRpmDir deletes mofed driver inventory dir on a specific node.
-func DeleteMofedRpmDir(clientset *clients.Settings, podName, namespace, clusterArch, nodeName string) (string, error) {
+// DeleteMofedRpmDir deletes mofed driver inventory dir on a specific node, with a pod running the debug tools image.
+func DeleteMofedRpmDir(clientset *clients.Settings, podName, namespace, image, nodeName string) (string, error) {
 
 	commands := []string{
 		"sh",
@@ -303,13 +301,13 @@ func DeleteMofedRpmDir(clientset *clients.Settings, podName, namespace, clusterA
 			"&& echo 'Successfully deleted mofed inventory';" +
 			"else echo 'Directory not found: /opt/mofed-container/inventory'; fi"}
 
-	return RunCommandsOnSpecificNode(clientset, podName, namespace, clusterArch, nodeName, commands)
+	return RunCommandsOnSpecificNode(clientset, podName, namespace, image, nodeName, commands)
 
 }
 
-// RunCommandsOnSpecificNode runs commands on a specific node by creating a pod on that node.
-
-func RunCommandsOnSpecificNode(clientset *clients.Settings, podName, namespace, clusterArch, nodeName string,
+// RunCommandsOnSpecificNode runs commands on a specific node by creating a pod running the debug tools image on that
+// node.
+func RunCommandsOnSpecificNode(clientset *clients.Settings, podName, namespace, image, nodeName string,
 	commands []string) (string, error) {
 
 	// Validate input parameters
@@ -346,7 +344,7 @@ func RunCommandsOnSpecificNode(clientset *clients.Settings, podName, namespace,
 				{
 					Name: "debugger",
 
-					Image: debugNodePodImageName[clusterArch],
+					Image: image,
 
 					Command: commands,
 					SecurityContext: &corev1.SecurityContext{
@@ -397,12 +395,20 @@ func RunCommandsOnSpecificNode(clientset *clients.Settings, podName, namespace,
 		return "", fmt.Errorf("timed out waiting for pod to complete")
 	}
 
-	// Brief delay to ensure logs are fully available
-	time.Sleep(5 * time.Second)
+	// The logs of a just completed pod are not always served right away.
+	var (
+		logs   string
+		logErr error
+	)
 
-	logs, err := GetPodLogs(clientset, namespace, podName)
-	if err != nil {
-		return "", fmt.Errorf("pod completed with phase %s but failed to get logs: %v", phase, err)
+	err = wait.PollUntilContextTimeout(context.TODO(), time.Second, podLogsTimeout, true,
+		func(ctx context.Context) (bool, error) {
+			logs, logErr = GetPodLogs(clientset, namespace, podName)
+
+			return logErr == nil && logs != "", nil
+		})
+	if err != nil && logErr != nil {
+		return "", fmt.Errorf("pod completed with phase %s but failed to get logs: %v", phase, logErr)
 	}
 
 	if phase == corev1.PodFailed {
@@ -0,0 +1,66 @@
+package rdmatest
+
+import (
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestCreateRdmaWorkloadPod(t *testing.T) {
+	for _, test := range []struct {
+		name            string
+		mode            string
+		withCuda        string
+		rdmaNetworkType string
+		resource        corev1.ResourceName
+		args            []string
+		gpus            int64
+	}{
+		{
+			name:            "shared device server",
+			mode:            "server",
+			withCuda:        "yes",
+			rdmaNetworkType: "shared-device",
+			resource:        RdmaSharedDeviceResourceName["infiniband"],
+			args:            []string{"-c", "yes", "-m", "server", "-n", "net1", "-d", "mlx5_0"},
+			gpus:            1,
+		},
+		{
+			name:            "sriov client",
+			mode:            "client",
+			withCuda:        "no",
+			rdmaNetworkType: "sriov",
+			resource:        RdmaLegacySriovResourceName,
+			args:            []string{"-c", "no", "-m", "client", "-n", "net1", "-d", "mlx5_0", "-i", "192.168.2.1"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			pod := CreateRdmaWorkloadPod("rdma-"+test.mode, "test-rdma", test.withCuda, test.mode, "worker-0",
+				"mlx5_0", "rdmashared-net", "quay.io/example/rdma:latest", "infiniband", "192.168.2.1",
+				test.rdmaNetworkType)
+
+			// The specs wait for the pod to succeed or fail, which a restarted pod never does.
+			if pod.Spec.RestartPolicy != corev1.RestartPolicyNever {
+				t.Errorf("expected restart policy %s, got %s", corev1.RestartPolicyNever, pod.Spec.RestartPolicy)
+			}
+
+			if pod.Spec.NodeSelector["kubernetes.io/hostname"] != "worker-0" ||
+				pod.Annotations["k8s.v1.cni.cncf.io/networks"] != "rdmashared-net" {
+				t.Errorf("unexpected pod placement %+v %+v", pod.Spec.NodeSelector, pod.Annotations)
+			}
+
+			container := pod.Spec.Containers[0]
+			if !slices.Equal(container.Args, test.args) {
+				t.Errorf("expected args %v, got %v", test.args, container.Args)
+			}
+
+			rdmaLimit := container.Resources.Limits[test.resource]
+			gpuLimit := container.Resources.Limits[gpuResourceName]
+
+			if rdmaLimit.Value() != 1 || gpuLimit.Value() != test.gpus {
+				t.Errorf("unexpected pod resources %+v", container.Resources.Limits)
+			}
+		})
+	}
+}
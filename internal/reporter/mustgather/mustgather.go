@@ -0,0 +1,358 @@
+package mustgather
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	nvidianetworkv1alpha1 "github.com/Mellanox/network-operator/api/v1alpha1"
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	nvidiagpuv1alpha1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1alpha1"
+	nfdv1 "github.com/openshift/cluster-nfd-operator/api/v1"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ManifestFileName is the name of the manifest file stored at the root of the archive.
+	ManifestFileName = "manifest.json"
+)
+
+var logger = logging.Logger(logging.Reporter)
+
+// Manifest describes the content of a must-gather archive.
+type Manifest struct {
+	CollectedAt time.Time `json:"collectedAt"`
+	Namespaces  []string  `json:"namespaces"`
+	Files       []File    `json:"files"`
+	Errors      []string  `json:"errors,omitempty"`
+}
+
+// File describes a single file of a must-gather archive.
+type File struct {
+	Path      string `json:"path"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Size      int    `json:"size"`
+}
+
+// NodeSummary holds the node fields relevant to the GPU and network operators.
+type NodeSummary struct {
+	Name        string              `json:"name"`
+	Labels      map[string]string   `json:"labels,omitempty"`
+	Capacity    corev1.ResourceList `json:"capacity,omitempty"`
+	Allocatable corev1.ResourceList `json:"allocatable,omitempty"`
+}
+
+// listTarget is a kind of resource collected by the Collector through the controller-runtime client.
+type listTarget struct {
+	kind       string
+	namespaced bool
+	newList    func() runtimeClient.ObjectList
+}
+
+var (
+	clusterTargets = []listTarget{
+		{kind: "ClusterPolicy", newList: func() runtimeClient.ObjectList { return &nvidiagpuv1.ClusterPolicyList{} }},
+		{kind: "NVIDIADriver", newList: func() runtimeClient.ObjectList { return &nvidiagpuv1alpha1.NVIDIADriverList{} }},
+		{kind: "NicClusterPolicy",
+			newList: func() runtimeClient.ObjectList { return &nvidianetworkv1alpha1.NicClusterPolicyList{} }},
+	}
+
+	namespacedTargets = []listTarget{
+		{kind: "NodeFeatureDiscovery", namespaced: true,
+			newList: func() runtimeClient.ObjectList { return &nfdv1.NodeFeatureDiscoveryList{} }},
+		{kind: "ClusterServiceVersion", namespaced: true,
+			newList: func() runtimeClient.ObjectList { return &olmv1alpha1.ClusterServiceVersionList{} }},
+		{kind: "Subscription", namespaced: true,
+			newList: func() runtimeClient.ObjectList { return &olmv1alpha1.SubscriptionList{} }},
+		{kind: "InstallPlan", namespaced: true,
+			newList: func() runtimeClient.ObjectList { return &olmv1alpha1.InstallPlanList{} }},
+	}
+)
+
+// Collector gathers the operator resources, pod logs, nodes and events of a cluster into a compressed archive.
+type Collector struct {
+	apiClient  *clients.Settings
+	namespaces []string
+	manifest   Manifest
+	tarWriter  *tar.Writer
+}
+
+// NewCollector returns a Collector gathering the cluster scoped resources and the resources of the given namespaces.
+func NewCollector(apiClient *clients.Settings, namespaces ...string) *Collector {
+	return &Collector{apiClient: apiClient, namespaces: namespaces}
+}
+
+// Collect writes a gzip compressed tar archive holding the gathered resources and a manifest into archivePath.
+// Resources which cannot be gathered, such as kinds whose CRD is not installed, are listed in the manifest errors
+// and do not fail the collection.
+func (collector *Collector) Collect(ctx context.Context, archivePath string) (*Manifest, error) {
+	if collector.apiClient == nil {
+		return nil, fmt.Errorf("cannot collect must-gather with nil apiClient")
+	}
+
+	if archivePath == "" {
+		return nil, fmt.Errorf("must-gather archive path cannot be empty")
+	}
+
+	logger.V(logging.LevelDebug).Info("Collecting must-gather", "archive", archivePath, "namespaces", collector.namespaces)
+
+	archiveFile, err := os.Create(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create must-gather archive %s: %w", archivePath, err)
+	}
+
+	defer func() {
+		_ = archiveFile.Close()
+	}()
+
+	gzipWriter := gzip.NewWriter(archiveFile)
+	collector.tarWriter = tar.NewWriter(gzipWriter)
+	collector.manifest = Manifest{CollectedAt: time.Now().UTC(), Namespaces: collector.namespaces}
+
+	for _, target := range clusterTargets {
+		collector.collectList(ctx, target, "")
+	}
+
+	collector.collectNodes(ctx)
+
+	for _, namespace := range collector.namespaces {
+		for _, target := range namespacedTargets {
+			collector.collectList(ctx, target, namespace)
+		}
+
+		collector.collectDaemonSets(ctx, namespace)
+		collector.collectPods(ctx, namespace)
+		collector.collectEvents(ctx, namespace)
+	}
+
+	manifestContent, err := json.MarshalIndent(collector.manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal must-gather manifest: %w", err)
+	}
+
+	if err := collector.writeFile(ManifestFileName, manifestContent); err != nil {
+		return nil, err
+	}
+
+	if err := collector.tarWriter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close must-gather archive: %w", err)
+	}
+
+	if err := gzipWriter.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress must-gather archive: %w", err)
+	}
+
+	logger.V(logging.LevelDebug).Info("Collected must-gather", "archive", archivePath,
+		"files", len(collector.manifest.Files), "errors", len(collector.manifest.Errors))
+
+	return &collector.manifest, nil
+}
+
+func (collector *Collector) collectList(ctx context.Context, target listTarget, namespace string) {
+	list := target.newList()
+
+	var listOptions []runtimeClient.ListOption
+	if target.namespaced {
+		listOptions = append(listOptions, runtimeClient.InNamespace(namespace))
+	}
+
+	if err := collector.apiClient.Client.List(ctx, list, listOptions...); err != nil {
+		collector.addError(fmt.Errorf("failed to list %s in namespace '%s': %w", target.kind, namespace, err))
+
+		return
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		collector.addError(fmt.Errorf("failed to extract %s list: %w", target.kind, err))
+
+		return
+	}
+
+	for _, item := range items {
+		collector.addObject(target.kind, item)
+	}
+}
+
+func (collector *Collector) collectDaemonSets(ctx context.Context, namespace string) {
+	daemonSets, err := collector.apiClient.AppsV1Interface.DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		collector.addError(fmt.Errorf("failed to list DaemonSets in namespace '%s': %w", namespace, err))
+
+		return
+	}
+
+	for index := range daemonSets.Items {
+		collector.addObject("DaemonSet", &daemonSets.Items[index])
+	}
+}
+
+func (collector *Collector) collectPods(ctx context.Context, namespace string) {
+	pods, err := collector.apiClient.CoreV1Interface.Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		collector.addError(fmt.Errorf("failed to list Pods in namespace '%s': %w", namespace, err))
+
+		return
+	}
+
+	for index := range pods.Items {
+		pod := &pods.Items[index]
+		collector.addObject("Pod", pod)
+
+		for _, status := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			collector.collectContainerLog(ctx, pod, status.Name, false)
+
+			if status.RestartCount > 0 {
+				collector.collectContainerLog(ctx, pod, status.Name, true)
+			}
+		}
+	}
+}
+
+func (collector *Collector) collectContainerLog(ctx context.Context, pod *corev1.Pod, container string, previous bool) {
+	logs, err := collector.apiClient.CoreV1Interface.Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: container,
+		Previous:  previous,
+	}).DoRaw(ctx)
+	if err != nil {
+		collector.addError(fmt.Errorf("failed to get logs of container %s of pod %s in namespace '%s': %w",
+			container, pod.Name, pod.Namespace, err))
+
+		return
+	}
+
+	fileName := container + ".log"
+	if previous {
+		fileName = container + ".previous.log"
+	}
+
+	collector.add(File{
+		Path:      path.Join("namespaces", pod.Namespace, "logs", pod.Name, fileName),
+		Kind:      "ContainerLog",
+		Name:      pod.Name + "/" + container,
+		Namespace: pod.Namespace,
+	}, logs)
+}
+
+func (collector *Collector) collectNodes(ctx context.Context) {
+	nodes, err := collector.apiClient.CoreV1Interface.Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		collector.addError(fmt.Errorf("failed to list Nodes: %w", err))
+
+		return
+	}
+
+	for _, node := range nodes.Items {
+		content, err := yaml.Marshal(NodeSummary{
+			Name:        node.Name,
+			Labels:      node.Labels,
+			Capacity:    node.Status.Capacity,
+			Allocatable: node.Status.Allocatable,
+		})
+		if err != nil {
+			collector.addError(fmt.Errorf("failed to marshal Node %s: %w", node.Name, err))
+
+			continue
+		}
+
+		collector.add(File{Path: path.Join("cluster", "nodes", node.Name+".yaml"), Kind: "Node", Name: node.Name}, content)
+	}
+}
+
+func (collector *Collector) collectEvents(ctx context.Context, namespace string) {
+	events, err := collector.apiClient.CoreV1Interface.Events(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		collector.addError(fmt.Errorf("failed to list Events in namespace '%s': %w", namespace, err))
+
+		return
+	}
+
+	content, err := yaml.Marshal(events.Items)
+	if err != nil {
+		collector.addError(fmt.Errorf("failed to marshal Events in namespace '%s': %w", namespace, err))
+
+		return
+	}
+
+	collector.add(File{Path: path.Join("namespaces", namespace, "events.yaml"), Kind: "EventList",
+		Namespace: namespace}, content)
+}
+
+func (collector *Collector) addObject(kind string, object runtime.Object) {
+	metaObject, err := meta.Accessor(object)
+	if err != nil {
+		collector.addError(fmt.Errorf("failed to access %s metadata: %w", kind, err))
+
+		return
+	}
+
+	content, err := yaml.Marshal(object)
+	if err != nil {
+		collector.addError(fmt.Errorf("failed to marshal %s %s: %w", kind, metaObject.GetName(), err))
+
+		return
+	}
+
+	filePath := path.Join("cluster", kind, metaObject.GetName()+".yaml")
+	if metaObject.GetNamespace() != "" {
+		filePath = path.Join("namespaces", metaObject.GetNamespace(), kind, metaObject.GetName()+".yaml")
+	}
+
+	collector.add(File{
+		Path:      filePath,
+		Kind:      kind,
+		Name:      metaObject.GetName(),
+		Namespace: metaObject.GetNamespace(),
+	}, content)
+}
+
+func (collector *Collector) add(file File, content []byte) {
+	if err := collector.writeFile(file.Path, content); err != nil {
+		collector.addError(err)
+
+		return
+	}
+
+	file.Size = len(content)
+	collector.manifest.Files = append(collector.manifest.Files, file)
+}
+
+func (collector *Collector) addError(err error) {
+	logger.V(logging.LevelDebug).Info("Must-gather collection error", "error", err.Error())
+
+	collector.manifest.Errors = append(collector.manifest.Errors, err.Error())
+}
+
+func (collector *Collector) writeFile(filePath string, content []byte) error {
+	header := &tar.Header{
+		Name:    filePath,
+		Mode:    0644,
+		Size:    int64(len(content)),
+		ModTime: collector.manifest.CollectedAt,
+	}
+
+	if err := collector.tarWriter.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write must-gather archive header of %s: %w", filePath, err)
+	}
+
+	if _, err := collector.tarWriter.Write(content); err != nil {
+		return fmt.Errorf("failed to write must-gather archive file %s: %w", filePath, err)
+	}
+
+	return nil
+}
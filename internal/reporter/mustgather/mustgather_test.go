@@ -0,0 +1,135 @@
+package mustgather
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	olmv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testNamespace = "nvidia-gpu-operator"
+
+func TestCollect(t *testing.T) {
+	apiClient := clients.NewFakeSettings(
+		&nvidiagpuv1.ClusterPolicy{ObjectMeta: metav1.ObjectMeta{Name: "gpu-cluster-policy"}},
+		&olmv1alpha1.Subscription{ObjectMeta: metav1.ObjectMeta{Name: "gpu-operator-certified", Namespace: testNamespace}},
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "nvidia-driver-daemonset", Namespace: testNamespace}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "nvidia-driver-daemonset-abcde", Namespace: testNamespace},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "nvidia-driver-ctr", RestartCount: 1},
+			}},
+		},
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Labels: map[string]string{"nvidia.com/gpu.present": "true"}},
+			Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{
+				"nvidia.com/gpu": resource.MustParse("1"),
+			}},
+		},
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "driver-started", Namespace: testNamespace}},
+	)
+
+	archivePath := filepath.Join(t.TempDir(), "must-gather.tar.gz")
+
+	manifest, err := NewCollector(apiClient, testNamespace).Collect(context.TODO(), archivePath)
+	if err != nil {
+		t.Fatalf("unexpected error collecting must-gather: %v", err)
+	}
+
+	archiveFiles := readArchive(t, archivePath)
+
+	expectedFiles := []string{
+		"cluster/ClusterPolicy/gpu-cluster-policy.yaml",
+		"cluster/nodes/worker-0.yaml",
+		"namespaces/nvidia-gpu-operator/Subscription/gpu-operator-certified.yaml",
+		"namespaces/nvidia-gpu-operator/DaemonSet/nvidia-driver-daemonset.yaml",
+		"namespaces/nvidia-gpu-operator/Pod/nvidia-driver-daemonset-abcde.yaml",
+		"namespaces/nvidia-gpu-operator/logs/nvidia-driver-daemonset-abcde/nvidia-driver-ctr.log",
+		"namespaces/nvidia-gpu-operator/logs/nvidia-driver-daemonset-abcde/nvidia-driver-ctr.previous.log",
+		"namespaces/nvidia-gpu-operator/events.yaml",
+		ManifestFileName,
+	}
+
+	for _, expectedFile := range expectedFiles {
+		if _, ok := archiveFiles[expectedFile]; !ok {
+			t.Errorf("expected file %s in archive", expectedFile)
+		}
+	}
+
+	if len(manifest.Files) != len(expectedFiles)-1 {
+		t.Errorf("expected %d files in manifest, got %d: %+v", len(expectedFiles)-1, len(manifest.Files), manifest.Files)
+	}
+
+	if len(manifest.Errors) != 0 {
+		t.Errorf("unexpected manifest errors: %v", manifest.Errors)
+	}
+
+	var archivedManifest Manifest
+	if err := json.Unmarshal(archiveFiles[ManifestFileName], &archivedManifest); err != nil {
+		t.Fatalf("unexpected error parsing archived manifest: %v", err)
+	}
+
+	if len(archivedManifest.Files) != len(manifest.Files) {
+		t.Errorf("expected archived manifest to list %d files, got %d", len(manifest.Files), len(archivedManifest.Files))
+	}
+}
+
+func TestCollectNilClient(t *testing.T) {
+	if _, err := NewCollector(nil).Collect(context.TODO(), filepath.Join(t.TempDir(), "archive.tar.gz")); err == nil {
+		t.Error("expected error collecting must-gather with nil apiClient")
+	}
+}
+
+func readArchive(t *testing.T, archivePath string) map[string][]byte {
+	t.Helper()
+
+	archiveFile, err := os.Open(archivePath)
+	if err != nil {
+		t.Fatalf("unexpected error opening archive: %v", err)
+	}
+
+	defer func() {
+		_ = archiveFile.Close()
+	}()
+
+	gzipReader, err := gzip.NewReader(archiveFile)
+	if err != nil {
+		t.Fatalf("unexpected error decompressing archive: %v", err)
+	}
+
+	files := map[string][]byte{}
+	tarReader := tar.NewReader(gzipReader)
+
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			t.Fatalf("unexpected error reading archive: %v", err)
+		}
+
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			t.Fatalf("unexpected error reading archive file %s: %v", header.Name, err)
+		}
+
+		files[header.Name] = content
+	}
+
+	return files
+}
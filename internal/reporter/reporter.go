@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -16,11 +17,17 @@ import (
 	"github.com/golang/glog"
 	"github.com/onsi/ginkgo/v2/types"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/reporter/mustgather"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 var (
 	pathToPodExecLogs = "/tmp/pod_exec_logs.log"
+
+	mustGatherArchiveFormat = "must-gather-%s.tar.gz"
+
+	logger = logging.Logger(logging.Reporter)
 )
 
 func newReporter(
@@ -134,6 +141,31 @@ func removeFile(fPath string) error {
 	return nil
 }
 
+// CollectMustGatherIfFailed gathers the operator resources, pod logs, nodes and events of the given namespaces
+// into a compressed archive in the report directory if TC is failed. Unlike RunMustGather it does not need any
+// external script, so it also works in disconnected environments.
+func CollectMustGatherIfFailed(ctx context.Context, report types.SpecReport, nSpaces map[string]string) {
+	if !types.SpecStateFailureStates.Is(report.State) {
+		return
+	}
+
+	namespaces := make([]string, 0, len(nSpaces))
+	for namespace := range nSpaces {
+		namespaces = append(namespaces, namespace)
+	}
+
+	sort.Strings(namespaces)
+
+	tcReportName := strings.NewReplacer(" ", "_", "/", "_").Replace(report.FullText())
+	archiveName := fmt.Sprintf(mustGatherArchiveFormat, tcReportName)
+
+	_, err := mustgather.NewCollector(inittools.APIClient, namespaces...).Collect(
+		ctx, inittools.GeneralConfig.GetReportPath(archiveName))
+	if err != nil {
+		logger.Error(err, "Failed to collect must-gather archive", "archive", archiveName)
+	}
+}
+
 func RunMustGather(artifactDir, mustGatherScriptPath string, timeout time.Duration) error {
 	if artifactDir == "" {
 		return fmt.Errorf("artifact directory cannot be empty")
@@ -146,13 +178,15 @@ func RunMustGather(artifactDir, mustGatherScriptPath string, timeout time.Durati
 	cmd.Env = append(os.Environ(), fmt.Sprintf("ARTIFACT_DIR=%s", artifactDir))
 	output, err := cmd.CombinedOutput()
 	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
-		glog.Errorf("%s script timed out: %v", mustGatherScriptPath, ctx.Err())
+		logger.Error(ctx.Err(), "Must gather script timed out", "script", mustGatherScriptPath)
 		return fmt.Errorf("must gather script timed out: %w", ctx.Err())
 	}
 	if err != nil {
-		glog.Errorf("Error running %s script: %v\nOutput: %s", mustGatherScriptPath, err, output)
+		logger.Error(err, "Error running must gather script", "script", mustGatherScriptPath,
+			"output", string(output))
 		return fmt.Errorf("error running must gather script: %w", err)
 	}
-	glog.V(100).Infof("must gather script output: %s", output)
+	logger.V(logging.LevelTrace).Info("Must gather script output", "script", mustGatherScriptPath,
+		"output", string(output))
 	return nil
 }
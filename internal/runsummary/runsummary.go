@@ -0,0 +1,279 @@
+package runsummary
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/onsi/ginkgo/v2/types"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/config"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nodes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// SchemaVersion is the version of the run summary schema. It is bumped on every incompatible change.
+	SchemaVersion = "v1"
+	// FileNameFormat is the name format of the run summary file of a suite written in the report directory. The
+	// suites run by a single ginkgo command share the report directory, so every suite writes its own file.
+	FileNameFormat = "run-summary-%s.json"
+	// GPULabelPrefix is the prefix of the node labels reported for every GPU node.
+	GPULabelPrefix = "nvidia.com/gpu."
+)
+
+// Summary is the machine-readable summary of a test suite run.
+type Summary struct {
+	mutex sync.Mutex
+
+	SchemaVersion       string    `json:"schemaVersion"`
+	Suite               string    `json:"suite"`
+	StartTime           time.Time `json:"startTime"`
+	EndTime             time.Time `json:"endTime,omitempty"`
+	OpenShiftVersion    string    `json:"openshiftVersion,omitempty"`
+	ClusterArchitecture string    `json:"clusterArchitecture,omitempty"`
+	Operator            *Operator `json:"operator,omitempty"`
+	ClusterPolicyHash   string    `json:"clusterPolicyHash,omitempty"`
+	Nodes               []Node    `json:"nodes,omitempty"`
+	Specs               []Spec    `json:"specs"`
+	Metrics             []Metric  `json:"metrics,omitempty"`
+}
+
+// Operator describes the operator deployed by the suite.
+type Operator struct {
+	CSV                    string `json:"csv"`
+	Version                string `json:"version"`
+	Channel                string `json:"channel,omitempty"`
+	CatalogSource          string `json:"catalogSource,omitempty"`
+	CatalogSourceNamespace string `json:"catalogSourceNamespace,omitempty"`
+}
+
+// Node holds the GPU labels of a GPU enabled node.
+type Node struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels"`
+}
+
+// Spec holds the outcome of a single spec.
+type Spec struct {
+	Name            string   `json:"name"`
+	Labels          []string `json:"labels,omitempty"`
+	State           string   `json:"state"`
+	DurationSeconds float64  `json:"durationSeconds"`
+	Failure         string   `json:"failure,omitempty"`
+}
+
+// Metric is a workload measurement, such as gpu-burn Gflop/s or ib_write_bw bandwidth.
+type Metric struct {
+	Name   string            `json:"name"`
+	Value  float64           `json:"value"`
+	Unit   string            `json:"unit"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// New returns an empty Summary of the given suite.
+func New(suite string) *Summary {
+	return &Summary{SchemaVersion: SchemaVersion, Suite: suite, StartTime: time.Now().UTC(), Specs: []Spec{}}
+}
+
+// SetOpenShiftVersion sets the OpenShift version of the cluster.
+func (summary *Summary) SetOpenShiftVersion(version string) {
+	summary.mutex.Lock()
+	defer summary.mutex.Unlock()
+
+	summary.OpenShiftVersion = version
+}
+
+// SetClusterArchitecture sets the architecture of the cluster worker nodes.
+func (summary *Summary) SetClusterArchitecture(architecture string) {
+	summary.mutex.Lock()
+	defer summary.mutex.Unlock()
+
+	summary.ClusterArchitecture = architecture
+}
+
+// SetOperator sets the deployed operator.
+func (summary *Summary) SetOperator(operator Operator) {
+	summary.mutex.Lock()
+	defer summary.mutex.Unlock()
+
+	summary.Operator = &operator
+}
+
+// SetClusterPolicySpec sets the hash of the given ClusterPolicy spec so that runs with the same spec can be grouped.
+func (summary *Summary) SetClusterPolicySpec(spec interface{}) error {
+	hash, err := SpecHash(spec)
+	if err != nil {
+		return err
+	}
+
+	summary.mutex.Lock()
+	defer summary.mutex.Unlock()
+
+	summary.ClusterPolicyHash = hash
+
+	return nil
+}
+
+// AddMetric appends a workload metric.
+func (summary *Summary) AddMetric(name string, value float64, unit string, metricLabels map[string]string) {
+	summary.mutex.Lock()
+	defer summary.mutex.Unlock()
+
+	summary.Metrics = append(summary.Metrics, Metric{Name: name, Value: value, Unit: unit, Labels: metricLabels})
+}
+
+// AddSpecReports appends the outcome of the given specs. Specs which were not run are ignored.
+func (summary *Summary) AddSpecReports(reports types.SpecReports) {
+	summary.mutex.Lock()
+	defer summary.mutex.Unlock()
+
+	for _, report := range reports {
+		if report.LeafNodeType != types.NodeTypeIt || report.State == types.SpecStateSkipped {
+			continue
+		}
+
+		spec := Spec{
+			Name:            report.FullText(),
+			Labels:          report.Labels(),
+			State:           report.State.String(),
+			DurationSeconds: report.RunTime.Seconds(),
+		}
+
+		if report.State.Is(types.SpecStateFailureStates) {
+			spec.Failure = report.Failure.Message
+		}
+
+		summary.Specs = append(summary.Specs, spec)
+	}
+}
+
+// CollectOperator sets the operator from the ClusterServiceVersion installed by the Subscription found in the given
+// namespace. Without Subscription, the operator is set from the single ClusterServiceVersion of the namespace and the
+// channel and catalog source are left empty.
+func (summary *Summary) CollectOperator(ctx context.Context, apiClient *clients.Settings, namespace string) error {
+	subscriptions, err := apiClient.Subscriptions(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list Subscriptions in namespace '%s': %w", namespace, err)
+	}
+
+	for _, subscription := range subscriptions.Items {
+		if subscription.Spec == nil || subscription.Status.InstalledCSV == "" {
+			continue
+		}
+
+		// Mid-upgrade and with copied CSVs of cluster-wide operators the namespace holds several CSVs.
+		csv, err := apiClient.ClusterServiceVersions(namespace).Get(ctx, subscription.Status.InstalledCSV,
+			metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get ClusterServiceVersion '%s' installed by Subscription '%s': %w",
+				subscription.Status.InstalledCSV, subscription.Name, err)
+		}
+
+		summary.SetOperator(Operator{
+			CSV:                    csv.Name,
+			Version:                csv.Spec.Version.String(),
+			Channel:                subscription.Spec.Channel,
+			CatalogSource:          subscription.Spec.CatalogSource,
+			CatalogSourceNamespace: subscription.Spec.CatalogSourceNamespace,
+		})
+
+		return nil
+	}
+
+	csvList, err := apiClient.ClusterServiceVersions(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list ClusterServiceVersions in namespace '%s': %w", namespace, err)
+	}
+
+	if len(csvList.Items) != 1 {
+		return fmt.Errorf("expected exactly one ClusterServiceVersion in namespace '%s' without Subscription, "+
+			"found %d", namespace, len(csvList.Items))
+	}
+
+	summary.SetOperator(Operator{
+		CSV:     csvList.Items[0].Name,
+		Version: csvList.Items[0].Spec.Version.String(),
+	})
+
+	return nil
+}
+
+// CollectNodes sets the nodes matching the given selector with their GPU labels.
+func (summary *Summary) CollectNodes(ctx context.Context, apiClient *clients.Settings, selector map[string]string) error {
+	nodeBuilders, err := nodes.ListWithContext(ctx, apiClient, metav1.ListOptions{
+		LabelSelector: labels.Set(selector).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list nodes with selector %v: %w", selector, err)
+	}
+
+	var gpuNodes []Node
+
+	for _, nodeBuilder := range nodeBuilders {
+		gpuLabels := map[string]string{}
+
+		for key, value := range nodeBuilder.Object.Labels {
+			if strings.HasPrefix(key, GPULabelPrefix) {
+				gpuLabels[key] = value
+			}
+		}
+
+		gpuNodes = append(gpuNodes, Node{Name: nodeBuilder.Object.Name, Labels: gpuLabels})
+	}
+
+	sort.Slice(gpuNodes, func(i, j int) bool {
+		return gpuNodes[i].Name < gpuNodes[j].Name
+	})
+
+	summary.mutex.Lock()
+	defer summary.mutex.Unlock()
+
+	summary.Nodes = gpuNodes
+
+	return nil
+}
+
+// Marshal returns the JSON encoding of the summary and sets its end time.
+func (summary *Summary) Marshal() ([]byte, error) {
+	summary.mutex.Lock()
+	defer summary.mutex.Unlock()
+
+	summary.EndTime = time.Now().UTC()
+
+	return json.MarshalIndent(summary, "", "  ")
+}
+
+// FileName returns the name of the run summary file of the suite, such as run-summary-gpu.json.
+func (summary *Summary) FileName() string {
+	return fmt.Sprintf(FileNameFormat, strings.ToLower(summary.Suite))
+}
+
+// Write writes the summary into the run summary file of the suite in the report directory.
+func (summary *Summary) Write(cfg *config.GeneralConfig) error {
+	content, err := summary.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal run summary: %w", err)
+	}
+
+	return cfg.WriteReport(summary.FileName(), content)
+}
+
+// SpecHash returns the hex encoded sha256 of the JSON encoding of the given spec.
+func SpecHash(spec interface{}) (string, error) {
+	content, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal spec: %w", err)
+	}
+
+	hash := sha256.Sum256(content)
+
+	return hex.EncodeToString(hash[:]), nil
+}
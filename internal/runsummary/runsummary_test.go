@@ -0,0 +1,150 @@
+package runsummary
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/onsi/ginkgo/v2/types"
+	"github.com/operator-framework/api/pkg/lib/version"
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestMarshal(t *testing.T) {
+	summary := New("GPU")
+	summary.SetOpenShiftVersion("4.17.12")
+	summary.SetClusterArchitecture("amd64")
+	summary.SetOperator(Operator{CSV: "gpu-operator-certified.v24.9.2", Version: "24.9.2", Channel: "v24.9"})
+	summary.AddMetric("gpu-burn", 12345.5, "Gflop/s", map[string]string{"gpu": "0"})
+	summary.AddSpecReports(types.SpecReports{
+		{LeafNodeType: types.NodeTypeIt, LeafNodeText: "Deploy GPU Operator", State: types.SpecStatePassed,
+			RunTime: 90 * time.Second},
+		{LeafNodeType: types.NodeTypeIt, LeafNodeText: "Upgrade GPU Operator", State: types.SpecStateSkipped},
+		{LeafNodeType: types.NodeTypeBeforeSuite, State: types.SpecStatePassed},
+	})
+
+	if err := summary.SetClusterPolicySpec(map[string]string{"driver": "enabled"}); err != nil {
+		t.Fatalf("unexpected error hashing spec: %v", err)
+	}
+
+	content, err := summary.Marshal()
+	if err != nil {
+		t.Fatalf("unexpected error marshalling summary: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(content, &decoded); err != nil {
+		t.Fatalf("unexpected error parsing summary: %v", err)
+	}
+
+	for _, key := range []string{"schemaVersion", "suite", "startTime", "endTime", "openshiftVersion",
+		"clusterArchitecture", "operator", "clusterPolicyHash", "specs", "metrics"} {
+		if _, ok := decoded[key]; !ok {
+			t.Errorf("expected key %s in run summary", key)
+		}
+	}
+
+	if decoded["schemaVersion"] != SchemaVersion {
+		t.Errorf("expected schema version %s, got %v", SchemaVersion, decoded["schemaVersion"])
+	}
+
+	if len(summary.Specs) != 1 || summary.Specs[0].State != "passed" || summary.Specs[0].DurationSeconds != 90 {
+		t.Errorf("unexpected specs: %+v", summary.Specs)
+	}
+
+	if summary.FileName() != "run-summary-gpu.json" {
+		t.Errorf("unexpected run summary file name %s", summary.FileName())
+	}
+}
+
+func TestSpecHash(t *testing.T) {
+	firstHash, err := SpecHash(map[string]bool{"mig": true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	secondHash, _ := SpecHash(map[string]bool{"mig": true})
+	otherHash, _ := SpecHash(map[string]bool{"mig": false})
+
+	if firstHash != secondHash {
+		t.Errorf("expected identical specs to have the same hash")
+	}
+
+	if firstHash == otherHash {
+		t.Errorf("expected different specs to have different hashes")
+	}
+}
+
+func TestCollectOperator(t *testing.T) {
+	newCSV := func(name, csvVersion string) *operatorsv1alpha1.ClusterServiceVersion {
+		return &operatorsv1alpha1.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "gpu-operator"},
+			Spec: operatorsv1alpha1.ClusterServiceVersionSpec{
+				Version: version.OperatorVersion{Version: semver.MustParse(csvVersion)},
+			},
+		}
+	}
+
+	subscription := &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu-subscription", Namespace: "gpu-operator"},
+		Spec: &operatorsv1alpha1.SubscriptionSpec{Channel: "v25.3", CatalogSource: "certified-operators",
+			CatalogSourceNamespace: "openshift-marketplace"},
+		Status: operatorsv1alpha1.SubscriptionStatus{InstalledCSV: "gpu-operator-certified.v25.3.0"},
+	}
+
+	for _, test := range []struct {
+		name     string
+		objects  []runtime.Object
+		expected Operator
+		err      bool
+	}{
+		{
+			name: "upgrade in progress",
+			objects: []runtime.Object{newCSV("gpu-operator-certified.v24.9.2", "24.9.2"),
+				newCSV("gpu-operator-certified.v25.3.0", "25.3.0"), subscription},
+			expected: Operator{CSV: "gpu-operator-certified.v25.3.0", Version: "25.3.0", Channel: "v25.3",
+				CatalogSource: "certified-operators", CatalogSourceNamespace: "openshift-marketplace"},
+		},
+		{
+			name:     "bundle without subscription",
+			objects:  []runtime.Object{newCSV("gpu-operator-certified.v25.3.0", "25.3.0")},
+			expected: Operator{CSV: "gpu-operator-certified.v25.3.0", Version: "25.3.0"},
+		},
+		{
+			name: "several CSVs without subscription",
+			objects: []runtime.Object{newCSV("gpu-operator-certified.v24.9.2", "24.9.2"),
+				newCSV("gpu-operator-certified.v25.3.0", "25.3.0")},
+			err: true,
+		},
+		{
+			name:    "installed CSV missing",
+			objects: []runtime.Object{subscription},
+			err:     true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			summary := New("GPU")
+
+			err := summary.CollectOperator(t.Context(), clients.NewFakeSettings(test.objects...), "gpu-operator")
+			if test.err {
+				if err == nil {
+					t.Errorf("expected error collecting the operator")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error collecting the operator: %v", err)
+			}
+
+			if summary.Operator == nil || *summary.Operator != test.expected {
+				t.Errorf("expected operator %+v, got %+v", test.expected, summary.Operator)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package soak
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kind is the kind of a GPU error event.
+type Kind string
+
+const (
+	// KindXid is an NVIDIA driver Xid error of a GPU.
+	KindXid Kind = "Xid"
+	// KindSXid is an NVSwitch SXid error.
+	KindSXid Kind = "SXid"
+	// KindECC is a GPU memory ECC event.
+	KindECC Kind = "ECC"
+	// KindFellOffBus is a GPU which fell off the PCIe bus and is lost until the node is rebooted.
+	KindFellOffBus Kind = "FellOffBus"
+	// KindWorkload is a soak workload pod which failed or whose output did not meet its thresholds.
+	KindWorkload Kind = "Workload"
+	// KindScan is a kernel or driver log scan which failed, so the GPU errors of the node may go unnoticed.
+	KindScan Kind = "Scan"
+)
+
+var (
+	xidRegexp  = regexp.MustCompile(`NVRM: Xid \(PCI:([^)]+)\): ([0-9]+),\s*(.*)$`)
+	sxidRegexp = regexp.MustCompile(`SXid \(PCI:([^)]+)\): ([0-9]+),\s*(.*)$`)
+	pciRegexp  = regexp.MustCompile(`(?i)\b([0-9a-f]{4,8}:[0-9a-f]{2}:[0-9a-f]{2}(?:\.[0-9a-f])?)\b`)
+	eccRegexp  = regexp.MustCompile(`(?i)NVRM: .*\b(?:ECC|DBE|SBE)\b`)
+	lostRegexp = regexp.MustCompile(`(?i)fallen off the bus|GPU is lost`)
+
+	// dmesgTimeRegexp matches the "[ 1234.567890] " seconds since boot prefix of the default dmesg output.
+	dmesgTimeRegexp = regexp.MustCompile(`^\[\s*([0-9]+\.[0-9]+)\]\s*`)
+
+	// eccXids are the Xids reporting ECC events.
+	eccXids = map[int]bool{48: true, 63: true, 64: true, 92: true, 94: true, 95: true, 140: true}
+
+	// xidDescriptions are the descriptions of the most common Xids, from the NVIDIA Xid catalog.
+	xidDescriptions = map[int]string{
+		13:  "Graphics engine exception",
+		31:  "GPU memory page fault",
+		32:  "Invalid or corrupted push buffer stream",
+		43:  "GPU stopped processing",
+		45:  "Preemptive cleanup, due to previous errors",
+		48:  "Double bit ECC error",
+		61:  "Internal micro-controller breakpoint/warning",
+		62:  "Internal micro-controller halt",
+		63:  "ECC page retirement or row remapping recording event",
+		64:  "ECC page retirement or row remapper recording failure",
+		68:  "Video processor exception",
+		69:  "Graphics engine class error",
+		74:  "NVLink error",
+		79:  "GPU has fallen off the bus",
+		92:  "High single-bit ECC error rate",
+		94:  "Contained ECC error",
+		95:  "Uncontained ECC error",
+		119: "GSP RPC timeout",
+		120: "GSP error",
+		140: "Unrecovered ECC error",
+	}
+)
+
+// Event is a GPU error found in a kernel or driver log, or a failed soak workload pod.
+type Event struct {
+	Time time.Time `json:"time"`
+	Node string    `json:"node"`
+	// Source is where the event was found: the kernel log, a driver pod or a workload pod.
+	Source string `json:"source"`
+	// Device is the PCI address of the GPU or NVSwitch, empty when the event does not name one.
+	Device string `json:"device,omitempty"`
+	Kind   Kind   `json:"kind"`
+	// Code is the Xid or SXid number, 0 for the events without one.
+	Code        int    `json:"code,omitempty"`
+	Description string `json:"description,omitempty"`
+	Message     string `json:"message"`
+	// Ignored is true for the Xids the soak run is configured to ignore.
+	Ignored bool `json:"ignored,omitempty"`
+
+	// key identifies the log line of the event across the scans: its timestamp as logged, and its message.
+	key string
+	// sinceBoot is the seconds since boot timestamp of a default dmesg output line.
+	sinceBoot time.Duration
+}
+
+// Classify returns the GPU error event of a kernel or driver log line, without its timestamp. It returns false if
+// the line does not report a GPU error.
+func Classify(line string) (Event, bool) {
+	line = strings.TrimSpace(line)
+
+	if match := xidRegexp.FindStringSubmatch(line); match != nil {
+		code, _ := strconv.Atoi(match[2])
+		event := Event{Kind: KindXid, Device: match[1], Code: code, Description: xidDescriptions[code],
+			Message: line}
+
+		switch {
+		case code == 79:
+			event.Kind = KindFellOffBus
+		case eccXids[code]:
+			event.Kind = KindECC
+		}
+
+		return event, true
+	}
+
+	if match := sxidRegexp.FindStringSubmatch(line); match != nil {
+		code, _ := strconv.Atoi(match[2])
+
+		return Event{Kind: KindSXid, Device: match[1], Code: code, Description: match[3], Message: line}, true
+	}
+
+	if lostRegexp.MatchString(line) {
+		return Event{Kind: KindFellOffBus, Device: pciDevice(line), Description: xidDescriptions[79],
+			Message: line}, true
+	}
+
+	if eccRegexp.MatchString(line) {
+		return Event{Kind: KindECC, Device: pciDevice(line), Message: line}, true
+	}
+
+	return Event{}, false
+}
+
+// ParseLog returns the GPU error events of a kernel or driver log. The lines may start with a "dmesg --time-format
+// iso" or a pod log RFC 3339 timestamp, the events of the lines with a dmesg seconds since boot timestamp or without
+// timestamp having a zero time.
+func ParseLog(output string) []Event {
+	var events []Event
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		timestamp, eventTime, message := splitTimestamp(line)
+
+		event, found := Classify(message)
+		if !found {
+			continue
+		}
+
+		event.Time = eventTime
+		event.key = timestamp + "\x00" + event.Message
+
+		if match := dmesgTimeRegexp.FindStringSubmatch(line); match != nil {
+			event.sinceBoot, _ = parseSeconds(match[1])
+		}
+
+		events = append(events, event)
+	}
+
+	return events
+}
+
+// ParseKernelLog returns the GPU error events of the kernel log printed by the soak node debug pods: the node time and
+// uptime in seconds, followed by the default dmesg output, whose lines start with their seconds since boot. Unlike
+// the wall clock time dmesg derives from them, these seconds do not change when the node clock is stepped, so they
+// identify the events across the scans, while the event time is derived from the boot time of the node.
+func ParseKernelLog(output string) ([]Event, error) {
+	lines := strings.SplitN(output, "\n", 3)
+	if len(lines) < 2 {
+		return nil, fmt.Errorf("kernel log misses the node time and uptime: %q", output)
+	}
+
+	nodeTime, err := parseSeconds(lines[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the node time: %w", err)
+	}
+
+	uptime, err := parseSeconds(lines[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the node uptime: %w", err)
+	}
+
+	bootTime := time.Unix(0, 0).Add(nodeTime - uptime).UTC()
+
+	var dmesgOutput string
+	if len(lines) == 3 {
+		dmesgOutput = lines[2]
+	}
+
+	events := ParseLog(dmesgOutput)
+	for index := range events {
+		if events[index].Time.IsZero() {
+			events[index].Time = bootTime.Add(events[index].sinceBoot)
+		}
+	}
+
+	return events, nil
+}
+
+// splitTimestamp returns the timestamp the line starts with, as logged and parsed, and the rest of the line. The
+// parsed time is zero for a dmesg seconds since boot timestamp.
+func splitTimestamp(line string) (string, time.Time, string) {
+	if match := dmesgTimeRegexp.FindStringSubmatch(line); match != nil {
+		return match[1], time.Time{}, line[len(match[0]):]
+	}
+
+	timestamp, message, found := strings.Cut(line, " ")
+	if !found {
+		return "", time.Time{}, line
+	}
+
+	// dmesg separates the fractional seconds with a comma, as in 2024-05-02T10:11:12,345678+00:00.
+	layoutTimestamp := strings.Replace(timestamp, ",", ".", 1)
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999-0700"} {
+		if eventTime, err := time.Parse(layout, layoutTimestamp); err == nil {
+			return timestamp, eventTime.UTC(), strings.TrimSpace(message)
+		}
+	}
+
+	return "", time.Time{}, line
+}
+
+// lastTimestamp returns the time of the last line of the log with a timestamp, zero if it has none.
+func lastTimestamp(output string) time.Time {
+	lines := strings.Split(output, "\n")
+
+	for index := len(lines) - 1; index >= 0; index-- {
+		if _, lineTime, _ := splitTimestamp(strings.TrimSpace(lines[index])); !lineTime.IsZero() {
+			return lineTime
+		}
+	}
+
+	return time.Time{}
+}
+
+// parseSeconds parses a number of seconds with a fractional part, such as 1234.567890.
+func parseSeconds(value string) (time.Duration, error) {
+	seconds, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// pciDevice returns the first PCI address of the line, empty if it holds none.
+func pciDevice(line string) string {
+	if match := pciRegexp.FindStringSubmatch(line); match != nil {
+		return match[1]
+	}
+
+	return ""
+}
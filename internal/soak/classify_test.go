@@ -0,0 +1,149 @@
+package soak
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+func TestClassify(t *testing.T) {
+	for _, test := range []struct {
+		line   string
+		kind   Kind
+		device string
+		code   int
+	}{
+		{line: "NVRM: Xid (PCI:0000:3b:00): 43, pid=1207, name=python3, Ch 00000010", kind: KindXid,
+			device: "0000:3b:00", code: 43},
+		{line: "NVRM: Xid (PCI:0000:86:00): 94, pid='<unknown>', name=<unknown>, Contained: SM (0x1)", kind: KindECC,
+			device: "0000:86:00", code: 94},
+		{line: "NVRM: Xid (PCI:0000:af:00): 79, pid='<unknown>', name=<unknown>, GPU has fallen off the bus.",
+			kind: KindFellOffBus, device: "0000:af:00", code: 79},
+		{line: "nvidia-nvswitch0: SXid (PCI:0000:05:00.0): 20034, Fatal, Link 30 LTSSM Fault Up", kind: KindSXid,
+			device: "0000:05:00.0", code: 20034},
+		{line: "NVRM: GPU 0000:3b:00.0: GPU has fallen off the bus.", kind: KindFellOffBus, device: "0000:3b:00.0"},
+		{line: "Unable to determine the device handle for GPU 0000:3b:00.0: GPU is lost.  Reboot the system",
+			kind: KindFellOffBus, device: "0000:3b:00.0"},
+		{line: "NVRM: GPU 0000:86:00.0: uncorrectable ECC error detected", kind: KindECC, device: "0000:86:00.0"},
+	} {
+		event, found := Classify(test.line)
+		if !found || event.Kind != test.kind || event.Device != test.device || event.Code != test.code {
+			t.Errorf("unexpected event %+v of line %q", event, test.line)
+		}
+	}
+
+	for _, line := range []string{
+		"NVRM: loading NVIDIA UNIX x86_64 Kernel Module  550.127.08  Tue Nov 12 01:46:13 UTC 2024",
+		"nvidia-gpu 0000:3b:00.0: irq 152 for MSI/MSI-X",
+		"ECC is enabled on all the GPUs",
+	} {
+		if event, found := Classify(line); found {
+			t.Errorf("unexpected event %+v of line %q", event, line)
+		}
+	}
+}
+
+func TestParseLog(t *testing.T) {
+	fixture, err := os.ReadFile("testdata/dmesg-iso.txt")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	events := ParseLog(string(fixture))
+
+	expectedKinds := []Kind{KindXid, KindECC, KindECC, KindFellOffBus, KindFellOffBus, KindSXid}
+	if len(events) != len(expectedKinds) {
+		t.Fatalf("expected %d events, got %+v", len(expectedKinds), events)
+	}
+
+	for index, event := range events {
+		if event.Kind != expectedKinds[index] {
+			t.Errorf("expected event %d of kind %s, got %+v", index, expectedKinds[index], event)
+		}
+	}
+
+	expectedTime := time.Date(2026, 10, 17, 10, 11, 12, 345678000, time.UTC)
+	if !events[0].Time.Equal(expectedTime) || events[0].Code != 31 ||
+		events[0].Description != "GPU memory page fault" {
+		t.Errorf("unexpected first event %+v", events[0])
+	}
+
+	events = ParseLog("[ 4711.204518] NVRM: Xid (PCI:0000:3b:00): 13, Graphics SM Warp Exception\n" +
+		"2026-10-17T10:11:12.5Z NVRM: Xid (PCI:0000:3b:00): 45, pid=8811, Ch 00000020\n")
+	if len(events) != 2 || !events[0].Time.IsZero() || events[0].Code != 13 ||
+		!events[1].Time.Equal(time.Date(2026, 10, 17, 10, 11, 12, 500000000, time.UTC)) {
+		t.Errorf("unexpected events %+v", events)
+	}
+}
+
+func TestParseKernelLog(t *testing.T) {
+	dmesg := "[    0.000000] Linux version 5.14.0-427.50.1.el9_4.x86_64\n" +
+		"[ 4711.204518] NVRM: Xid (PCI:0000:3b:00): 13, Graphics SM Warp Exception\n"
+
+	events, err := ParseKernelLog("1792231200.500000000\n7200.25\n" + dmesg)
+	if err != nil {
+		t.Fatalf("unexpected error parsing the kernel log: %v", err)
+	}
+
+	bootTime := time.Date(2026, 10, 17, 8, 0, 0, 250000000, time.UTC)
+	if len(events) != 1 || events[0].Code != 13 ||
+		events[0].Time.Sub(bootTime.Add(4711204518*time.Microsecond)).Abs() > time.Millisecond {
+		t.Fatalf("unexpected events %+v", events)
+	}
+
+	// The node clock was stepped by an hour, the event is still the same.
+	steppedEvents, err := ParseKernelLog("1792234800.500000000\n7200.25\n" + dmesg)
+	if err != nil || len(steppedEvents) != 1 || steppedEvents[0].key != events[0].key {
+		t.Errorf("expected the same event after the node clock was stepped, got %+v, %v", steppedEvents, err)
+	}
+
+	if _, err := ParseKernelLog(dmesg); err == nil {
+		t.Errorf("expected error parsing a kernel log without node time and uptime")
+	}
+}
+
+func TestParseDriverLog(t *testing.T) {
+	output := "2026-10-17T10:11:12.100000000Z NVRM: Xid (PCI:0000:3b:00): 13, Graphics SM Warp Exception\n" +
+		"2026-10-17T10:11:12.200000000Z NVRM: Xid (PCI:0000:3b:00): 13, Graphics SM Warp Exception\n" +
+		"2026-10-17T10:11:13.300000000Z Driver container is running\n"
+
+	events := ParseLog(output)
+	if len(events) != 2 || events[0].key == events[1].key || events[0].Time.Equal(events[1].Time) {
+		t.Errorf("expected two distinct events of the repeated line, got %+v", events)
+	}
+
+	if lastTime := lastTimestamp(output); !lastTime.Equal(time.Date(2026, 10, 17, 10, 11, 13, 300000000, time.UTC)) {
+		t.Errorf("unexpected last log time %s", lastTime)
+	}
+
+	if lastTime := lastTimestamp("no timestamp\n"); !lastTime.IsZero() {
+		t.Errorf("expected no last log time, got %s", lastTime)
+	}
+}
+
+func TestReport(t *testing.T) {
+	start := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	report := &Report{Workload: "gpu-burn", Start: start, End: start.Add(time.Hour), Nodes: []string{"a", "b"},
+		Iterations: 3, Events: []Event{
+			{Time: start.Add(time.Minute), Node: "b", Device: "0000:3b:00", Kind: KindXid, Code: 13},
+			{Time: start.Add(2 * time.Minute), Node: "a", Device: "0000:86:00", Kind: KindECC, Code: 48},
+			{Time: start.Add(3 * time.Minute), Node: "a", Device: "0000:86:00", Kind: KindFellOffBus, Code: 79},
+			{Time: start.Add(4 * time.Minute), Node: "a", Kind: KindWorkload, Message: "pod is Failed"},
+			{Time: start.Add(5 * time.Minute), Node: "b", Device: "0000:3b:00", Kind: KindXid, Code: 13,
+				Ignored: true},
+			{Time: start.Add(6 * time.Minute), Node: "a", Source: SourceKernel, Kind: KindScan,
+				Message: "failed to read the kernel log of node a"},
+		}}
+
+	if failures := report.Failures(); len(failures) != 5 {
+		t.Errorf("expected 5 failures, got %+v", failures)
+	}
+
+	summaries := report.Summary()
+	if len(summaries) != 3 || summaries[0].Node != "a" || summaries[0].Device != "" ||
+		summaries[0].Counts[KindScan] != 1 || summaries[1].Counts[KindECC] != 1 ||
+		summaries[1].Counts[KindFellOffBus] != 1 || !summaries[1].Last.Equal(start.Add(3*time.Minute)) ||
+		summaries[2].Counts[KindXid] != 1 {
+		t.Errorf("unexpected summary %+v", summaries)
+	}
+}
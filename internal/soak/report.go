@@ -0,0 +1,149 @@
+package soak
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ReportFileName is the name of the soak report file written in the report directory.
+const ReportFileName = "soak-report.json"
+
+// Report is the outcome of a soak run.
+type Report struct {
+	Workload   string    `json:"workload"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end,omitempty"`
+	Nodes      []string  `json:"nodes"`
+	Iterations int       `json:"iterations"`
+	// Baseline are the GPU errors already logged when the soak run started, which do not fail it.
+	Baseline []Event `json:"baseline,omitempty"`
+	// Events are the GPU errors, workload failures and failed log scans of the soak run, sorted by time.
+	Events []Event `json:"events,omitempty"`
+}
+
+// DeviceSummary is the number of failures of a GPU, or of a node for the failures naming no GPU.
+type DeviceSummary struct {
+	Node   string       `json:"node"`
+	Device string       `json:"device,omitempty"`
+	Counts map[Kind]int `json:"counts"`
+	First  time.Time    `json:"first"`
+	Last   time.Time    `json:"last"`
+}
+
+// Failures returns the events failing the soak run, that is all but the ignored Xids.
+func (report *Report) Failures() []Event {
+	var failures []Event
+
+	for _, event := range report.Events {
+		if !event.Ignored {
+			failures = append(failures, event)
+		}
+	}
+
+	return failures
+}
+
+// Summary returns the failures per node and GPU, sorted by node and device.
+func (report *Report) Summary() []DeviceSummary {
+	var summaries []DeviceSummary
+
+	indexes := map[string]int{}
+
+	for _, event := range report.Failures() {
+		key := event.Node + "/" + event.Device
+
+		index, found := indexes[key]
+		if !found {
+			index = len(summaries)
+			indexes[key] = index
+			summaries = append(summaries, DeviceSummary{Node: event.Node, Device: event.Device,
+				Counts: map[Kind]int{}, First: event.Time, Last: event.Time})
+		}
+
+		summary := &summaries[index]
+		summary.Counts[event.Kind]++
+
+		if event.Time.Before(summary.First) {
+			summary.First = event.Time
+		}
+
+		if event.Time.After(summary.Last) {
+			summary.Last = event.Time
+		}
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Node != summaries[j].Node {
+			return summaries[i].Node < summaries[j].Node
+		}
+
+		return summaries[i].Device < summaries[j].Device
+	})
+
+	return summaries
+}
+
+// Timeline returns the human-readable timeline of the soak run events, followed by the failures per node and GPU.
+func (report *Report) Timeline() string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "Soak run of %s on %d nodes from %s to %s: %d iterations, %d events, %d failures\n",
+		report.Workload, len(report.Nodes), report.Start.Format(time.RFC3339), report.End.Format(time.RFC3339),
+		report.Iterations, len(report.Events), len(report.Failures()))
+
+	if len(report.Baseline) > 0 {
+		fmt.Fprintf(&builder, "%d GPU errors were logged before the soak run\n", len(report.Baseline))
+	}
+
+	for _, event := range report.Events {
+		fmt.Fprintf(&builder, "%s %s %s %s", event.Time.Format(time.RFC3339), event.Node, deviceName(event.Device),
+			event.Kind)
+
+		if event.Code != 0 {
+			fmt.Fprintf(&builder, " %d", event.Code)
+		}
+
+		if event.Description != "" {
+			fmt.Fprintf(&builder, " (%s)", event.Description)
+		}
+
+		if event.Ignored {
+			builder.WriteString(" [ignored]")
+		}
+
+		fmt.Fprintf(&builder, " from %s: %s\n", event.Source, event.Message)
+	}
+
+	for _, summary := range report.Summary() {
+		kinds := make([]string, 0, len(summary.Counts))
+		for kind, count := range summary.Counts {
+			kinds = append(kinds, fmt.Sprintf("%s=%d", kind, count))
+		}
+
+		sort.Strings(kinds)
+
+		fmt.Fprintf(&builder, "Node %s GPU %s: %s, first at %s, last at %s\n", summary.Node,
+			deviceName(summary.Device), strings.Join(kinds, " "), summary.First.Format(time.RFC3339),
+			summary.Last.Format(time.RFC3339))
+	}
+
+	return builder.String()
+}
+
+// sortEvents sorts the events by time, keeping the order of the events of the same time.
+func (report *Report) sortEvents() {
+	sort.SliceStable(report.Events, func(i, j int) bool {
+		return report.Events[i].Time.Before(report.Events[j].Time)
+	})
+}
+
+// deviceName returns the device, or "-" for the events naming no GPU.
+func deviceName(device string) string {
+	if device == "" {
+		return "-"
+	}
+
+	return device
+}
@@ -0,0 +1,388 @@
+package soak
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	rdmatest "github.com/rh-ecosystem-edge/nvidia-ci/internal/rdma"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/wait"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/workload"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DriverPodSelector selects the GPU driver pods, whose logs are scanned.
+	DriverPodSelector = "app.kubernetes.io/component=nvidia-driver"
+	// DriverContainer is the container of the GPU driver pods.
+	DriverContainer = "nvidia-driver-ctr"
+	// SourceKernel is the source of the events found in the kernel log of a node.
+	SourceKernel = "kernel"
+	// WorkloadTimeoutMargin is how much longer than the workload duration its pods may take to complete.
+	WorkloadTimeoutMargin = 10 * time.Minute
+)
+
+var (
+	logger = logging.Logger(logging.GPU)
+
+	// kernelLogCommand prints the node time and uptime, then the kernel log of the node with its seconds since boot
+	// timestamps, as parsed by ParseKernelLog.
+	kernelLogCommand = []string{"chroot", "/host", "sh", "-c", "date +%s.%N && cut -d ' ' -f 1 /proc/uptime && dmesg"}
+)
+
+// Config is the configuration of a soak run.
+type Config struct {
+	// Duration is how long the workload is scheduled again and again.
+	Duration time.Duration
+	// ScanInterval is the interval between the scans of the kernel and driver logs.
+	ScanInterval time.Duration
+	// Workload is the GPU workload scheduled on the targets.
+	Workload workload.GPUWorkload
+	// Namespace is the namespace of the workload and node debug pods.
+	Namespace string
+	// Targets are the workload pods of every iteration, their names suffixed with the iteration number. Every target
+	// must set the node it runs on, whose kernel log is scanned.
+	Targets []workload.Target
+	// DebugImage is the image of the node debug pods reading the kernel log.
+	DebugImage string
+	// DriverNamespace is the namespace of the GPU driver pods, whose logs are scanned when set.
+	DriverNamespace string
+	// IgnoredXids are the Xids reported in the timeline without failing the soak run.
+	IgnoredXids []int
+}
+
+// Validate checks that the soak run configuration is complete.
+func (config *Config) Validate() error {
+	switch {
+	case config.Duration <= 0:
+		return fmt.Errorf("soak duration must be positive, got %s", config.Duration)
+	case config.ScanInterval <= 0:
+		return fmt.Errorf("soak scan interval must be positive, got %s", config.ScanInterval)
+	case config.Workload == nil:
+		return fmt.Errorf("soak run has no workload")
+	case config.Namespace == "" || config.DebugImage == "":
+		return fmt.Errorf("soak run requires a namespace and a debug image")
+	case len(config.Targets) == 0:
+		return fmt.Errorf("soak run has no target to run the workload on")
+	}
+
+	for _, target := range config.Targets {
+		if target.NodeName == "" {
+			return fmt.Errorf("soak target %s has no node", target.Name)
+		}
+	}
+
+	return nil
+}
+
+// Nodes returns the sorted nodes of the targets.
+func (config *Config) Nodes() []string {
+	var nodeNames []string
+
+	for _, target := range config.Targets {
+		if !slices.Contains(nodeNames, target.NodeName) {
+			nodeNames = append(nodeNames, target.NodeName)
+		}
+	}
+
+	slices.Sort(nodeNames)
+
+	return nodeNames
+}
+
+// soakRun is the state of a soak run shared by the workload iterations and the log scans.
+type soakRun struct {
+	apiClient *clients.Settings
+	config    Config
+	nodes     []string
+	scans     int
+
+	// driverLogSince is the time of the last line read of every GPU driver pod log, from which the next scan reads.
+	driverLogSince map[string]time.Time
+
+	mutex  sync.Mutex
+	seen   map[string]bool
+	report *Report
+}
+
+// Run schedules the workload on the targets again and again for the configured duration, while scanning the kernel
+// log of their nodes and the GPU driver pod logs for GPU errors every scan interval. The errors logged before the
+// run are reported as its baseline. On failure the returned report holds the iterations and events so far.
+func Run(ctx context.Context, apiClient *clients.Settings, config Config) (*Report, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	run := &soakRun{
+		apiClient: apiClient,
+		config:    config,
+		nodes:     config.Nodes(),
+		seen:      map[string]bool{},
+		report:    &Report{Workload: config.Workload.Name(), Start: time.Now().UTC(), Nodes: config.Nodes()},
+
+		driverLogSince: map[string]time.Time{},
+	}
+
+	logger.V(logging.LevelDebug).Info("Starting soak run", "workload", config.Workload.Name(),
+		"duration", config.Duration.String(), "nodes", run.nodes)
+
+	if failures := run.scan(ctx, true); len(failures) > 0 {
+		var errs []error
+		for _, failure := range failures {
+			errs = append(errs, errors.New(failure.Message))
+		}
+
+		return run.report, fmt.Errorf("failed to scan the logs before the soak run: %w", errors.Join(errs...))
+	}
+
+	scanCtx, stopScans := context.WithCancel(ctx)
+	scansDone := make(chan struct{})
+
+	go func() {
+		defer close(scansDone)
+
+		ticker := time.NewTicker(config.ScanInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-scanCtx.Done():
+				return
+			case <-ticker.C:
+				run.scanAndRecordFailures(scanCtx)
+			}
+		}
+	}()
+
+	var err error
+
+	deadline := run.report.Start.Add(config.Duration)
+
+	for iteration := 1; time.Now().Before(deadline) && ctx.Err() == nil; iteration++ {
+		if err = run.runIteration(ctx, iteration); err != nil {
+			err = fmt.Errorf("soak iteration %d failed: %w", iteration, err)
+
+			break
+		}
+	}
+
+	stopScans()
+	<-scansDone
+
+	run.scanAndRecordFailures(ctx)
+
+	run.report.End = time.Now().UTC()
+	run.report.sortEvents()
+
+	logger.V(logging.LevelDebug).Info("Finished soak run", "workload", config.Workload.Name(),
+		"iterations", run.report.Iterations, "events", len(run.report.Events))
+
+	return run.report, err
+}
+
+// runIteration deploys the workload on the targets, waits for its pods to complete and validates their output. The
+// failed pods and the thresholds their output did not meet are recorded as events, only the errors deploying,
+// validating or cleaning up the workload failing the iteration.
+func (run *soakRun) runIteration(ctx context.Context, iteration int) error {
+	targets := make([]workload.Target, 0, len(run.config.Targets))
+	for _, target := range run.config.Targets {
+		target.Name = fmt.Sprintf("%s-%d", target.Name, iteration)
+		targets = append(targets, target)
+	}
+
+	logger.V(logging.LevelDebug).Info("Starting soak iteration", "iteration", iteration,
+		"workload", run.config.Workload.Name())
+
+	workloadRun, err := workload.DeployWithContext(ctx, run.apiClient, run.config.Workload, run.config.Namespace,
+		targets)
+	if err != nil {
+		return errors.Join(err, workloadRun.CleanupWithContext(ctx))
+	}
+
+	timeout := run.config.Workload.Duration() + WorkloadTimeoutMargin
+	succeeded := true
+
+	for index, podBuilder := range workloadRun.Pods {
+		if failure := run.waitForPod(ctx, podBuilder, timeout); failure != "" {
+			succeeded = false
+
+			run.record(Event{Time: time.Now().UTC(), Node: targets[index].NodeName,
+				Source: podBuilder.Definition.Name, Kind: KindWorkload, Message: failure})
+		}
+	}
+
+	if succeeded {
+		results, err := workloadRun.ValidateWithContext(ctx)
+		if err != nil {
+			return errors.Join(err, workloadRun.CleanupWithContext(ctx))
+		}
+
+		for _, result := range results {
+			for _, problem := range result.Problems {
+				run.record(Event{Time: time.Now().UTC(), Node: result.NodeName, Source: result.PodName,
+					Kind: KindWorkload, Message: problem})
+			}
+		}
+	}
+
+	run.mutex.Lock()
+	run.report.Iterations++
+	run.mutex.Unlock()
+
+	return workloadRun.CleanupWithContext(ctx)
+}
+
+// waitForPod waits for up to timeout for the workload pod to complete, returning why it failed, empty if it
+// succeeded.
+func (run *soakRun) waitForPod(ctx context.Context, podBuilder *pod.Builder, timeout time.Duration) string {
+	err := wait.WatchPodPhase(ctx, run.apiClient, podBuilder.Definition.Name, run.config.Namespace, timeout,
+		corev1.PodSucceeded, corev1.PodFailed)
+	if err != nil {
+		return fmt.Sprintf("pod did not complete within %s: %v", timeout, err)
+	}
+
+	completedPod, err := pod.PullWithContext(ctx, run.apiClient, podBuilder.Definition.Name, run.config.Namespace)
+	if err != nil {
+		return fmt.Sprintf("failed to pull the completed pod: %v", err)
+	}
+
+	if completedPod.Object.Status.Phase != corev1.PodSucceeded {
+		return fmt.Sprintf("pod is %s: %s %s", completedPod.Object.Status.Phase, completedPod.Object.Status.Reason,
+			completedPod.Object.Status.Message)
+	}
+
+	return ""
+}
+
+// scanAndRecordFailures scans the logs, recording the failed scans as events which fail the soak run, since a node
+// whose logs cannot be read, such as a NotReady node whose GPU fell off the bus, could hide GPU errors.
+func (run *soakRun) scanAndRecordFailures(ctx context.Context) {
+	for _, failure := range run.scan(ctx, false) {
+		run.record(failure)
+	}
+}
+
+// scan reads the kernel log of every node, through a node debug pod, and the GPU driver pod logs, recording the GPU
+// errors not seen before. The errors of the baseline scan are recorded as the report baseline. The failed scans are
+// returned as events.
+func (run *soakRun) scan(ctx context.Context, baseline bool) []Event {
+	var failures []Event
+
+	run.scans++
+	scanTime := time.Now().UTC()
+
+	scanFailed := func(nodeName, source string, err error) {
+		failures = append(failures, Event{Time: scanTime, Node: nodeName, Source: source, Kind: KindScan,
+			Message: err.Error()})
+	}
+
+	for nodeIndex, nodeName := range run.nodes {
+		debugPodName := fmt.Sprintf("soak-kernel-log-%d-%d", nodeIndex, run.scans)
+
+		output, err := rdmatest.RunCommandsOnSpecificNode(run.apiClient, debugPodName, run.config.Namespace,
+			run.config.DebugImage, nodeName, kernelLogCommand)
+		if err != nil {
+			scanFailed(nodeName, SourceKernel, fmt.Errorf("failed to read the kernel log of node %s: %w",
+				nodeName, err))
+
+			continue
+		}
+
+		events, err := ParseKernelLog(output)
+		if err != nil {
+			scanFailed(nodeName, SourceKernel, fmt.Errorf("failed to parse the kernel log of node %s: %w",
+				nodeName, err))
+
+			continue
+		}
+
+		run.recordEvents(nodeName, SourceKernel, scanTime, events, baseline)
+	}
+
+	if run.config.DriverNamespace == "" {
+		return failures
+	}
+
+	driverPods, err := pod.ListWithContext(ctx, run.apiClient, run.config.DriverNamespace,
+		metav1.ListOptions{LabelSelector: DriverPodSelector})
+	if err != nil {
+		scanFailed("", "driver pods", fmt.Errorf("failed to list the GPU driver pods: %w", err))
+
+		return failures
+	}
+
+	for _, driverPod := range driverPods {
+		source := "pod/" + driverPod.Object.Name
+
+		// The log lines of the second of the last line read are read again, and skipped as seen.
+		output, err := driverPod.GetTimestampedLogWithContext(ctx, run.driverLogSince[source], DriverContainer)
+		if err != nil {
+			scanFailed(driverPod.Object.Spec.NodeName, source,
+				fmt.Errorf("failed to get GPU driver pod %s logs: %w", driverPod.Object.Name, err))
+
+			continue
+		}
+
+		if lastLineTime := lastTimestamp(output); !lastLineTime.IsZero() {
+			run.driverLogSince[source] = lastLineTime
+		}
+
+		run.recordEvents(driverPod.Object.Spec.NodeName, source, scanTime, ParseLog(output), baseline)
+	}
+
+	return failures
+}
+
+// recordEvents records the GPU errors of a log not seen by the previous scans, with the scan time when their line
+// has no timestamp.
+func (run *soakRun) recordEvents(nodeName, source string, scanTime time.Time, events []Event, baseline bool) {
+	for _, event := range events {
+		key := nodeName + "\x00" + source + "\x00" + event.key
+
+		run.mutex.Lock()
+		seen := run.seen[key]
+		run.seen[key] = true
+		run.mutex.Unlock()
+
+		if seen {
+			continue
+		}
+
+		if event.Time.IsZero() {
+			event.Time = scanTime
+		}
+
+		event.Node = nodeName
+		event.Source = source
+
+		if baseline {
+			run.mutex.Lock()
+			run.report.Baseline = append(run.report.Baseline, event)
+			run.mutex.Unlock()
+
+			continue
+		}
+
+		run.record(event)
+	}
+}
+
+// record adds the event to the report, marking the configured Xids as ignored.
+func (run *soakRun) record(event Event) {
+	event.Ignored = event.Code != 0 && event.Kind != KindSXid && slices.Contains(run.config.IgnoredXids, event.Code)
+
+	logger.Info("GPU error detected", "node", event.Node, "device", event.Device, "kind", event.Kind,
+		"code", event.Code, "ignored", event.Ignored, "message", event.Message)
+
+	run.mutex.Lock()
+	defer run.mutex.Unlock()
+
+	run.report.Events = append(run.report.Events, event)
+}
@@ -0,0 +1,52 @@
+package suite
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	"github.com/openshift-kni/k8sreporter"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/reporter"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/runsummary"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+)
+
+var logger = logging.Logger(logging.Reporter)
+
+// Register registers the nodes shared by every suite, and returns true so that it can be called at package level
+// from the suite file as var _ = Register(...):
+//   - on failure, the reporter dumps the given namespaces and CRDs under a directory named after the suite file, and
+//     must-gather collects the given namespaces;
+//   - every spec logs to its own file in the report directory;
+//   - after the suite, the spec reports are added to the run summary, which is written to the report directory.
+func Register(runSummary *runsummary.Summary, suiteFile string, reporterNamespaces map[string]string,
+	reporterCRDs []k8sreporter.CRData) bool {
+	JustAfterEach(func(ctx SpecContext) {
+		specReport := CurrentSpecReport()
+		reporter.ReportIfFailed(specReport, suiteFile, reporterNamespaces, reporterCRDs, clients.SetScheme)
+		reporter.CollectMustGatherIfFailed(ctx, specReport, reporterNamespaces)
+	})
+
+	BeforeEach(func() {
+		specLogPath := inittools.GeneralConfig.GetReportPath(logging.SpecLogFileName(CurrentSpecReport().FullText()))
+		if err := logging.StartSpecLog(specLogPath); err != nil {
+			logger.Error(err, "Failed to start spec log", "path", specLogPath)
+		}
+	})
+
+	AfterEach(func() {
+		if err := logging.StopSpecLog(); err != nil {
+			logger.Error(err, "Failed to stop spec log")
+		}
+	})
+
+	ReportAfterSuite("run summary", func(report Report) {
+		runSummary.AddSpecReports(report.SpecReports)
+
+		if err := runSummary.Write(inittools.GeneralConfig); err != nil {
+			logger.Error(err, "Failed to write run summary")
+		}
+	})
+
+	return true
+}
@@ -0,0 +1,210 @@
+package timeslicing
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/configmap"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DevicePluginConfigLabel is the node label selecting the device plugin configuration of the node.
+	DevicePluginConfigLabel = "nvidia.com/device-plugin.config"
+	// GPUReplicasLabel is the node label GPU feature discovery sets to the number of replicas of every GPU.
+	GPUReplicasLabel = "nvidia.com/gpu.replicas"
+	// SharedGPUResourceName is the extended resource the time-sliced GPUs are advertised as with renameByDefault.
+	SharedGPUResourceName corev1.ResourceName = "nvidia.com/gpu.shared"
+)
+
+var (
+	isFalse = false
+	isTrue  = true
+
+	logger = logging.Logger(logging.GPU)
+
+	// gpuUUIDRegex matches the GPU lines of the nvidia-smi -L output.
+	gpuUUIDRegex = regexp.MustCompile(`(?m)^GPU \d+: .*\(UUID: (GPU-[0-9a-fA-F-]+)\)`)
+)
+
+// Config is a time-slicing configuration of the device plugin.
+type Config struct {
+	// Replicas is the number of replicas each GPU is advertised as.
+	Replicas int
+	// RenameByDefault advertises the replicas as nvidia.com/gpu.shared instead of nvidia.com/gpu.
+	RenameByDefault bool
+	// FailRequestsGreaterThanOne fails the allocation of more than one replica to a container.
+	FailRequestsGreaterThanOne bool
+}
+
+// Validate returns an error if the time-slicing configuration does not share the GPUs.
+func (config Config) Validate() error {
+	if config.Replicas < 2 {
+		return fmt.Errorf("time-slicing replicas must be at least 2 to share a GPU, got %d", config.Replicas)
+	}
+
+	return nil
+}
+
+// ResourceName returns the extended resource the device plugin advertises the GPU replicas as.
+func (config Config) ResourceName() corev1.ResourceName {
+	if config.RenameByDefault {
+		return SharedGPUResourceName
+	}
+
+	return nvidiagpu.GPUResourceName
+}
+
+// DevicePluginConfigData returns the device plugin ConfigMap data holding the time-slicing configurations,
+// keyed by the name of the configuration the nodes select with the nvidia.com/device-plugin.config label.
+func DevicePluginConfigData(configs map[string]Config) (map[string]string, error) {
+	if len(configs) == 0 {
+		return nil, fmt.Errorf("at least one time-slicing configuration is required")
+	}
+
+	data := make(map[string]string, len(configs))
+
+	for name, config := range configs {
+		if err := config.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid time-slicing configuration %s: %w", name, err)
+		}
+
+		devicePluginConfig := map[string]interface{}{
+			"version": "v1",
+			"sharing": map[string]interface{}{
+				"timeSlicing": map[string]interface{}{
+					"renameByDefault":            config.RenameByDefault,
+					"failRequestsGreaterThanOne": config.FailRequestsGreaterThanOne,
+					"resources": []map[string]interface{}{
+						{
+							"name":     nvidiagpu.GPUResourceName,
+							"replicas": config.Replicas,
+						},
+					},
+				},
+			},
+		}
+
+		yamlData, err := yaml.Marshal(devicePluginConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal time-slicing configuration %s: %w", name, err)
+		}
+
+		data[name] = string(yamlData)
+	}
+
+	return data, nil
+}
+
+// CreateDevicePluginConfigMap creates a ConfigMap with the device plugin time-slicing configurations.
+func CreateDevicePluginConfigMap(apiClient *clients.Settings, configMapName, configMapNamespace string,
+	configs map[string]Config) (*configmap.Builder, error) {
+	data, err := DevicePluginConfigData(configs)
+	if err != nil {
+		return nil, err
+	}
+
+	createdConfigMap, err := configmap.NewBuilder(apiClient, configMapName, configMapNamespace).
+		WithData(data).
+		Create()
+	if err != nil {
+		logger.V(logging.LevelDebug).Info("Error creating Device Plugin time-slicing ConfigMap",
+			"name", configMapName, "namespace", configMapNamespace, "error", err)
+
+		return nil, err
+	}
+
+	logger.V(logging.LevelDebug).Info("Created Device Plugin time-slicing ConfigMap",
+		"name", configMapName, "namespace", configMapNamespace)
+
+	return createdConfigMap, nil
+}
+
+// CheckNodeCapacity returns an error if the node does not advertise the replicas of all its GPUs following the
+// time-slicing configuration.
+func CheckNodeCapacity(node *corev1.Node, config Config) error {
+	gpuCount, err := strconv.ParseInt(node.Labels[nvidiagpu.GPUCountLabel], 10, 64)
+	if err != nil {
+		return fmt.Errorf("node %s has no valid %s label: %w", node.Name, nvidiagpu.GPUCountLabel, err)
+	}
+
+	if replicas := node.Labels[GPUReplicasLabel]; replicas != strconv.Itoa(config.Replicas) {
+		return fmt.Errorf("node %s label %s is '%s' instead of '%d'", node.Name, GPUReplicasLabel, replicas,
+			config.Replicas)
+	}
+
+	resourceName := config.ResourceName()
+	capacity := node.Status.Capacity[resourceName]
+
+	if expected := gpuCount * int64(config.Replicas); capacity.Value() != expected {
+		return fmt.Errorf("node %s advertises %d %s resources instead of %d", node.Name, capacity.Value(),
+			resourceName, expected)
+	}
+
+	return nil
+}
+
+// CreateTimeSlicingTestPod returns a Pod listing the GPUs allocated to it on the node, then sleeping.
+func CreateTimeSlicingTestPod(podName, podNamespace, image, nodeName string, resourceName corev1.ResourceName,
+	count int64) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      podName,
+			Namespace: podNamespace,
+			Labels: map[string]string{
+				"app": "time-slicing-test-app",
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			NodeName:      nodeName,
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsNonRoot:   &isTrue,
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+			},
+			Tolerations: []corev1.Toleration{
+				{
+					Key:      "nvidia.com/gpu",
+					Effect:   corev1.TaintEffectNoSchedule,
+					Operator: corev1.TolerationOpExists,
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name:    "time-slicing-test-ctr",
+					Image:   image,
+					Command: []string{"/bin/sh", "-c", "nvidia-smi -L && sleep infinity"},
+					SecurityContext: &corev1.SecurityContext{
+						AllowPrivilegeEscalation: &isFalse,
+						Capabilities: &corev1.Capabilities{
+							Drop: []corev1.Capability{"ALL"},
+						},
+					},
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{
+							resourceName: *resource.NewQuantity(count, resource.DecimalSI),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// GPUUUIDs returns the UUIDs of the GPUs listed in the nvidia-smi -L output.
+func GPUUUIDs(nvidiaSmiOutput string) []string {
+	var uuids []string
+
+	for _, match := range gpuUUIDRegex.FindAllStringSubmatch(nvidiaSmiOutput, -1) {
+		uuids = append(uuids, match[1])
+	}
+
+	return uuids
+}
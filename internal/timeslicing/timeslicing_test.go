@@ -0,0 +1,116 @@
+package timeslicing
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+func TestDevicePluginConfigData(t *testing.T) {
+	data, err := DevicePluginConfigData(map[string]Config{
+		"time-slicing":        {Replicas: 4, FailRequestsGreaterThanOne: true},
+		"time-slicing-shared": {Replicas: 8, RenameByDefault: true},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error generating device plugin config: %v", err)
+	}
+
+	var devicePluginConfig struct {
+		Version string `json:"version"`
+		Sharing struct {
+			TimeSlicing struct {
+				RenameByDefault            bool `json:"renameByDefault"`
+				FailRequestsGreaterThanOne bool `json:"failRequestsGreaterThanOne"`
+				Resources                  []struct {
+					Name     string `json:"name"`
+					Replicas int    `json:"replicas"`
+				} `json:"resources"`
+			} `json:"timeSlicing"`
+		} `json:"sharing"`
+	}
+
+	if err := yaml.Unmarshal([]byte(data["time-slicing-shared"]), &devicePluginConfig); err != nil {
+		t.Fatalf("unexpected error parsing device plugin config: %v", err)
+	}
+
+	timeSlicing := devicePluginConfig.Sharing.TimeSlicing
+	if devicePluginConfig.Version != "v1" || !timeSlicing.RenameByDefault || timeSlicing.FailRequestsGreaterThanOne ||
+		len(timeSlicing.Resources) != 1 || timeSlicing.Resources[0].Name != "nvidia.com/gpu" ||
+		timeSlicing.Resources[0].Replicas != 8 {
+		t.Errorf("unexpected device plugin config:\n%s", data["time-slicing-shared"])
+	}
+
+	if !strings.Contains(data["time-slicing"], "failRequestsGreaterThanOne: true") {
+		t.Errorf("unexpected device plugin config:\n%s", data["time-slicing"])
+	}
+
+	if _, err := DevicePluginConfigData(map[string]Config{"no-sharing": {Replicas: 1}}); err == nil {
+		t.Error("expected error for a single replica")
+	}
+}
+
+func TestCreateDevicePluginConfigMap(t *testing.T) {
+	apiClient := clients.NewFakeSettings()
+
+	_, err := CreateDevicePluginConfigMap(apiClient, "time-slicing-config", nvidiagpu.NvidiaGPUNamespace,
+		map[string]Config{"time-slicing": {Replicas: 4}})
+	if err != nil {
+		t.Fatalf("unexpected error creating device plugin ConfigMap: %v", err)
+	}
+
+	configMap, err := apiClient.ConfigMaps(nvidiagpu.NvidiaGPUNamespace).Get(context.TODO(), "time-slicing-config",
+		metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error getting device plugin ConfigMap: %v", err)
+	}
+
+	if !strings.Contains(configMap.Data["time-slicing"], "timeSlicing") {
+		t.Errorf("unexpected device plugin ConfigMap data %v", configMap.Data)
+	}
+}
+
+func TestCheckNodeCapacity(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Labels: map[string]string{
+			nvidiagpu.GPUCountLabel: "2", GPUReplicasLabel: "4"}},
+		Status: corev1.NodeStatus{Capacity: corev1.ResourceList{
+			nvidiagpu.GPUResourceName: resource.MustParse("8")}},
+	}
+
+	if err := CheckNodeCapacity(node, Config{Replicas: 4}); err != nil {
+		t.Errorf("unexpected error checking node capacity: %v", err)
+	}
+
+	if err := CheckNodeCapacity(node, Config{Replicas: 4, RenameByDefault: true}); err == nil {
+		t.Error("expected error for missing nvidia.com/gpu.shared capacity")
+	}
+
+	if err := CheckNodeCapacity(node, Config{Replicas: 8}); err == nil {
+		t.Error("expected error for replicas label mismatch")
+	}
+
+	node.Status.Capacity[SharedGPUResourceName] = resource.MustParse("8")
+	if err := CheckNodeCapacity(node, Config{Replicas: 4, RenameByDefault: true}); err != nil {
+		t.Errorf("unexpected error checking node shared capacity: %v", err)
+	}
+}
+
+func TestGPUUUIDs(t *testing.T) {
+	nvidiaSmiOutput := `GPU 0: NVIDIA A10G (UUID: GPU-5c89852c-d268-c3f3-1b07-005d5ae1dc3f)
+GPU 1: NVIDIA A100-SXM4-80GB (UUID: GPU-1d4a0b6e-2a5c-5f1d-8b4e-7c3d2e1f0a9b)
+  MIG 1g.10gb     Device  0: (UUID: MIG-c6d4f1ef-42e4-5de3-91c7-45d71c87eb3f)
+`
+
+	uuids := GPUUUIDs(nvidiaSmiOutput)
+	if len(uuids) != 2 || uuids[0] != "GPU-5c89852c-d268-c3f3-1b07-005d5ae1dc3f" ||
+		uuids[1] != "GPU-1d4a0b6e-2a5c-5f1d-8b4e-7c3d2e1f0a9b" {
+		t.Errorf("unexpected GPU UUIDs %v", uuids)
+	}
+}
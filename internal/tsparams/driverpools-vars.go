@@ -0,0 +1,21 @@
+package tsparams
+
+import (
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	nvidiagpuv1alpha1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1alpha1"
+	"github.com/openshift-kni/k8sreporter"
+)
+
+var (
+	// DriverPoolsReporterNamespacesToDump tells to the reporter from where to collect logs.
+	DriverPoolsReporterNamespacesToDump = map[string]string{
+		"openshift-nfd":       "nfd-operator",
+		"nvidia-gpu-operator": "gpu-operator",
+	}
+
+	// DriverPoolsReporterCRDsToDump tells to the reporter what CRs to dump.
+	DriverPoolsReporterCRDsToDump = []k8sreporter.CRData{
+		{Cr: &nvidiagpuv1.ClusterPolicyList{}},
+		{Cr: &nvidiagpuv1alpha1.NVIDIADriverList{}},
+	}
+)
@@ -0,0 +1,20 @@
+package tsparams
+
+import (
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	"github.com/openshift-kni/k8sreporter"
+)
+
+var (
+	// SoakReporterNamespacesToDump tells to the reporter from where to collect logs.
+	SoakReporterNamespacesToDump = map[string]string{
+		"openshift-nfd":       "nfd-operator",
+		"nvidia-gpu-operator": "gpu-operator",
+		"test-soak":           "test-soak",
+	}
+
+	// SoakReporterCRDsToDump tells to the reporter what CRs to dump.
+	SoakReporterCRDsToDump = []k8sreporter.CRData{
+		{Cr: &nvidiagpuv1.ClusterPolicyList{}},
+	}
+)
@@ -0,0 +1,96 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/dcgm"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DCGMMetricsValid waits for a defined period of time for the DCGM exporter to report the metrics of every GPU
+// within the given ranges. When nodeName is empty the exporter service is scraped, otherwise the exporter pod
+// of the node is. Only the samples having all the given label values are checked.
+func DCGMMetricsValid(apiClient *clients.Settings, namespace, nodeName string, labels map[string]string,
+	ranges []dcgm.MetricRange, pollInterval, timeout time.Duration) error {
+	return DCGMMetricsValidWithContext(
+		context.TODO(), apiClient, namespace, nodeName, labels, ranges, pollInterval, timeout)
+}
+
+// DCGMMetricsValidWithContext is the context-aware variant of DCGMMetricsValid.
+func DCGMMetricsValidWithContext(
+	ctx context.Context, apiClient *clients.Settings, namespace, nodeName string, labels map[string]string,
+	ranges []dcgm.MetricRange, pollInterval, timeout time.Duration) error {
+	return dcgmMetricsValid(ctx, apiClient, namespace, nodeName, func(samples []dcgm.Sample) []string {
+		return dcgm.CheckMetrics(dcgm.FilterLabels(samples, labels), ranges)
+	}, pollInterval, timeout)
+}
+
+// DCGMNodeMetricsValid waits for a defined period of time for the DCGM exporter pod of the node to report the
+// metrics of each of its gpuCount GPUs within the given ranges.
+func DCGMNodeMetricsValid(apiClient *clients.Settings, namespace, nodeName string, gpuCount int,
+	ranges []dcgm.MetricRange, pollInterval, timeout time.Duration) error {
+	return DCGMNodeMetricsValidWithContext(
+		context.TODO(), apiClient, namespace, nodeName, gpuCount, ranges, pollInterval, timeout)
+}
+
+// DCGMNodeMetricsValidWithContext is the context-aware variant of DCGMNodeMetricsValid.
+func DCGMNodeMetricsValidWithContext(
+	ctx context.Context, apiClient *clients.Settings, namespace, nodeName string, gpuCount int,
+	ranges []dcgm.MetricRange, pollInterval, timeout time.Duration) error {
+	if nodeName == "" {
+		return fmt.Errorf("DCGM exporter node name cannot be empty")
+	}
+
+	return dcgmMetricsValid(ctx, apiClient, namespace, nodeName, func(samples []dcgm.Sample) []string {
+		return append(dcgm.CheckMetrics(samples, ranges), dcgm.CheckGPUCount(samples, ranges, gpuCount)...)
+	}, pollInterval, timeout)
+}
+
+// dcgmMetricsValid polls the DCGM exporter service, or the exporter pod of the node when nodeName is set, until
+// the check of the scraped samples returns no problem.
+func dcgmMetricsValid(ctx context.Context, apiClient *clients.Settings, namespace, nodeName string,
+	check func(samples []dcgm.Sample) []string, pollInterval, timeout time.Duration) error {
+	var problems []string
+
+	err := wait.PollUntilContextTimeout(
+		ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			var (
+				samples []dcgm.Sample
+				err     error
+			)
+
+			if nodeName == "" {
+				samples, err = dcgm.ScrapeWithContext(ctx, apiClient, namespace)
+			} else {
+				samples, err = dcgm.ScrapeNodeWithContext(ctx, apiClient, namespace, nodeName)
+			}
+
+			if err != nil {
+				gpuLogger.V(logging.LevelDebug).Info("DCGM exporter scrape error", "error", err)
+				problems = []string{err.Error()}
+
+				return false, nil
+			}
+
+			problems = check(samples)
+
+			gpuLogger.V(logging.LevelDebug).Info("DCGM exporter metrics checked", "namespace", namespace,
+				"node", nodeName, "problems", problems)
+
+			return len(problems) == 0, nil
+		})
+
+	if err != nil {
+		gpuLogger.Info("DCGM exporter metrics are not valid", "namespace", namespace, "node", nodeName,
+			"problems", problems, "error", err)
+
+		return fmt.Errorf("DCGM exporter metrics are not valid: %s: %w", strings.Join(problems, "; "), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// maxWarningEvents is the number of most recent Warning events reported in a Diagnosis.
+	maxWarningEvents = 10
+	// diagnosisTimeout bounds the time spent collecting a Diagnosis after a readiness wait timed out.
+	diagnosisTimeout = 1 * time.Minute
+)
+
+// Diagnosis explains why the operands deployed in an operator namespace are not ready.
+type Diagnosis struct {
+	Namespace string
+	// DaemonSets lists the DaemonSets which are not fully available.
+	DaemonSets []string
+	// Pods lists the Pending pods and the crash looping containers with their last termination reason.
+	Pods []string
+	// Unschedulable lists the scheduler messages of the pods which cannot be scheduled.
+	Unschedulable []string
+	// Events lists the most recent Warning events, oldest first.
+	Events []string
+	// Errors lists the parts of the diagnosis which could not be collected.
+	Errors []string
+}
+
+// String returns the diagnosis as a multi-line report.
+func (diagnosis *Diagnosis) String() string {
+	var report strings.Builder
+
+	fmt.Fprintf(&report, "diagnosis of namespace '%s':", diagnosis.Namespace)
+
+	writeSection := func(title string, lines []string) {
+		if len(lines) == 0 {
+			return
+		}
+
+		fmt.Fprintf(&report, "\n  %s:", title)
+
+		for _, line := range lines {
+			fmt.Fprintf(&report, "\n    - %s", line)
+		}
+	}
+
+	writeSection("DaemonSets not fully available", diagnosis.DaemonSets)
+	writeSection("Pods not running", diagnosis.Pods)
+	writeSection("Unschedulable pods", diagnosis.Unschedulable)
+	writeSection("Recent Warning events", diagnosis.Events)
+	writeSection("Diagnosis errors", diagnosis.Errors)
+
+	if diagnosis.isEmpty() {
+		report.WriteString(" no unavailable DaemonSet, failing pod or Warning event found")
+	}
+
+	return report.String()
+}
+
+func (diagnosis *Diagnosis) isEmpty() bool {
+	return len(diagnosis.DaemonSets) == 0 && len(diagnosis.Pods) == 0 && len(diagnosis.Unschedulable) == 0 &&
+		len(diagnosis.Events) == 0 && len(diagnosis.Errors) == 0
+}
+
+// ReadinessError is returned when a resource does not become ready before the timeout. It wraps the wait error
+// and carries a Diagnosis of the namespace where the resource deploys its operands.
+type ReadinessError struct {
+	Kind      string
+	Name      string
+	LastState string
+	Err       error
+	Diagnosis *Diagnosis
+}
+
+// Error returns the wait error followed by the diagnosis.
+func (readinessError *ReadinessError) Error() string {
+	message := fmt.Sprintf("%s %s is not ready, last state '%s': %v", readinessError.Kind, readinessError.Name,
+		readinessError.LastState, readinessError.Err)
+
+	if readinessError.Diagnosis == nil {
+		return message
+	}
+
+	return message + "\n" + readinessError.Diagnosis.String()
+}
+
+// Unwrap returns the wait error.
+func (readinessError *ReadinessError) Unwrap() error {
+	return readinessError.Err
+}
+
+// DiagnoseNamespace collects the DaemonSets which are not fully available, the Pending and crash looping pods,
+// the unschedulable reasons and the recent Warning events of the given namespace.
+func DiagnoseNamespace(ctx context.Context, apiClient *clients.Settings, namespace string) *Diagnosis {
+	diagnosis := &Diagnosis{Namespace: namespace}
+
+	if apiClient == nil {
+		diagnosis.Errors = append(diagnosis.Errors, "apiClient is nil")
+
+		return diagnosis
+	}
+
+	diagnoseDaemonSets(ctx, apiClient, diagnosis)
+	diagnosePods(ctx, apiClient, diagnosis)
+	diagnoseEvents(ctx, apiClient, diagnosis)
+
+	return diagnosis
+}
+
+func diagnoseDaemonSets(ctx context.Context, apiClient *clients.Settings, diagnosis *Diagnosis) {
+	daemonSets, err := apiClient.AppsV1Interface.DaemonSets(diagnosis.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		diagnosis.Errors = append(diagnosis.Errors, fmt.Sprintf("failed to list DaemonSets: %v", err))
+
+		return
+	}
+
+	for _, daemonSet := range daemonSets.Items {
+		status := daemonSet.Status
+		if status.NumberAvailable >= status.DesiredNumberScheduled && status.NumberMisscheduled == 0 {
+			continue
+		}
+
+		diagnosis.DaemonSets = append(diagnosis.DaemonSets, fmt.Sprintf(
+			"%s: %d/%d available, %d ready, %d unavailable, %d misscheduled", daemonSet.Name,
+			status.NumberAvailable, status.DesiredNumberScheduled, status.NumberReady, status.NumberUnavailable,
+			status.NumberMisscheduled))
+	}
+}
+
+func diagnosePods(ctx context.Context, apiClient *clients.Settings, diagnosis *Diagnosis) {
+	pods, err := apiClient.CoreV1Interface.Pods(diagnosis.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		diagnosis.Errors = append(diagnosis.Errors, fmt.Sprintf("failed to list pods: %v", err))
+
+		return
+	}
+
+	for _, pod := range pods.Items {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse &&
+				condition.Reason == corev1.PodReasonUnschedulable {
+				diagnosis.Unschedulable = append(diagnosis.Unschedulable,
+					fmt.Sprintf("%s: %s", pod.Name, condition.Message))
+			}
+		}
+
+		containerProblems := describeContainerProblems(pod.Status.InitContainerStatuses)
+		containerProblems = append(containerProblems, describeContainerProblems(pod.Status.ContainerStatuses)...)
+
+		if pod.Status.Phase != corev1.PodPending && len(containerProblems) == 0 {
+			continue
+		}
+
+		description := fmt.Sprintf("%s (%s)", pod.Name, pod.Status.Phase)
+		if len(containerProblems) > 0 {
+			description = fmt.Sprintf("%s: %s", description, strings.Join(containerProblems, "; "))
+		}
+
+		diagnosis.Pods = append(diagnosis.Pods, description)
+	}
+}
+
+// describeContainerProblems returns the waiting containers with their last termination reason.
+func describeContainerProblems(containerStatuses []corev1.ContainerStatus) []string {
+	var problems []string
+
+	for _, containerStatus := range containerStatuses {
+		if containerStatus.State.Waiting == nil || containerStatus.State.Waiting.Reason == "" ||
+			containerStatus.State.Waiting.Reason == "PodInitializing" {
+			continue
+		}
+
+		problem := fmt.Sprintf("container %s %s", containerStatus.Name, containerStatus.State.Waiting.Reason)
+
+		if terminated := containerStatus.LastTerminationState.Terminated; terminated != nil {
+			problem = fmt.Sprintf("%s after %d restarts, last terminated with reason %s and exit code %d", problem,
+				containerStatus.RestartCount, terminated.Reason, terminated.ExitCode)
+		} else if containerStatus.State.Waiting.Message != "" {
+			problem = fmt.Sprintf("%s: %s", problem, containerStatus.State.Waiting.Message)
+		}
+
+		problems = append(problems, problem)
+	}
+
+	return problems
+}
+
+func diagnoseEvents(ctx context.Context, apiClient *clients.Settings, diagnosis *Diagnosis) {
+	events, err := apiClient.CoreV1Interface.Events(diagnosis.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "type=" + corev1.EventTypeWarning,
+	})
+	if err != nil {
+		diagnosis.Errors = append(diagnosis.Errors, fmt.Sprintf("failed to list events: %v", err))
+
+		return
+	}
+
+	warnings := events.Items[:0]
+
+	for _, event := range events.Items {
+		// The field selector is not applied by every client, filter again to be safe.
+		if event.Type == corev1.EventTypeWarning {
+			warnings = append(warnings, event)
+		}
+	}
+
+	sort.SliceStable(warnings, func(i, j int) bool {
+		return eventTime(warnings[i]).Before(eventTime(warnings[j]))
+	})
+
+	if len(warnings) > maxWarningEvents {
+		warnings = warnings[len(warnings)-maxWarningEvents:]
+	}
+
+	for _, event := range warnings {
+		diagnosis.Events = append(diagnosis.Events, fmt.Sprintf("%s %s %s/%s: %s",
+			eventTime(event).UTC().Format(time.RFC3339), event.Reason, event.InvolvedObject.Kind,
+			event.InvolvedObject.Name, event.Message))
+	}
+}
+
+// eventTime returns the time the event was last observed.
+func eventTime(event corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// diagnoseTimeout wraps the error of a readiness wait into a ReadinessError with a diagnosis of the given namespace
+// when the wait timed out. Other errors, including the cancellation of the parent context, are returned as is.
+func diagnoseTimeout(ctx context.Context, apiClient *clients.Settings, err error, kind, name, lastState,
+	namespace string) error {
+	if err == nil || !wait.Interrupted(err) || ctx.Err() != nil {
+		return err
+	}
+
+	diagnosisCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), diagnosisTimeout)
+	defer cancel()
+
+	return &ReadinessError{
+		Kind:      kind,
+		Name:      name,
+		LastState: lastState,
+		Err:       err,
+		Diagnosis: DiagnoseNamespace(diagnosisCtx, apiClient, namespace),
+	}
+}
@@ -0,0 +1,147 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const testOperandNamespace = "nvidia-gpu-operator"
+
+func diagnosisObjects() []runtime.Object {
+	objects := []runtime.Object{
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "nvidia-driver-daemonset", Namespace: testOperandNamespace},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, NumberAvailable: 1, NumberUnavailable: 1},
+		},
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "gpu-feature-discovery", Namespace: testOperandNamespace},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, NumberAvailable: 2, NumberReady: 2},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "nvidia-driver-daemonset-abcde", Namespace: testOperandNamespace},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:         "nvidia-driver-ctr",
+					RestartCount: 4,
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+					},
+					LastTerminationState: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1},
+					},
+				}},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "nvidia-dcgm-fghij", Namespace: testOperandNamespace},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				Conditions: []corev1.PodCondition{{
+					Type:    corev1.PodScheduled,
+					Status:  corev1.ConditionFalse,
+					Reason:  corev1.PodReasonUnschedulable,
+					Message: "0/3 nodes are available: 3 Insufficient nvidia.com/gpu.",
+				}},
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "gpu-feature-discovery-klmno", Namespace: testOperandNamespace},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		},
+	}
+
+	baseTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for index := 0; index < maxWarningEvents+2; index++ {
+		objects = append(objects, &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: fmt.Sprintf("event-%d", index), Namespace: testOperandNamespace},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "BackOff",
+			Message:        fmt.Sprintf("warning %d", index),
+			InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "nvidia-driver-daemonset-abcde"},
+			LastTimestamp:  metav1.NewTime(baseTime.Add(time.Duration(index) * time.Minute)),
+		})
+	}
+
+	return append(objects, &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "normal", Namespace: testOperandNamespace},
+		Type:       corev1.EventTypeNormal,
+		Reason:     "Pulled",
+	})
+}
+
+func TestDiagnoseNamespace(t *testing.T) {
+	apiClient := clients.NewFakeSettings(diagnosisObjects()...)
+
+	diagnosis := DiagnoseNamespace(context.TODO(), apiClient, testOperandNamespace)
+
+	if len(diagnosis.DaemonSets) != 1 || !strings.HasPrefix(diagnosis.DaemonSets[0], "nvidia-driver-daemonset: 1/2") {
+		t.Errorf("unexpected DaemonSets diagnosis: %v", diagnosis.DaemonSets)
+	}
+
+	if len(diagnosis.Pods) != 2 {
+		t.Fatalf("expected 2 pods in diagnosis, got %v", diagnosis.Pods)
+	}
+
+	crashLoop := strings.Join(diagnosis.Pods, "\n")
+	if !strings.Contains(crashLoop, "CrashLoopBackOff after 4 restarts, last terminated with reason Error") {
+		t.Errorf("expected crash looping container with its last termination reason, got %v", diagnosis.Pods)
+	}
+
+	if len(diagnosis.Unschedulable) != 1 ||
+		!strings.Contains(diagnosis.Unschedulable[0], "Insufficient nvidia.com/gpu") {
+		t.Errorf("unexpected unschedulable diagnosis: %v", diagnosis.Unschedulable)
+	}
+
+	if len(diagnosis.Events) != maxWarningEvents {
+		t.Fatalf("expected %d Warning events, got %d", maxWarningEvents, len(diagnosis.Events))
+	}
+
+	if !strings.HasSuffix(diagnosis.Events[len(diagnosis.Events)-1], fmt.Sprintf("warning %d", maxWarningEvents+1)) {
+		t.Errorf("expected the most recent event last, got %v", diagnosis.Events)
+	}
+
+	if len(diagnosis.Errors) != 0 {
+		t.Errorf("unexpected diagnosis errors: %v", diagnosis.Errors)
+	}
+}
+
+func TestClusterPolicyReadyTimeoutDiagnosis(t *testing.T) {
+	objects := append(diagnosisObjects(), &nvidiagpuv1.ClusterPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu-cluster-policy"},
+		Status:     nvidiagpuv1.ClusterPolicyStatus{State: "notReady", Namespace: testOperandNamespace},
+	})
+	apiClient := clients.NewFakeSettings(objects...)
+
+	err := ClusterPolicyReadyWithContext(context.TODO(), apiClient, "gpu-cluster-policy",
+		10*time.Millisecond, 100*time.Millisecond)
+
+	var readinessError *ReadinessError
+	if !errors.As(err, &readinessError) {
+		t.Fatalf("expected ReadinessError, got %v", err)
+	}
+
+	if readinessError.LastState != "notReady" {
+		t.Errorf("expected last state notReady, got %s", readinessError.LastState)
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected error to wrap context.DeadlineExceeded, got %v", readinessError.Err)
+	}
+
+	if !strings.Contains(err.Error(), "nvidia-driver-daemonset: 1/2 available") {
+		t.Errorf("expected the diagnosis in the error message, got %s", err.Error())
+	}
+}
@@ -0,0 +1,9 @@
+package wait
+
+import "github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+
+var (
+	gpuLogger     = logging.Logger(logging.GPU)
+	networkLogger = logging.Logger(logging.Network)
+	watchLogger   = logging.Logger(logging.GPU).WithName("watch")
+)
@@ -0,0 +1,62 @@
+package wait
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nodes"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// MIGConfigApplied waits for a defined period of time for the mig-manager to apply the MIG configuration
+// the node is labeled with.
+func MIGConfigApplied(apiClient *clients.Settings, nodeName, migConfig string, pollInterval,
+	timeout time.Duration) error {
+	return MIGConfigAppliedWithContext(context.TODO(), apiClient, nodeName, migConfig, pollInterval, timeout)
+}
+
+// MIGConfigAppliedWithContext is the context-aware variant of MIGConfigApplied.
+// It returns as soon as the mig-manager reports it failed to apply the MIG configuration.
+func MIGConfigAppliedWithContext(
+	ctx context.Context, apiClient *clients.Settings, nodeName, migConfig string, pollInterval,
+	timeout time.Duration) error {
+	lastState := ""
+
+	err := wait.PollUntilContextTimeout(
+		ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			node, err := nodes.PullWithContext(ctx, apiClient, nodeName)
+
+			if err != nil {
+				gpuLogger.V(logging.LevelDebug).Info("Node pull from cluster error", "error", err)
+
+				return false, err
+			}
+
+			if config := node.Object.Labels[nvidiagpu.MIGConfigLabel]; config != migConfig {
+				return false, fmt.Errorf("node %s label %s is '%s' instead of '%s'", nodeName,
+					nvidiagpu.MIGConfigLabel, config, migConfig)
+			}
+
+			lastState = node.Object.Labels[nvidiagpu.MIGConfigStateLabel]
+
+			gpuLogger.V(logging.LevelDebug).Info("Node MIG config is now in state", "name", nodeName,
+				"config", migConfig, "state", lastState)
+
+			if lastState == nvidiagpu.MIGConfigStateFailed {
+				return false, fmt.Errorf("mig-manager failed to apply MIG config '%s' on node %s", migConfig, nodeName)
+			}
+
+			return lastState == nvidiagpu.MIGConfigStateSuccess, nil
+		})
+
+	if err != nil {
+		gpuLogger.Info("Node MIG config is not applied", "name", nodeName, "config", migConfig,
+			"state", lastState, "error", err)
+	}
+
+	return err
+}
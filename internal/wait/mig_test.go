@@ -0,0 +1,55 @@
+package wait
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMIGConfigApplied(t *testing.T) {
+	testCases := []struct {
+		name   string
+		labels map[string]string
+		valid  bool
+	}{
+		{
+			name: "success",
+			labels: map[string]string{nvidiagpu.MIGConfigLabel: "all-1g.10gb",
+				nvidiagpu.MIGConfigStateLabel: nvidiagpu.MIGConfigStateSuccess},
+			valid: true,
+		},
+		{
+			name: "failed",
+			labels: map[string]string{nvidiagpu.MIGConfigLabel: "all-1g.10gb",
+				nvidiagpu.MIGConfigStateLabel: nvidiagpu.MIGConfigStateFailed},
+		},
+		{
+			name: "pending",
+			labels: map[string]string{nvidiagpu.MIGConfigLabel: "all-1g.10gb",
+				nvidiagpu.MIGConfigStateLabel: "pending"},
+		},
+		{
+			name: "other config",
+			labels: map[string]string{nvidiagpu.MIGConfigLabel: "all-disabled",
+				nvidiagpu.MIGConfigStateLabel: nvidiagpu.MIGConfigStateSuccess},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			apiClient := clients.NewFakeSettings(&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Labels: testCase.labels}})
+
+			err := MIGConfigAppliedWithContext(context.TODO(), apiClient, "worker-0", "all-1g.10gb",
+				10*time.Millisecond, 100*time.Millisecond)
+			if testCase.valid != (err == nil) {
+				t.Errorf("expected valid %t, got error %v", testCase.valid, err)
+			}
+		})
+	}
+}
@@ -2,12 +2,12 @@ package wait
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidianetwork"
 
-	"github.com/golang/glog"
-	"github.com/rh-ecosystem-edge/nvidia-ci/internal/networkparams"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
 	"k8s.io/apimachinery/pkg/util/wait"
 
@@ -17,39 +17,69 @@ import (
 // NicClusterPolicyReady Waits until nicClusterPolicy is Ready.
 func NicClusterPolicyReady(apiClient *clients.Settings, nicClusterPolicyName string, pollInterval,
 	timeout time.Duration) error {
-	return wait.PollUntilContextTimeout(
-		context.Background(), pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
-			nicClusterPolicy, err := nvidianetwork.PullNicClusterPolicy(apiClient, nicClusterPolicyName)
+	return NicClusterPolicyReadyWithContext(context.TODO(), apiClient, nicClusterPolicyName, pollInterval, timeout)
+}
+
+// NicClusterPolicyReadyWithContext is the context-aware variant of NicClusterPolicyReady.
+// On timeout, the returned *ReadinessError holds a Diagnosis of the namespace of the NicClusterPolicy operands.
+func NicClusterPolicyReadyWithContext(
+	ctx context.Context, apiClient *clients.Settings, nicClusterPolicyName string, pollInterval,
+	timeout time.Duration) error {
+	lastState := ""
+
+	err := wait.PollUntilContextTimeout(
+		ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			nicClusterPolicy, err := nvidianetwork.PullNicClusterPolicyWithContext(ctx, apiClient, nicClusterPolicyName)
 
 			if err != nil {
-				glog.V(networkparams.LogLevel).Infof("NicClusterPolicy pull from cluster error: %s\n", err)
+				networkLogger.V(logging.LevelDebug).Info("NicClusterPolicy pull from cluster error", "error", err)
 
 				return false, err
 			}
 
-			glog.V(networkparams.LogLevel).Infof("NicClusterPolicy %s in now in %s state",
-				nicClusterPolicy.Object.Name, nicClusterPolicy.Object.Status.State)
+			networkLogger.V(logging.LevelDebug).Info("NicClusterPolicy is now in state",
+				"name", nicClusterPolicy.Object.Name, "state", nicClusterPolicy.Object.Status.State)
+
+			lastState = string(nicClusterPolicy.Object.Status.State)
 
 			// returns true, nil when NicClusterPolicy is ready, this exits out of the PollUntilContextTimeout()
 			return nicClusterPolicy.Object.Status.State == networkoperator.StateReady, nil
 		})
+
+	err = diagnoseTimeout(ctx, apiClient, err, "NicClusterPolicy", nicClusterPolicyName, lastState,
+		nvidianetwork.NvidiaNetworkNamespace)
+
+	var readinessError *ReadinessError
+	if errors.As(err, &readinessError) {
+		networkLogger.Info("NicClusterPolicy is not ready", "name", nicClusterPolicyName,
+			"diagnosis", readinessError.Diagnosis.String())
+	}
+
+	return err
 }
 
 // MacvlanNetworkReady Waits until macvlanNetwork is Ready.
 func MacvlanNetworkReady(apiClient *clients.Settings, macvlanNetworkName string, pollInterval,
+	timeout time.Duration) error {
+	return MacvlanNetworkReadyWithContext(context.TODO(), apiClient, macvlanNetworkName, pollInterval, timeout)
+}
+
+// MacvlanNetworkReadyWithContext is the context-aware variant of MacvlanNetworkReady.
+func MacvlanNetworkReadyWithContext(
+	ctx context.Context, apiClient *clients.Settings, macvlanNetworkName string, pollInterval,
 	timeout time.Duration) error {
 	return wait.PollUntilContextTimeout(
-		context.Background(), pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
-			macVlanNetwork, err := nvidianetwork.PullMacvlanNetwork(apiClient, macvlanNetworkName)
+		ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			macVlanNetwork, err := nvidianetwork.PullMacvlanNetworkWithContext(ctx, apiClient, macvlanNetworkName)
 
 			if err != nil {
-				glog.V(networkparams.LogLevel).Infof("MacvlanNetwork pull from cluster error: %s\n", err)
+				networkLogger.V(logging.LevelDebug).Info("MacvlanNetwork pull from cluster error", "error", err)
 
 				return false, err
 			}
 
-			glog.V(networkparams.LogLevel).Infof("MacvlanNetwork %s in now in %s state",
-				macVlanNetwork.Object.Name, macVlanNetwork.Object.Status.State)
+			networkLogger.V(logging.LevelDebug).Info("MacvlanNetwork is now in state",
+				"name", macVlanNetwork.Object.Name, "state", macVlanNetwork.Object.Status.State)
 
 			// returns true, nil when MacvlanNetwork is ready, this exits out of the PollUntilContextTimeout()
 			return macVlanNetwork.Object.Status.State == networkoperator.StateReady, nil
@@ -58,19 +88,26 @@ func MacvlanNetworkReady(apiClient *clients.Settings, macvlanNetworkName string,
 
 // IPoIBNetworkReady Waits until ipoibNetwork is Ready.
 func IPoIBNetworkReady(apiClient *clients.Settings, ipoibNetworkName string, pollInterval,
+	timeout time.Duration) error {
+	return IPoIBNetworkReadyWithContext(context.TODO(), apiClient, ipoibNetworkName, pollInterval, timeout)
+}
+
+// IPoIBNetworkReadyWithContext is the context-aware variant of IPoIBNetworkReady.
+func IPoIBNetworkReadyWithContext(
+	ctx context.Context, apiClient *clients.Settings, ipoibNetworkName string, pollInterval,
 	timeout time.Duration) error {
 	return wait.PollUntilContextTimeout(
-		context.Background(), pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
-			ipoIBNetwork, err := nvidianetwork.PullIPoIBNetwork(apiClient, ipoibNetworkName)
+		ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			ipoIBNetwork, err := nvidianetwork.PullIPoIBNetworkWithContext(ctx, apiClient, ipoibNetworkName)
 
 			if err != nil {
-				glog.V(networkparams.LogLevel).Infof("IPoIBNetwork pull from cluster error: %s\n", err)
+				networkLogger.V(logging.LevelDebug).Info("IPoIBNetwork pull from cluster error", "error", err)
 
 				return false, err
 			}
 
-			glog.V(networkparams.LogLevel).Infof("IPoIBNetwork %s in now in %s state",
-				ipoIBNetwork.Object.Name, ipoIBNetwork.Object.Status.State)
+			networkLogger.V(logging.LevelDebug).Info("IPoIBNetwork is now in state", "name", ipoIBNetwork.Object.Name,
+				"state", ipoIBNetwork.Object.Status.State)
 
 			// returns true, nil when IPoIBNetwork is ready, this exits out of the PollUntilContextTimeout()
 			return ipoIBNetwork.Object.Status.State == networkoperator.StateReady, nil
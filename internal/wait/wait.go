@@ -2,12 +2,13 @@ package wait
 
 import (
 	"context"
+	"errors"
 	"time"
 
-	"github.com/golang/glog"
-	"github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
+	nvidiagpuv1alpha1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1alpha1"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/deployment"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/olm"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -16,58 +17,141 @@ import (
 // ClusterPolicyReady Waits until clusterPolicy is Ready.
 func ClusterPolicyReady(apiClient *clients.Settings, clusterPolicyName string, pollInterval,
 	timeout time.Duration) error {
-	return wait.PollUntilContextTimeout(
-		context.TODO(), pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
-			clusterPolicy, err := nvidiagpu.Pull(apiClient, clusterPolicyName)
+	return ClusterPolicyReadyWithContext(context.TODO(), apiClient, clusterPolicyName, pollInterval, timeout)
+}
+
+// ClusterPolicyReadyWithContext is the context-aware variant of ClusterPolicyReady.
+// On timeout, the returned *ReadinessError holds a Diagnosis of the namespace of the ClusterPolicy operands.
+func ClusterPolicyReadyWithContext(
+	ctx context.Context, apiClient *clients.Settings, clusterPolicyName string, pollInterval,
+	timeout time.Duration) error {
+	lastState := ""
+	operandNamespace := nvidiagpu.NvidiaGPUNamespace
+
+	err := wait.PollUntilContextTimeout(
+		ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			clusterPolicy, err := nvidiagpu.PullWithContext(ctx, apiClient, clusterPolicyName)
 
 			if err != nil {
-				glog.V(gpuparams.GpuLogLevel).Infof("ClusterPolicy pull from cluster error: %s\n", err)
+				gpuLogger.V(logging.LevelDebug).Info("ClusterPolicy pull from cluster error", "error", err)
 
 				return false, err
 			}
 
 			if clusterPolicy.Object != nil && clusterPolicy.Object.Status.State == "ready" {
-				glog.V(gpuparams.GpuLogLevel).Infof("ClusterPolicy %s in now in %s state",
-					clusterPolicy.Object.Name, clusterPolicy.Object.Status.State)
+				gpuLogger.V(logging.LevelDebug).Info("ClusterPolicy is now in state",
+					"name", clusterPolicy.Object.Name, "state", clusterPolicy.Object.Status.State)
 
 				// this exits out of the PollUntilContextTimeout()
 				return true, nil
 			}
 			if clusterPolicy.Object == nil {
-				glog.V(gpuparams.GpuLogLevel).Info("ClusterPolicy object is nil")
+				gpuLogger.V(logging.LevelDebug).Info("ClusterPolicy object is nil")
 				return false, nil
 			}
 
-			glog.V(gpuparams.GpuLogLevel).Infof("ClusterPolicy %s in now in %s state",
-				clusterPolicy.Object.Name, clusterPolicy.Object.Status.State)
+			lastState = string(clusterPolicy.Object.Status.State)
+
+			if clusterPolicy.Object.Status.Namespace != "" {
+				operandNamespace = clusterPolicy.Object.Status.Namespace
+			}
+
+			gpuLogger.V(logging.LevelDebug).Info("ClusterPolicy is now in state", "name", clusterPolicy.Object.Name,
+				"state", clusterPolicy.Object.Status.State)
 
 			return false, nil
 		})
+
+	err = diagnoseTimeout(ctx, apiClient, err, "ClusterPolicy", clusterPolicyName, lastState, operandNamespace)
+
+	var readinessError *ReadinessError
+	if errors.As(err, &readinessError) {
+		gpuLogger.Info("ClusterPolicy is not ready", "name", clusterPolicyName,
+			"diagnosis", readinessError.Diagnosis.String())
+	}
+
+	return err
+}
+
+// NVIDIADriverReady waits for a defined period of time for the NVIDIADriver to be in ready state.
+func NVIDIADriverReady(apiClient *clients.Settings, nvidiaDriverName string, pollInterval,
+	timeout time.Duration) error {
+	return NVIDIADriverReadyWithContext(context.TODO(), apiClient, nvidiaDriverName, pollInterval, timeout)
+}
+
+// NVIDIADriverReadyWithContext is the context-aware variant of NVIDIADriverReady.
+// On timeout, the returned *ReadinessError holds a Diagnosis of the namespace of the driver operands.
+func NVIDIADriverReadyWithContext(
+	ctx context.Context, apiClient *clients.Settings, nvidiaDriverName string, pollInterval,
+	timeout time.Duration) error {
+	lastState := ""
+	operandNamespace := nvidiagpu.NvidiaGPUNamespace
+
+	err := wait.PollUntilContextTimeout(
+		ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			nvidiaDriver, err := nvidiagpu.PullNVIDIADriverWithContext(ctx, apiClient, nvidiaDriverName)
+
+			// A transient Get error lets the pull succeed without object, so both mean not ready yet.
+			if err != nil || nvidiaDriver.Object == nil {
+				gpuLogger.V(logging.LevelDebug).Info("NVIDIADriver pull from cluster error", "error", err)
+
+				return false, nil
+			}
+
+			lastState = string(nvidiaDriver.Object.Status.State)
+
+			if nvidiaDriver.Object.Status.Namespace != "" {
+				operandNamespace = nvidiaDriver.Object.Status.Namespace
+			}
+
+			gpuLogger.V(logging.LevelDebug).Info("NVIDIADriver is now in state", "name", nvidiaDriverName,
+				"state", lastState)
+
+			return nvidiaDriver.Object.Status.State == nvidiagpuv1alpha1.Ready, nil
+		})
+
+	err = diagnoseTimeout(ctx, apiClient, err, nvidiagpu.NVIDIADriverKind, nvidiaDriverName, lastState,
+		operandNamespace)
+
+	var readinessError *ReadinessError
+	if errors.As(err, &readinessError) {
+		gpuLogger.Info("NVIDIADriver is not ready", "name", nvidiaDriverName,
+			"diagnosis", readinessError.Diagnosis.String())
+	}
+
+	return err
 }
 
 // CSVSucceeded waits for a defined period of time for CSV to be in Succeeded state.
 func CSVSucceeded(apiClient *clients.Settings, csvName, csvNamespace string, pollInterval,
+	timeout time.Duration) error {
+	return CSVSucceededWithContext(context.TODO(), apiClient, csvName, csvNamespace, pollInterval, timeout)
+}
+
+// CSVSucceededWithContext is the context-aware variant of CSVSucceeded.
+func CSVSucceededWithContext(
+	ctx context.Context, apiClient *clients.Settings, csvName, csvNamespace string, pollInterval,
 	timeout time.Duration) error {
 	return wait.PollUntilContextTimeout(
-		context.TODO(), pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
-			csvPulled, err := olm.PullClusterServiceVersion(apiClient, csvName, csvNamespace)
+		ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			csvPulled, err := olm.PullClusterServiceVersionWithContext(ctx, apiClient, csvName, csvNamespace)
 
 			if err != nil {
-				glog.V(gpuparams.GpuLogLevel).Infof("ClusterServiceVersion pull from cluster error: %s\n", err)
+				gpuLogger.V(logging.LevelDebug).Info("ClusterServiceVersion pull from cluster error", "error", err)
 
 				return false, err
 			}
 
 			if csvPulled.Object.Status.Phase == "Succeeded" {
-				glog.V(gpuparams.GpuLogLevel).Infof("ClusterServiceVersion %s in now in %s state",
-					csvPulled.Object.Name, csvPulled.Object.Status.Phase)
+				gpuLogger.V(logging.LevelDebug).Info("ClusterServiceVersion is now in phase",
+					"name", csvPulled.Object.Name, "phase", csvPulled.Object.Status.Phase)
 
 				// this exists out of the wait.PollImmediate().
 				return true, nil
 			}
 
-			glog.V(gpuparams.GpuLogLevel).Infof("clusterPolicy %s in now in %s state",
-				csvPulled.Object.Name, csvPulled.Object.Status.Phase)
+			gpuLogger.V(logging.LevelDebug).Info("ClusterServiceVersion is now in phase",
+				"name", csvPulled.Object.Name, "phase", csvPulled.Object.Status.Phase)
 
 			return false, err
 		})
@@ -75,24 +159,32 @@ func CSVSucceeded(apiClient *clients.Settings, csvName, csvNamespace string, pol
 
 // DeploymentCreated waits for a defined period of time for deployment to be created.
 func DeploymentCreated(apiClient *clients.Settings, deploymentName, deploymentNamespace string, pollInterval,
+	timeout time.Duration) bool {
+	return DeploymentCreatedWithContext(context.TODO(), apiClient, deploymentName, deploymentNamespace,
+		pollInterval, timeout)
+}
+
+// DeploymentCreatedWithContext is the context-aware variant of DeploymentCreated.
+func DeploymentCreatedWithContext(
+	ctx context.Context, apiClient *clients.Settings, deploymentName, deploymentNamespace string, pollInterval,
 	timeout time.Duration) bool {
 	// Note: the value for boolean variable "immediate" is false here, meaning check AFTER polling interval
 	//       on the very first try.  Otherwise the first check was causing an error and failing testcase.
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), pollInterval, timeout, false, func(ctx context.Context) (bool, error) {
+		ctx, pollInterval, timeout, false, func(ctx context.Context) (bool, error) {
 			var err error
-			deploymentPulled, err := deployment.Pull(apiClient, deploymentName, deploymentNamespace)
+			deploymentPulled, err := deployment.PullWithContext(ctx, apiClient, deploymentName, deploymentNamespace)
 
 			if err != nil {
-				glog.V(gpuparams.GpuLogLevel).Infof("Deployment '%s' pull from cluster namespace '%s' error:"+
-					" %v", deploymentName, deploymentNamespace, err)
+				gpuLogger.V(logging.LevelDebug).Info("Deployment pull from cluster error",
+					"name", deploymentName, "namespace", deploymentNamespace, "error", err)
 
 				return false, err
 			}
 
-			if deploymentPulled.Exists() {
-				glog.V(gpuparams.GpuLogLevel).Infof("Deployment '%s' in namespace '%s' has been created",
-					deploymentPulled.Object.Name, deploymentNamespace)
+			if deploymentPulled.ExistsWithContext(ctx) {
+				gpuLogger.V(logging.LevelDebug).Info("Deployment has been created",
+					"name", deploymentPulled.Object.Name, "namespace", deploymentNamespace)
 
 				// this exists out of the wait.PollImmediate().
 				return true, nil
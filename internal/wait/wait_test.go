@@ -0,0 +1,59 @@
+package wait
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	nvidiagpuv1alpha1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1alpha1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestNVIDIADriverReadyWithGetErrors(t *testing.T) {
+	apiClient := clients.NewFakeSettings(&nvidiagpuv1alpha1.NVIDIADriver{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Status:     nvidiagpuv1alpha1.NVIDIADriverStatus{State: nvidiagpuv1alpha1.Ready},
+	})
+
+	// The first Gets fail with an error other than NotFound, which leaves the pulled NVIDIADriver without object.
+	failures := 2
+	apiClient.Client = interceptor.NewClient(apiClient.Client.(goclient.WithWatch), interceptor.Funcs{
+		Get: func(ctx context.Context, client goclient.WithWatch, key goclient.ObjectKey, obj goclient.Object,
+			opts ...goclient.GetOption) error {
+			if failures > 0 {
+				failures--
+
+				return k8serrors.NewServiceUnavailable("etcd leader changed")
+			}
+
+			return client.Get(ctx, key, obj, opts...)
+		},
+	})
+
+	err := NVIDIADriverReadyWithContext(context.TODO(), apiClient, "default", 10*time.Millisecond, time.Second)
+	if err != nil {
+		t.Errorf("expected NVIDIADriver ready after transient errors, got %v", err)
+	}
+
+	if failures != 0 {
+		t.Errorf("expected the Get errors to be retried, %d left", failures)
+	}
+
+	apiClient.Client = interceptor.NewClient(apiClient.Client.(goclient.WithWatch), interceptor.Funcs{
+		Get: func(ctx context.Context, client goclient.WithWatch, key goclient.ObjectKey, obj goclient.Object,
+			opts ...goclient.GetOption) error {
+			return k8serrors.NewForbidden(schema.GroupResource{Resource: "nvidiadrivers"}, key.Name, nil)
+		},
+	})
+
+	err = NVIDIADriverReadyWithContext(context.TODO(), apiClient, "default", 10*time.Millisecond,
+		50*time.Millisecond)
+	if err == nil {
+		t.Error("expected error waiting for NVIDIADriver failing every Get")
+	}
+}
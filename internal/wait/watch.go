@@ -0,0 +1,369 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+const (
+	// watchRetryInterval is the delay before listing the objects again after a failed list or a closed watch.
+	watchRetryInterval = 5 * time.Second
+)
+
+// ObjectCondition returns true when the watched object reached the expected state.
+// The object is nil while it does not exist.
+type ObjectCondition func(object runtimeClient.Object) (bool, error)
+
+// ListCondition returns true when the watched objects, sorted by namespace and name, reached the expected state.
+type ListCondition func(objects []runtimeClient.Object) (bool, error)
+
+// ProgressFunc is called with the watched objects and the elapsed time every time the condition is not yet met.
+type ProgressFunc func(objects []runtimeClient.Object, elapsed time.Duration)
+
+// TimeoutError is returned when a watched condition is not met before the timeout.
+type TimeoutError struct {
+	Kind      string
+	Namespace string
+	Name      string
+	Timeout   time.Duration
+	// LastState describes the objects observed last, or why they could not be observed.
+	LastState string
+}
+
+// Error returns the description of the timed out condition.
+func (timeoutError *TimeoutError) Error() string {
+	target := timeoutError.Kind
+	if timeoutError.Name != "" {
+		target = fmt.Sprintf("%s %s", target, timeoutError.Name)
+	}
+
+	if timeoutError.Namespace != "" {
+		target = fmt.Sprintf("%s in namespace '%s'", target, timeoutError.Namespace)
+	}
+
+	return fmt.Sprintf("timed out after %s waiting for %s: %s", timeoutError.Timeout, target, timeoutError.LastState)
+}
+
+// Unwrap returns context.DeadlineExceeded so that errors.Is can be used on a TimeoutError.
+func (timeoutError *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// ForObjectCondition watches the object with the name, namespace and type of the given object until the condition
+// holds. The object can be either typed or an *unstructured.Unstructured with its GroupVersionKind set.
+// The condition is called with nil while the object does not exist, which allows to wait for both its creation
+// and its deletion.
+func ForObjectCondition(ctx context.Context, apiClient *clients.Settings, object runtimeClient.Object,
+	timeout time.Duration, condition ObjectCondition, onProgress ProgressFunc) error {
+	if object == nil {
+		return fmt.Errorf("cannot watch nil object")
+	}
+
+	if condition == nil {
+		return fmt.Errorf("cannot watch %s with nil condition", object.GetName())
+	}
+
+	list, err := newListFor(apiClient, object)
+	if err != nil {
+		return err
+	}
+
+	var listOptions []runtimeClient.ListOption
+	if object.GetNamespace() != "" {
+		listOptions = append(listOptions, runtimeClient.InNamespace(object.GetNamespace()))
+	}
+
+	return watchList(ctx, apiClient, list, object.GetName(), timeout,
+		func(objects []runtimeClient.Object) (bool, error) {
+			for _, observed := range objects {
+				if observed.GetName() == object.GetName() {
+					return condition(observed)
+				}
+			}
+
+			return condition(nil)
+		}, onProgress, listOptions...)
+}
+
+// ForListCondition watches the objects of the given list type matching the list options until the condition holds.
+// The list can be either typed or an *unstructured.UnstructuredList with its GroupVersionKind set.
+func ForListCondition(ctx context.Context, apiClient *clients.Settings, list runtimeClient.ObjectList,
+	timeout time.Duration, condition ListCondition, onProgress ProgressFunc,
+	listOptions ...runtimeClient.ListOption) error {
+	if list == nil {
+		return fmt.Errorf("cannot watch nil list")
+	}
+
+	if condition == nil {
+		return fmt.Errorf("cannot watch list with nil condition")
+	}
+
+	return watchList(ctx, apiClient, list, "", timeout, condition, onProgress, listOptions...)
+}
+
+// watchList lists the objects, then watches them from the listed resource version, evaluating the condition after
+// every observed change. The objects are listed again whenever the watch fails or is closed by the server.
+//
+//nolint:funlen,gocognit
+func watchList(ctx context.Context, apiClient *clients.Settings, list runtimeClient.ObjectList, name string,
+	timeout time.Duration, condition ListCondition, onProgress ProgressFunc,
+	listOptions ...runtimeClient.ListOption) error {
+	if apiClient == nil {
+		return fmt.Errorf("cannot watch with nil apiClient")
+	}
+
+	watchClient, ok := apiClient.Client.(runtimeClient.WithWatch)
+	if !ok {
+		return fmt.Errorf("apiClient does not support watching objects")
+	}
+
+	listOptionsApplied := &runtimeClient.ListOptions{}
+	listOptionsApplied.ApplyOptions(listOptions)
+
+	timeoutError := &TimeoutError{
+		Kind:      kindOf(watchClient, list),
+		Namespace: listOptionsApplied.Namespace,
+		Name:      name,
+		Timeout:   timeout,
+		LastState: "no object observed",
+	}
+
+	watchCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	startTime := time.Now()
+	objects := map[string]runtimeClient.Object{}
+
+	evaluate := func() (bool, error) {
+		sortedObjects := sortObjects(objects)
+		timeoutError.LastState = describeObjects(sortedObjects)
+
+		done, err := condition(sortedObjects)
+		if err != nil || done {
+			return done, err
+		}
+
+		if onProgress != nil {
+			onProgress(sortedObjects, time.Since(startTime))
+		}
+
+		return false, nil
+	}
+
+	for {
+		currentList, _ := list.DeepCopyObject().(runtimeClient.ObjectList)
+
+		if err := watchClient.List(watchCtx, currentList, listOptions...); err != nil {
+			if watchCtx.Err() != nil {
+				return waitError(ctx, timeoutError)
+			}
+
+			watchLogger.V(logging.LevelDebug).Info("Failed to list watched objects", "kind", timeoutError.Kind,
+				"error", err.Error())
+			timeoutError.LastState = fmt.Sprintf("last list failed: %v", err)
+
+			if !sleepContext(watchCtx, watchRetryInterval) {
+				return waitError(ctx, timeoutError)
+			}
+
+			continue
+		}
+
+		items, err := meta.ExtractList(currentList)
+		if err != nil {
+			return fmt.Errorf("failed to extract %s list: %w", timeoutError.Kind, err)
+		}
+
+		objects = map[string]runtimeClient.Object{}
+
+		for _, item := range items {
+			if object, ok := item.(runtimeClient.Object); ok {
+				objects[objectKey(object)] = object
+			}
+		}
+
+		if done, err := evaluate(); err != nil || done {
+			return err
+		}
+
+		watchedList, _ := list.DeepCopyObject().(runtimeClient.ObjectList)
+		watchOptions := append(append([]runtimeClient.ListOption{}, listOptions...), &runtimeClient.ListOptions{
+			Raw: &metav1.ListOptions{ResourceVersion: currentList.GetResourceVersion()},
+		})
+
+		watcher, err := watchClient.Watch(watchCtx, watchedList, watchOptions...)
+		if err != nil {
+			if watchCtx.Err() != nil {
+				return waitError(ctx, timeoutError)
+			}
+
+			watchLogger.V(logging.LevelDebug).Info("Failed to watch objects", "kind", timeoutError.Kind,
+				"error", err.Error())
+
+			if !sleepContext(watchCtx, watchRetryInterval) {
+				return waitError(ctx, timeoutError)
+			}
+
+			continue
+		}
+
+		done, err := consumeEvents(watchCtx, watcher, objects, evaluate)
+		watcher.Stop()
+
+		if err != nil || done {
+			return err
+		}
+
+		if watchCtx.Err() != nil {
+			return waitError(ctx, timeoutError)
+		}
+
+		watchLogger.V(logging.LevelTrace).Info("Watch closed, listing objects again", "kind", timeoutError.Kind)
+	}
+}
+
+// consumeEvents applies the watch events to objects and evaluates the condition after each of them. It returns when
+// the condition is met, the watch is closed or failed, or the context is done.
+func consumeEvents(ctx context.Context, watcher watch.Interface, objects map[string]runtimeClient.Object,
+	evaluate func() (bool, error)) (bool, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return false, nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return false, nil
+			}
+
+			object, isObject := event.Object.(runtimeClient.Object)
+
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				if isObject {
+					objects[objectKey(object)] = object
+				}
+			case watch.Deleted:
+				if isObject {
+					delete(objects, objectKey(object))
+				}
+			case watch.Error:
+				watchLogger.V(logging.LevelDebug).Info("Watch error event received", "status", event.Object)
+
+				return false, nil
+			default:
+				continue
+			}
+
+			if done, err := evaluate(); err != nil || done {
+				return done, err
+			}
+		}
+	}
+}
+
+// newListFor returns an empty list of the type of the given object.
+func newListFor(apiClient *clients.Settings, object runtimeClient.Object) (runtimeClient.ObjectList, error) {
+	if apiClient == nil || apiClient.Client == nil {
+		return nil, fmt.Errorf("cannot watch with nil apiClient")
+	}
+
+	gvk, err := apiutil.GVKForObject(object, apiClient.Client.Scheme())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the kind of object %s: %w", object.GetName(), err)
+	}
+
+	listGVK := gvk.GroupVersion().WithKind(gvk.Kind + "List")
+
+	if _, isUnstructured := object.(*unstructured.Unstructured); isUnstructured {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(listGVK)
+
+		return list, nil
+	}
+
+	newList, err := apiClient.Client.Scheme().New(listGVK)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", listGVK.Kind, err)
+	}
+
+	list, ok := newList.(runtimeClient.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a list", listGVK.Kind)
+	}
+
+	return list, nil
+}
+
+func kindOf(watchClient runtimeClient.WithWatch, object runtime.Object) string {
+	gvk, err := apiutil.GVKForObject(object, watchClient.Scheme())
+	if err != nil {
+		return fmt.Sprintf("%T", object)
+	}
+
+	return strings.TrimSuffix(gvk.Kind, "List")
+}
+
+func objectKey(object runtimeClient.Object) string {
+	return object.GetNamespace() + "/" + object.GetName()
+}
+
+func sortObjects(objects map[string]runtimeClient.Object) []runtimeClient.Object {
+	keys := make([]string, 0, len(objects))
+	for key := range objects {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	sortedObjects := make([]runtimeClient.Object, 0, len(keys))
+	for _, key := range keys {
+		sortedObjects = append(sortedObjects, objects[key])
+	}
+
+	return sortedObjects
+}
+
+func describeObjects(objects []runtimeClient.Object) string {
+	if len(objects) == 0 {
+		return "no object observed"
+	}
+
+	names := make([]string, 0, len(objects))
+	for _, object := range objects {
+		names = append(names, objectKey(object))
+	}
+
+	return fmt.Sprintf("condition not met by %d observed object(s) %v", len(objects), names)
+}
+
+func waitError(ctx context.Context, timeoutError *TimeoutError) error {
+	if ctx.Err() != nil && !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ctx.Err()
+	}
+
+	return timeoutError
+}
+
+// sleepContext waits for the given duration and returns false if the context is done first.
+func sleepContext(ctx context.Context, duration time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(duration):
+		return true
+	}
+}
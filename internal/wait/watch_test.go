@@ -0,0 +1,95 @@
+package wait
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestForObjectConditionCreated(t *testing.T) {
+	apiClient := clients.NewFakeSettings()
+	deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "gpu-operator", Namespace: "nvidia-gpu-operator"}}
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+
+		if err := apiClient.Client.Create(context.TODO(), deployment.DeepCopy()); err != nil {
+			t.Errorf("unexpected error creating deployment: %v", err)
+		}
+	}()
+
+	progressCalls := 0
+
+	err := ForObjectCondition(context.TODO(), apiClient, &appsv1.Deployment{ObjectMeta: deployment.ObjectMeta},
+		5*time.Second, func(object runtimeClient.Object) (bool, error) {
+			return object != nil, nil
+		}, func(objects []runtimeClient.Object, elapsed time.Duration) {
+			progressCalls++
+		})
+
+	if err != nil {
+		t.Fatalf("unexpected error waiting for deployment: %v", err)
+	}
+
+	if progressCalls == 0 {
+		t.Error("expected progress callback to be called before the deployment was created")
+	}
+}
+
+func TestForObjectConditionUnstructured(t *testing.T) {
+	apiClient := clients.NewFakeSettings(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu-burn-entrypoint", Namespace: "test-gpu-burn"},
+		Data:       map[string]string{"ready": "true"},
+	})
+
+	configMap := &unstructured.Unstructured{}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetName("gpu-burn-entrypoint")
+	configMap.SetNamespace("test-gpu-burn")
+
+	err := ForObjectCondition(context.TODO(), apiClient, configMap, 5*time.Second,
+		func(object runtimeClient.Object) (bool, error) {
+			if object == nil {
+				return false, nil
+			}
+
+			value, _, err := unstructured.NestedString(object.(*unstructured.Unstructured).Object, "data", "ready")
+
+			return value == "true", err
+		}, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error waiting for configmap: %v", err)
+	}
+}
+
+func TestForListConditionTimeout(t *testing.T) {
+	apiClient := clients.NewFakeSettings(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-0"}})
+
+	err := ForListCondition(context.TODO(), apiClient, &corev1.NodeList{}, 200*time.Millisecond,
+		func(objects []runtimeClient.Object) (bool, error) {
+			return len(objects) > 1, nil
+		}, nil)
+
+	var timeoutError *TimeoutError
+	if !errors.As(err, &timeoutError) {
+		t.Fatalf("expected TimeoutError, got %v", err)
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected error to wrap context.DeadlineExceeded")
+	}
+
+	if timeoutError.Kind != "Node" {
+		t.Errorf("expected Node kind in timeout error, got %s", timeoutError.Kind)
+	}
+}
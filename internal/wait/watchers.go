@@ -0,0 +1,89 @@
+package wait
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// WatchDeploymentCreated watches the deployment until it exists.
+func WatchDeploymentCreated(ctx context.Context, apiClient *clients.Settings, deploymentName,
+	deploymentNamespace string, timeout time.Duration) error {
+	return ForObjectCondition(ctx, apiClient,
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: deploymentName, Namespace: deploymentNamespace}},
+		timeout, func(object runtimeClient.Object) (bool, error) {
+			return object != nil, nil
+		}, logProgress(watchLogger, "Waiting for Deployment to be created", deploymentName))
+}
+
+// WatchCatalogSourceReady watches the catalogsource until its gRPC connection state is READY.
+func WatchCatalogSourceReady(ctx context.Context, apiClient *clients.Settings, catalogSourceName,
+	catalogSourceNamespace string, timeout time.Duration) error {
+	return ForObjectCondition(ctx, apiClient, &operatorsv1alpha1.CatalogSource{
+		ObjectMeta: metav1.ObjectMeta{Name: catalogSourceName, Namespace: catalogSourceNamespace}},
+		timeout, func(object runtimeClient.Object) (bool, error) {
+			catalogSource, ok := object.(*operatorsv1alpha1.CatalogSource)
+
+			return ok && catalogSource.Status.GRPCConnectionState != nil &&
+				catalogSource.Status.GRPCConnectionState.LastObservedState == "READY", nil
+		}, logProgress(watchLogger, "Waiting for CatalogSource to be ready", catalogSourceName))
+}
+
+// WatchNodesLabeled watches the nodes until at least one of them matches the label selector.
+func WatchNodesLabeled(ctx context.Context, apiClient *clients.Settings, nodeSelector map[string]string,
+	timeout time.Duration) error {
+	return ForListCondition(ctx, apiClient, &corev1.NodeList{}, timeout,
+		func(objects []runtimeClient.Object) (bool, error) {
+			return len(objects) > 0, nil
+		}, logProgress(watchLogger, "Waiting for nodes to be labeled", ""),
+		runtimeClient.MatchingLabels(nodeSelector))
+}
+
+// WatchSubscriptionCSVChanged watches the subscription until its current CSV is set and differs from previousCSV.
+func WatchSubscriptionCSVChanged(ctx context.Context, apiClient *clients.Settings, subscriptionName,
+	subscriptionNamespace, previousCSV string, timeout time.Duration) error {
+	return ForObjectCondition(ctx, apiClient, &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: subscriptionName, Namespace: subscriptionNamespace}},
+		timeout, func(object runtimeClient.Object) (bool, error) {
+			subscription, ok := object.(*operatorsv1alpha1.Subscription)
+
+			return ok && subscription.Status.CurrentCSV != "" && subscription.Status.CurrentCSV != previousCSV, nil
+		}, logProgress(watchLogger, "Waiting for Subscription current CSV to change", subscriptionName))
+}
+
+// WatchPodPhase watches the pod until it reaches one of the given phases.
+func WatchPodPhase(ctx context.Context, apiClient *clients.Settings, podName, podNamespace string,
+	timeout time.Duration, phases ...corev1.PodPhase) error {
+	return ForObjectCondition(ctx, apiClient,
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: podNamespace}},
+		timeout, func(object runtimeClient.Object) (bool, error) {
+			pod, ok := object.(*corev1.Pod)
+			if !ok {
+				return false, nil
+			}
+
+			for _, phase := range phases {
+				if pod.Status.Phase == phase {
+					return true, nil
+				}
+			}
+
+			return false, nil
+		}, logProgress(watchLogger, "Waiting for Pod phase", podName))
+}
+
+// logProgress returns a ProgressFunc logging the given message with the number of observed objects.
+func logProgress(logger logr.Logger, message, name string) ProgressFunc {
+	return func(objects []runtimeClient.Object, elapsed time.Duration) {
+		logger.V(logging.LevelDebug).Info(message, "name", name, "observed", len(objects),
+			"elapsed", elapsed.Round(time.Second).String())
+	}
+}
@@ -0,0 +1,198 @@
+package workload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/configmap"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// NodeMetricLabel is the metric label holding the node the workload pod ran on.
+const NodeMetricLabel = "node"
+
+var logger = logging.Logger(logging.GPU)
+
+// Run is a workload deployed in a namespace.
+type Run struct {
+	Workload   GPUWorkload
+	Namespace  string
+	Targets    []Target
+	ConfigMaps []*configmap.Builder
+	// Pods are the pods of the targets, in the same order.
+	Pods []*pod.Builder
+
+	apiClient *clients.Settings
+}
+
+// Deploy creates the ConfigMaps of the workload, then one pod per target, in the namespace. On failure the returned
+// run holds the resources created so far, which Cleanup removes.
+func Deploy(apiClient *clients.Settings, gpuWorkload GPUWorkload, namespace string,
+	targets []Target) (*Run, error) {
+	return DeployWithContext(context.TODO(), apiClient, gpuWorkload, namespace, targets)
+}
+
+// DeployWithContext is the context-aware variant of Deploy.
+func DeployWithContext(ctx context.Context, apiClient *clients.Settings, gpuWorkload GPUWorkload, namespace string,
+	targets []Target) (*Run, error) {
+	run := &Run{Workload: gpuWorkload, Namespace: namespace, Targets: targets, apiClient: apiClient}
+
+	if len(targets) == 0 {
+		return run, fmt.Errorf("workload %s has no target to run on", gpuWorkload.Name())
+	}
+
+	configMapData := gpuWorkload.ConfigMaps()
+	configMapNames := make([]string, 0, len(configMapData))
+
+	for name := range configMapData {
+		configMapNames = append(configMapNames, name)
+	}
+
+	sort.Strings(configMapNames)
+
+	for _, name := range configMapNames {
+		logger.V(logging.LevelDebug).Info("Creating workload ConfigMap", "workload", gpuWorkload.Name(),
+			"name", name, "namespace", namespace)
+
+		configMapBuilder, err := configmap.NewBuilder(apiClient, name, namespace).WithData(configMapData[name]).
+			CreateWithContext(ctx)
+		if err != nil {
+			return run, fmt.Errorf("failed to create workload %s ConfigMap %s: %w", gpuWorkload.Name(), name, err)
+		}
+
+		run.ConfigMaps = append(run.ConfigMaps, configMapBuilder)
+	}
+
+	for _, target := range targets {
+		podDefinition, err := gpuWorkload.Pod(namespace, target)
+		if err != nil {
+			return run, fmt.Errorf("failed to render workload %s pod %s: %w", gpuWorkload.Name(), target.Name, err)
+		}
+
+		logger.V(logging.LevelDebug).Info("Creating workload pod", "workload", gpuWorkload.Name(),
+			"name", podDefinition.Name, "namespace", namespace, "node", target.NodeName)
+
+		podBuilder, err := pod.NewBuilderFromDefinition(apiClient, podDefinition).CreateWithContext(ctx)
+		if err != nil {
+			return run, fmt.Errorf("failed to create workload %s pod %s: %w", gpuWorkload.Name(),
+				podDefinition.Name, err)
+		}
+
+		run.Pods = append(run.Pods, podBuilder)
+	}
+
+	return run, nil
+}
+
+// WaitForPhase waits for up to timeout for every pod of the run to be in the given phase.
+func (run *Run) WaitForPhase(phase corev1.PodPhase, timeout time.Duration) error {
+	return run.WaitForPhaseWithContext(context.TODO(), phase, timeout)
+}
+
+// WaitForPhaseWithContext is the context-aware variant of WaitForPhase.
+// The pods are waited for one after the other, each for up to timeout, then pulled again.
+func (run *Run) WaitForPhaseWithContext(ctx context.Context, phase corev1.PodPhase, timeout time.Duration) error {
+	for index, podBuilder := range run.Pods {
+		if err := podBuilder.WaitUntilInStatusWithContext(ctx, phase, timeout); err != nil {
+			return fmt.Errorf("workload %s pod %s is not %s: %w", run.Workload.Name(), podBuilder.Definition.Name,
+				phase, err)
+		}
+
+		if err := run.pullPod(ctx, index); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate collects the output of every pod of the run and validates it.
+func (run *Run) Validate() ([]*Result, error) {
+	return run.ValidateWithContext(context.TODO())
+}
+
+// ValidateWithContext is the context-aware variant of Validate.
+func (run *Run) ValidateWithContext(ctx context.Context) ([]*Result, error) {
+	results := make([]*Result, 0, len(run.Pods))
+
+	for index, podBuilder := range run.Pods {
+		output, err := podBuilder.GetFullLogWithContext(ctx, run.Workload.Container())
+		if err != nil {
+			return results, fmt.Errorf("failed to get workload %s pod %s logs: %w", run.Workload.Name(),
+				podBuilder.Definition.Name, err)
+		}
+
+		logger.V(logging.LevelDebug).Info("Collected workload pod logs", "workload", run.Workload.Name(),
+			"name", podBuilder.Definition.Name, "logs", output)
+
+		result, err := run.Workload.Validate(output, run.Targets[index])
+		if err != nil {
+			return results, fmt.Errorf("failed to validate workload %s pod %s output: %w", run.Workload.Name(),
+				podBuilder.Definition.Name, err)
+		}
+
+		if err := run.pullPod(ctx, index); err != nil {
+			return results, err
+		}
+
+		result.PodName = podBuilder.Definition.Name
+		result.NodeName = run.Pods[index].Object.Spec.NodeName
+
+		for metricIndex := range result.Metrics {
+			if result.Metrics[metricIndex].Labels == nil {
+				result.Metrics[metricIndex].Labels = map[string]string{}
+			}
+
+			result.Metrics[metricIndex].Labels[NodeMetricLabel] = result.NodeName
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// pullPod pulls the pod of the given index again. The node of the pods of the targets not pinned to a node is
+// only known once they are scheduled.
+func (run *Run) pullPod(ctx context.Context, index int) error {
+	podBuilder, err := pod.PullWithContext(ctx, run.apiClient, run.Pods[index].Definition.Name, run.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to pull workload %s pod %s: %w", run.Workload.Name(),
+			run.Pods[index].Definition.Name, err)
+	}
+
+	run.Pods[index] = podBuilder
+
+	return nil
+}
+
+// Cleanup deletes the pods, then the ConfigMaps of the run.
+func (run *Run) Cleanup() error {
+	return run.CleanupWithContext(context.TODO())
+}
+
+// CleanupWithContext is the context-aware variant of Cleanup.
+func (run *Run) CleanupWithContext(ctx context.Context) error {
+	var errs []error
+
+	for _, podBuilder := range run.Pods {
+		if _, err := podBuilder.DeleteWithContext(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete workload pod %s: %w", podBuilder.Definition.Name, err))
+		}
+	}
+
+	for _, configMapBuilder := range run.ConfigMaps {
+		if err := configMapBuilder.DeleteWithContext(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete workload ConfigMap %s: %w",
+				configMapBuilder.Definition.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,153 @@
+package workload
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/imagecatalog"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/nvidiagpuconfig"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/runsummary"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nodes"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// GPUWorkload is a validation workload running on GPUs. The Run type deploys it, waits for it, collects its output
+// and cleans it up, so a workload only describes its resources and how to validate its output.
+type GPUWorkload interface {
+	// Name returns the name the workload is registered by.
+	Name() string
+	// ConfigMaps returns the data of the ConfigMaps the workload pods mount, keyed by ConfigMap name.
+	ConfigMaps() map[string]map[string]string
+	// Pod returns the pod running the workload on the target in the namespace.
+	Pod(namespace string, target Target) (*corev1.Pod, error)
+	// Container returns the name of the container whose logs hold the workload output.
+	Container() string
+	// Duration returns how long the workload runs.
+	Duration() time.Duration
+	// Validate parses the output of the workload pod of the target and checks it against the thresholds.
+	Validate(output string, target Target) (*Result, error)
+}
+
+// Target is a pod of a workload and where it runs.
+type Target struct {
+	// Name is the name of the pod.
+	Name string
+	// NodeName is the node the pod runs on, empty to let the scheduler pick one of the workload nodes.
+	NodeName string
+	// GPUs is the number of GPUs the pod requests, 0 for the workload default.
+	GPUs int
+}
+
+// Result is the outcome of a workload pod.
+type Result struct {
+	PodName  string
+	NodeName string
+	// Metrics are the performance metrics of the workload, to be added to the run summary.
+	Metrics []runsummary.Metric
+	// Problems lists the thresholds the workload output did not meet.
+	Problems []string
+}
+
+// Params are the parameters the registered workloads are built with.
+type Params struct {
+	// Config is the GPU tests configuration holding the workload specific parameters.
+	Config *nvidiagpuconfig.NvidiaGPUConfig
+	// Architecture is the CPU architecture of the GPU nodes, selecting the workload image.
+	Architecture string
+	// Images is the catalog the workload image is resolved from, the default catalog when nil.
+	Images *imagecatalog.Catalog
+	// NodeSelector selects the nodes the workload pods run on.
+	NodeSelector map[string]string
+}
+
+// Factory builds a workload from the parameters.
+type Factory func(params Params) (GPUWorkload, error)
+
+var (
+	registryMutex sync.RWMutex
+	registry      = map[string]Factory{}
+)
+
+// Register makes a workload available by name. It panics if a workload is already registered by that name.
+func Register(name string, factory Factory) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	if _, found := registry[name]; found {
+		panic(fmt.Sprintf("workload %s is already registered", name))
+	}
+
+	registry[name] = factory
+}
+
+// New returns the workload registered by name built from the parameters.
+func New(name string, params Params) (GPUWorkload, error) {
+	registryMutex.RLock()
+	factory, found := registry[name]
+	registryMutex.RUnlock()
+
+	if !found {
+		return nil, fmt.Errorf("unknown workload '%s', registered workloads are %v", name, Names())
+	}
+
+	if params.Config == nil {
+		return nil, fmt.Errorf("cannot build workload '%s' without configuration", name)
+	}
+
+	if params.Images == nil {
+		params.Images = imagecatalog.Default()
+	}
+
+	return factory(params)
+}
+
+// Names returns the sorted names of the registered workloads.
+func Names() []string {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Targets returns count targets named prefix-0 to prefix-count-1, scheduled on any workload node.
+func Targets(prefix string, count int) []Target {
+	targets := make([]Target, 0, count)
+
+	for index := 0; index < count; index++ {
+		targets = append(targets, Target{Name: fmt.Sprintf("%s-%d", prefix, index)})
+	}
+
+	return targets
+}
+
+// NodeTargets returns one target per node, named prefix-0 to prefix-len(gpuNodes)-1, requesting gpus GPUs or, when
+// gpus is 0, all the allocatable GPUs of its node.
+func NodeTargets(prefix string, gpuNodes []*nodes.Builder, gpus int) []Target {
+	targets := make([]Target, 0, len(gpuNodes))
+
+	for index, gpuNode := range gpuNodes {
+		nodeGPUs := gpus
+		if nodeGPUs == 0 {
+			allocatable := gpuNode.Object.Status.Allocatable[nvidiagpu.GPUResourceName]
+			nodeGPUs = int(allocatable.Value())
+		}
+
+		targets = append(targets, Target{
+			Name:     fmt.Sprintf("%s-%d", prefix, index),
+			NodeName: gpuNode.Object.Name,
+			GPUs:     nodeGPUs,
+		})
+	}
+
+	return targets
+}
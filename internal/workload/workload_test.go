@@ -0,0 +1,128 @@
+package workload
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/nvidiagpuconfig"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/runsummary"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type testWorkload struct{}
+
+func (testWorkload) Name() string {
+	return "test"
+}
+
+func (testWorkload) ConfigMaps() map[string]map[string]string {
+	return map[string]map[string]string{"test-entrypoint": {"entrypoint.sh": "echo ok"}}
+}
+
+func (testWorkload) Pod(namespace string, target Target) (*corev1.Pod, error) {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: target.Name, Namespace: namespace},
+		Spec: corev1.PodSpec{
+			NodeName:   target.NodeName,
+			Containers: []corev1.Container{{Name: "test-ctr", Image: "test"}},
+		},
+	}, nil
+}
+
+func (testWorkload) Container() string {
+	return "test-ctr"
+}
+
+func (testWorkload) Duration() time.Duration {
+	return time.Minute
+}
+
+func (testWorkload) Validate(output string, target Target) (*Result, error) {
+	return &Result{Metrics: []runsummary.Metric{{Name: "test", Value: float64(len(output)), Unit: "bytes"}}}, nil
+}
+
+func TestRegistry(t *testing.T) {
+	Register("test", func(params Params) (GPUWorkload, error) {
+		return testWorkload{}, nil
+	})
+
+	if names := Names(); !reflect.DeepEqual(names, []string{"test"}) {
+		t.Errorf("unexpected registered workloads %v", names)
+	}
+
+	if _, err := New("test", Params{Config: &nvidiagpuconfig.NvidiaGPUConfig{}}); err != nil {
+		t.Errorf("unexpected error building the test workload: %v", err)
+	}
+
+	if _, err := New("test", Params{}); err == nil {
+		t.Errorf("expected error building a workload without configuration")
+	}
+
+	if _, err := New("unknown", Params{Config: &nvidiagpuconfig.NvidiaGPUConfig{}}); err == nil ||
+		!strings.Contains(err.Error(), "[test]") {
+		t.Errorf("expected an unknown workload error listing the registered workloads, got %v", err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected registering a workload twice to panic")
+		}
+	}()
+
+	Register("test", nil)
+}
+
+func TestRun(t *testing.T) {
+	apiClient := clients.NewFakeSettings()
+	targets := Targets("test", 2)
+	targets[1].NodeName = "worker-1"
+
+	if targets[0].Name != "test-0" || targets[1].Name != "test-1" {
+		t.Fatalf("unexpected targets %+v", targets)
+	}
+
+	run, err := Deploy(apiClient, testWorkload{}, "test-ns", targets)
+	if err != nil {
+		t.Fatalf("unexpected error deploying the test workload: %v", err)
+	}
+
+	if len(run.ConfigMaps) != 1 || len(run.Pods) != 2 {
+		t.Fatalf("expected 1 ConfigMap and 2 pods, got %d and %d", len(run.ConfigMaps), len(run.Pods))
+	}
+
+	// Schedule the unpinned pod, its node is only known once pulled again.
+	scheduledPod := run.Pods[0].Object.DeepCopy()
+	scheduledPod.Spec.NodeName = "worker-0"
+
+	if _, err := apiClient.Pods("test-ns").Update(t.Context(), scheduledPod, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("unexpected error scheduling the test pod: %v", err)
+	}
+
+	results, err := run.Validate()
+	if err != nil {
+		t.Fatalf("unexpected error validating the test workload: %v", err)
+	}
+
+	if len(results) != 2 || results[0].NodeName != "worker-0" || results[1].PodName != "test-1" ||
+		results[1].NodeName != "worker-1" ||
+		results[1].Metrics[0].Labels[NodeMetricLabel] != "worker-1" {
+		t.Errorf("unexpected results %+v", results)
+	}
+
+	if err := run.Cleanup(); err != nil {
+		t.Fatalf("unexpected error cleaning up the test workload: %v", err)
+	}
+
+	pods, err := apiClient.Pods("test-ns").List(t.Context(), metav1.ListOptions{})
+	if err != nil || len(pods.Items) != 0 {
+		t.Errorf("expected the pods to be deleted, got %v, %v", pods, err)
+	}
+
+	if _, err := Deploy(apiClient, testWorkload{}, "test-ns", nil); err == nil {
+		t.Errorf("expected error deploying a workload without target")
+	}
+}
@@ -57,6 +57,7 @@ type Settings struct {
 	PackageManifestInterface clientPkgManifestV1.OperatorsV1Interface
 	operatorv1alpha1.OperatorV1alpha1Interface
 	machinev1beta1client.MachineV1beta1Interface
+	DryRunRecorder *DryRunRecorder
 }
 
 // New returns a *Settings with the given kubeconfig.
@@ -109,7 +110,7 @@ func New(kubeconfig string) *Settings {
 		return nil
 	}
 
-	clientSet.Client, err = runtimeClient.New(config, runtimeClient.Options{
+	clientSet.Client, err = runtimeClient.NewWithWatch(config, runtimeClient.Options{
 		Scheme: crScheme,
 	})
 
@@ -0,0 +1,136 @@
+package clients
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/golang/glog"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DryRunCreate is the verb recorded for planned object creations.
+	DryRunCreate = "create"
+	// DryRunUpdate is the verb recorded for planned object updates.
+	DryRunUpdate = "update"
+	// DryRunDelete is the verb recorded for planned object deletions.
+	DryRunDelete = "delete"
+)
+
+// DryRunAction describes a single cluster mutation planned in dry-run mode.
+type DryRunAction struct {
+	Sequence  int    `json:"sequence"`
+	Verb      string `json:"verb"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	Manifest  string `json:"manifest"`
+}
+
+// DryRunRecorder keeps the ordered list of cluster mutations planned in dry-run mode and
+// writes them as a YAML plan file after every recorded action.
+type DryRunRecorder struct {
+	mutex    sync.Mutex
+	scheme   *runtime.Scheme
+	planFile string
+	actions  []DryRunAction
+}
+
+// NewDryRunRecorder returns a DryRunRecorder writing its plan into planFile.
+func NewDryRunRecorder(planFile string) (*DryRunRecorder, error) {
+	crScheme := runtime.NewScheme()
+
+	if err := SetScheme(crScheme); err != nil {
+		return nil, fmt.Errorf("failed to load dry-run recorder scheme: %w", err)
+	}
+
+	return &DryRunRecorder{scheme: crScheme, planFile: planFile}, nil
+}
+
+// Record appends the given verb and object to the plan and rewrites the plan file.
+func (recorder *DryRunRecorder) Record(verb string, object runtime.Object) error {
+	if object == nil {
+		return fmt.Errorf("cannot record dry-run %s of nil object", verb)
+	}
+
+	manifest, err := yaml.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("failed to render dry-run %s manifest: %w", verb, err)
+	}
+
+	action := DryRunAction{
+		Verb:     verb,
+		Kind:     recorder.kindOf(object),
+		Manifest: string(manifest),
+	}
+
+	if metaObject, ok := object.(interface {
+		GetName() string
+		GetNamespace() string
+	}); ok {
+		action.Name = metaObject.GetName()
+		action.Namespace = metaObject.GetNamespace()
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	action.Sequence = len(recorder.actions) + 1
+	recorder.actions = append(recorder.actions, action)
+
+	glog.V(100).Infof("Dry-run: recorded %s of %s %s in namespace '%s'",
+		action.Verb, action.Kind, action.Name, action.Namespace)
+
+	return recorder.writePlan()
+}
+
+// Actions returns a copy of the actions recorded so far.
+func (recorder *DryRunRecorder) Actions() []DryRunAction {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	return append([]DryRunAction{}, recorder.actions...)
+}
+
+func (recorder *DryRunRecorder) kindOf(object runtime.Object) string {
+	if kind := object.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+
+	if gvk, err := apiutil.GVKForObject(object, recorder.scheme); err == nil {
+		return gvk.Kind
+	}
+
+	return reflect.Indirect(reflect.ValueOf(object)).Type().Name()
+}
+
+func (recorder *DryRunRecorder) writePlan() error {
+	if recorder.planFile == "" {
+		return nil
+	}
+
+	plan, err := yaml.Marshal(recorder.actions)
+	if err != nil {
+		return fmt.Errorf("failed to render dry-run plan: %w", err)
+	}
+
+	return os.WriteFile(recorder.planFile, plan, 0666)
+}
+
+// IsDryRun returns true when the client records cluster mutations instead of applying them.
+func (settings *Settings) IsDryRun() bool {
+	return settings != nil && settings.DryRunRecorder != nil
+}
+
+// RecordDryRun records the given mutation in the dry-run plan.
+func (settings *Settings) RecordDryRun(verb string, object runtime.Object) error {
+	if !settings.IsDryRun() {
+		return fmt.Errorf("cannot record dry-run %s: dry-run mode is not enabled", verb)
+	}
+
+	return settings.DryRunRecorder.Record(verb, object)
+}
@@ -0,0 +1,76 @@
+package clients
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+func TestDryRunRecorder(t *testing.T) {
+	planFile := filepath.Join(t.TempDir(), "plan.yaml")
+
+	recorder, err := NewDryRunRecorder(planFile)
+	if err != nil {
+		t.Fatalf("unexpected error creating recorder: %v", err)
+	}
+
+	apiClient := NewFakeSettings()
+	apiClient.DryRunRecorder = recorder
+
+	if !apiClient.IsDryRun() {
+		t.Fatal("expected apiClient to be in dry-run mode")
+	}
+
+	testNamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "nvidia-gpu-operator"}}
+	testClusterPolicy := &nvidiagpuv1.ClusterPolicy{ObjectMeta: metav1.ObjectMeta{Name: "gpu-cluster-policy"}}
+
+	for _, record := range []struct {
+		verb   string
+		object runtime.Object
+	}{
+		{verb: DryRunCreate, object: testNamespace},
+		{verb: DryRunCreate, object: testClusterPolicy},
+		{verb: DryRunDelete, object: testNamespace},
+	} {
+		if err := apiClient.RecordDryRun(record.verb, record.object); err != nil {
+			t.Fatalf("unexpected error recording %s: %v", record.verb, err)
+		}
+	}
+
+	content, err := os.ReadFile(planFile)
+	if err != nil {
+		t.Fatalf("unexpected error reading plan file: %v", err)
+	}
+
+	var plan []DryRunAction
+	if err := yaml.Unmarshal(content, &plan); err != nil {
+		t.Fatalf("unexpected error parsing plan file: %v", err)
+	}
+
+	expected := []DryRunAction{
+		{Sequence: 1, Verb: DryRunCreate, Kind: "Namespace", Name: "nvidia-gpu-operator"},
+		{Sequence: 2, Verb: DryRunCreate, Kind: "ClusterPolicy", Name: "gpu-cluster-policy"},
+		{Sequence: 3, Verb: DryRunDelete, Kind: "Namespace", Name: "nvidia-gpu-operator"},
+	}
+
+	if len(plan) != len(expected) {
+		t.Fatalf("expected %d planned actions, got %d", len(expected), len(plan))
+	}
+
+	for index, action := range plan {
+		if action.Sequence != expected[index].Sequence || action.Verb != expected[index].Verb ||
+			action.Kind != expected[index].Kind || action.Name != expected[index].Name {
+			t.Errorf("unexpected action %d: %+v", index, action)
+		}
+
+		if action.Manifest == "" {
+			t.Errorf("expected rendered manifest for action %d", index)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package clients
+
+import (
+	"log"
+
+	configFake "github.com/openshift/client-go/config/clientset/versioned/fake"
+	machineFake "github.com/openshift/client-go/machine/clientset/versioned/fake"
+	operatorFake "github.com/openshift/client-go/operator/clientset/versioned/fake"
+	securityFake "github.com/openshift/client-go/security/clientset/versioned/fake"
+	olmFake "github.com/operator-framework/operator-lifecycle-manager/pkg/api/client/clientset/versioned/fake"
+	pkgManifestFake "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/client/clientset/versioned/fake"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicFake "k8s.io/client-go/dynamic/fake"
+	k8sFake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	runtimeFake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// NewFakeSettings returns a *Settings backed by in-memory fake clients and pre-populated with the given objects.
+// The controller-runtime and dynamic clients are seeded with every object, while each typed clientset only
+// receives the objects its own scheme knows about.
+func NewFakeSettings(objects ...runtime.Object) *Settings {
+	crScheme := runtime.NewScheme()
+
+	if err := SetScheme(crScheme); err != nil {
+		log.Print("Error to load fake apiClient scheme")
+
+		return nil
+	}
+
+	k8sClient := k8sFake.NewSimpleClientset(filterObjectsForScheme(k8sFake.AddToScheme, objects)...)
+	olmClient := olmFake.NewSimpleClientset(filterObjectsForScheme(olmFake.AddToScheme, objects)...)
+
+	clientSet := &Settings{}
+	clientSet.K8sClient = k8sClient
+	clientSet.CoreV1Interface = k8sClient.CoreV1()
+	clientSet.AppsV1Interface = k8sClient.AppsV1()
+	clientSet.ConfigV1Interface = configFake.NewSimpleClientset(
+		filterObjectsForScheme(configFake.AddToScheme, objects)...).ConfigV1()
+	clientSet.SecurityV1Interface = securityFake.NewSimpleClientset(
+		filterObjectsForScheme(securityFake.AddToScheme, objects)...).SecurityV1()
+	clientSet.OperatorsV1alpha1Interface = olmClient.OperatorsV1alpha1()
+	clientSet.OperatorsV1Interface = olmClient.OperatorsV1()
+	clientSet.PackageManifestInterface = pkgManifestFake.NewSimpleClientset(
+		filterObjectsForScheme(pkgManifestFake.AddToScheme, objects)...).OperatorsV1()
+	clientSet.OperatorV1alpha1Interface = operatorFake.NewSimpleClientset(
+		filterObjectsForScheme(operatorFake.AddToScheme, objects)...).OperatorV1alpha1()
+	clientSet.MachineV1beta1Interface = machineFake.NewSimpleClientset(
+		filterObjectsForScheme(machineFake.AddToScheme, objects)...).MachineV1beta1()
+	clientSet.Interface = dynamicFake.NewSimpleDynamicClient(crScheme, filterObjectsForScheme(SetScheme, objects)...)
+	clientSet.Client = runtimeFake.NewClientBuilder().
+		WithScheme(crScheme).
+		WithRuntimeObjects(filterObjectsForScheme(SetScheme, objects)...).
+		Build()
+	clientSet.Config = &rest.Config{}
+
+	return clientSet
+}
+
+// filterObjectsForScheme returns the objects whose kind is registered by the given addToScheme function.
+func filterObjectsForScheme(addToScheme func(*runtime.Scheme) error, objects []runtime.Object) []runtime.Object {
+	objScheme := runtime.NewScheme()
+
+	if err := addToScheme(objScheme); err != nil {
+		log.Printf("Error to load fake clientset scheme: %v", err)
+
+		return nil
+	}
+
+	var filtered []runtime.Object
+
+	for _, object := range objects {
+		if _, _, err := objScheme.ObjectKinds(object); err == nil {
+			filtered = append(filtered, object)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,88 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestNewFakeSettings(t *testing.T) {
+	testNamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "nvidia-gpu-operator"}}
+	testClusterPolicy := &nvidiagpuv1.ClusterPolicy{ObjectMeta: metav1.ObjectMeta{Name: "gpu-cluster-policy"}}
+	testSubscription := &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu-operator-certified", Namespace: "nvidia-gpu-operator"}}
+
+	apiClient := NewFakeSettings(testNamespace, testClusterPolicy, testSubscription)
+	if apiClient == nil {
+		t.Fatal("expected fake apiClient, got nil")
+	}
+
+	testCases := []struct {
+		name string
+		get  func() error
+	}{
+		{
+			name: "runtime client ClusterPolicy",
+			get: func() error {
+				return apiClient.Get(context.TODO(), types.NamespacedName{Name: testClusterPolicy.Name},
+					&nvidiagpuv1.ClusterPolicy{})
+			},
+		},
+		{
+			name: "runtime client Namespace",
+			get: func() error {
+				return apiClient.Get(context.TODO(), types.NamespacedName{Name: testNamespace.Name}, &corev1.Namespace{})
+			},
+		},
+		{
+			name: "CoreV1 Namespace",
+			get: func() error {
+				_, err := apiClient.Namespaces().Get(context.TODO(), testNamespace.Name, metav1.GetOptions{})
+
+				return err
+			},
+		},
+		{
+			name: "K8sClient Namespace",
+			get: func() error {
+				_, err := apiClient.K8sClient.CoreV1().Namespaces().Get(context.TODO(), testNamespace.Name,
+					metav1.GetOptions{})
+
+				return err
+			},
+		},
+		{
+			name: "OLM Subscription",
+			get: func() error {
+				_, err := apiClient.Subscriptions(testSubscription.Namespace).Get(context.TODO(),
+					testSubscription.Name, metav1.GetOptions{})
+
+				return err
+			},
+		},
+		{
+			name: "dynamic ClusterPolicy",
+			get: func() error {
+				_, err := apiClient.Resource(schema.GroupVersionResource{
+					Group: "nvidia.com", Version: "v1", Resource: "clusterpolicies"}).
+					Get(context.TODO(), testClusterPolicy.Name, metav1.GetOptions{})
+
+				return err
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if err := testCase.get(); err != nil {
+				t.Errorf("expected object to be found, got error: %v", err)
+			}
+		})
+	}
+}
@@ -25,6 +25,8 @@ type Builder struct {
 	// object is created.
 	errorMsg  string
 	apiClient corev1Typed.CoreV1Interface
+	// dryRunRecorder records the mutations of the builder instead of applying them when set.
+	dryRunRecorder *clients.DryRunRecorder
 }
 
 // AdditionalOptions additional options for configmap object.
@@ -32,8 +34,14 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // Pull retrieves an existing configmap object from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	return PullWithContext(context.TODO(), apiClient, name, nsname)
+}
+
+// PullWithContext is the context-aware variant of Pull.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	builder := Builder{
-		apiClient: apiClient.CoreV1Interface,
+		apiClient:      apiClient.CoreV1Interface,
+		dryRunRecorder: apiClient.DryRunRecorder,
 		Definition: &corev1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -57,7 +65,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	glog.V(100).Infof(
 		"Pulling configmap object name:%s in namespace: %s", name, nsname)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("configmap object %s doesn't exist in namespace %s", name, nsname)
 	}
 
@@ -72,7 +80,8 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 		"Initializing new configmap structure with the following params: %s, %s", name, nsname)
 
 	builder := &Builder{
-		apiClient: apiClient.CoreV1Interface,
+		apiClient:      apiClient.CoreV1Interface,
+		dryRunRecorder: apiClient.DryRunRecorder,
 		Definition: &corev1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -102,16 +111,27 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 
 // Create makes a configmap in cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	glog.V(100).Infof("Creating the configmap %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
+	if builder.dryRunRecorder != nil {
+		builder.Object = builder.Definition
+
+		return builder, builder.dryRunRecorder.Record(clients.DryRunCreate, builder.Definition)
+	}
+
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
 	}
 
 	return builder, err
@@ -119,18 +139,27 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Delete removes a configmap.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	glog.V(100).Infof("Deleting the configmap %s from namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if builder.dryRunRecorder != nil {
+		return builder.dryRunRecorder.Record(clients.DryRunDelete, builder.Definition)
+	}
+
+	if !builder.ExistsWithContext(ctx) {
 		return nil
 	}
 
 	err := builder.apiClient.ConfigMaps(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		ctx, builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -143,6 +172,11 @@ func (builder *Builder) Delete() error {
 
 // Exists checks whether the given configmap exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -153,7 +187,7 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.ConfigMaps(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -0,0 +1,363 @@
+package daemonset
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/msg"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/pod"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Builder provides struct for daemonset object containing connection to the cluster and the daemonset definitions.
+type Builder struct {
+	// DaemonSet definition.
+	Definition *appsv1.DaemonSet
+	// Created daemonset object.
+	Object *appsv1.DaemonSet
+	// Used in functions that define or mutate daemonset definition. errorMsg is processed before the daemonset
+	// object is used.
+	errorMsg string
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+}
+
+// Pull loads an existing daemonset into Builder struct.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	return PullWithContext(context.TODO(), apiClient, name, nsname)
+}
+
+// PullWithContext is the context-aware variant of Pull.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	// Safeguard against nil apiClient interfaces.
+	if apiClient == nil {
+		logger.V(logging.LevelTrace).Info("The apiClient is nil")
+
+		return nil, fmt.Errorf("apiClient cannot be nil")
+	}
+
+	logger.V(logging.LevelTrace).Info("Pulling existing daemonset", "name", name, "namespace", nsname)
+
+	builder := Builder{
+		apiClient: apiClient,
+		Definition: &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: nsname,
+			},
+		},
+	}
+
+	if name == "" {
+		logger.V(logging.LevelTrace).Info("The name of the daemonset is empty")
+
+		return nil, fmt.Errorf("daemonset 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		logger.V(logging.LevelTrace).Info("The namespace of the daemonset is empty")
+
+		return nil, fmt.Errorf("daemonset 'namespace' cannot be empty")
+	}
+
+	if !builder.ExistsWithContext(ctx) {
+		return nil, fmt.Errorf("daemonset object %s doesn't exist in namespace %s", name, nsname)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// Exists checks whether the given daemonset exists.
+func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Checking if daemonset exists")
+
+	var err error
+	builder.Object, err = builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
+		ctx, builder.Definition.Name, metav1.GetOptions{})
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Delete removes a daemonset.
+func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Deleting daemonset")
+
+	if builder.apiClient.IsDryRun() {
+		return builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
+
+	if !builder.ExistsWithContext(ctx) {
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.DaemonSets(builder.Definition.Namespace).Delete(
+		ctx, builder.Object.Name, metav1.DeleteOptions{})
+
+	if err != nil {
+		return err
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// IsReady periodically checks if the daemonset is rolled out and its pods are available on every desired node.
+func (builder *Builder) IsReady(timeout time.Duration) bool {
+	return builder.IsReadyWithContext(context.TODO(), timeout)
+}
+
+// IsReadyWithContext is the context-aware variant of IsReady.
+func (builder *Builder) IsReadyWithContext(ctx context.Context, timeout time.Duration) bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Running periodic check until daemonset is ready")
+
+	if !builder.ExistsWithContext(ctx) {
+		return false
+	}
+
+	return builder.waitUntilRolledOut(ctx, 0, timeout) == nil
+}
+
+// WaitUntilRolledOut waits until a generation newer than sinceGeneration is observed by the daemonset controller
+// and its pods are updated and available on every desired node. sinceGeneration is typically the Generation
+// returned before the daemonset or its owner was modified.
+func (builder *Builder) WaitUntilRolledOut(sinceGeneration int64, timeout time.Duration) error {
+	return builder.WaitUntilRolledOutWithContext(context.TODO(), sinceGeneration, timeout)
+}
+
+// WaitUntilRolledOutWithContext is the context-aware variant of WaitUntilRolledOut.
+func (builder *Builder) WaitUntilRolledOutWithContext(
+	ctx context.Context, sinceGeneration int64, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Waiting for the defined period until daemonset rolls out a newer "+
+		"generation", "sinceGeneration", sinceGeneration)
+
+	if !builder.ExistsWithContext(ctx) {
+		return fmt.Errorf("cannot wait for daemonset %s rollout because it does not exist", builder.Definition.Name)
+	}
+
+	err := builder.waitUntilRolledOut(ctx, sinceGeneration, timeout)
+	if err != nil {
+		return fmt.Errorf("daemonset %s in namespace %s is not rolled out: %w (%s)",
+			builder.Definition.Name, builder.Definition.Namespace, err, builder.describeStatus())
+	}
+
+	return nil
+}
+
+// Generation returns the generation of the daemonset spec last pulled from the cluster.
+func (builder *Builder) Generation() int64 {
+	if valid, _ := builder.validate(); !valid || builder.Object == nil {
+		return 0
+	}
+
+	return builder.Object.Generation
+}
+
+// IsRolledOut returns true when the last pulled daemonset status reports the current generation as observed and
+// its pods as updated and available on every desired node.
+func (builder *Builder) IsRolledOut() bool {
+	if valid, _ := builder.validate(); !valid || builder.Object == nil {
+		return false
+	}
+
+	return isRolledOut(builder.Object, 0)
+}
+
+// ListPods returns the pods owned by the daemonset.
+func (builder *Builder) ListPods() ([]*pod.Builder, error) {
+	return builder.ListPodsWithContext(context.TODO())
+}
+
+// ListPodsWithContext is the context-aware variant of ListPods.
+func (builder *Builder) ListPodsWithContext(ctx context.Context) ([]*pod.Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Listing pods of daemonset")
+
+	if !builder.ExistsWithContext(ctx) {
+		return nil, fmt.Errorf("cannot list pods of daemonset %s because it does not exist", builder.Definition.Name)
+	}
+
+	listOptions := metav1.ListOptions{}
+
+	if builder.Object.Spec.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(builder.Object.Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector in daemonset %s: %w", builder.Definition.Name, err)
+		}
+
+		listOptions.LabelSelector = selector.String()
+	}
+
+	podBuilders, err := pod.ListWithContext(ctx, builder.apiClient, builder.Definition.Namespace, listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var ownedPods []*pod.Builder
+
+	for _, podBuilder := range podBuilders {
+		if metav1.IsControlledBy(podBuilder.Object, builder.Object) {
+			ownedPods = append(ownedPods, podBuilder)
+		}
+	}
+
+	return ownedPods, nil
+}
+
+// PodOnNode returns the pod of the daemonset running on the given node.
+func (builder *Builder) PodOnNode(nodeName string) (*pod.Builder, error) {
+	return builder.PodOnNodeWithContext(context.TODO(), nodeName)
+}
+
+// PodOnNodeWithContext is the context-aware variant of PodOnNode.
+func (builder *Builder) PodOnNodeWithContext(ctx context.Context, nodeName string) (*pod.Builder, error) {
+	if nodeName == "" {
+		logger.V(logging.LevelTrace).Info("The nodeName is empty")
+
+		return nil, fmt.Errorf("'nodeName' cannot be empty")
+	}
+
+	podBuilders, err := builder.ListPodsWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, podBuilder := range podBuilders {
+		if podBuilder.Object.Spec.NodeName == nodeName {
+			return podBuilder, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no pod of daemonset %s in namespace %s found on node %s",
+		builder.Definition.Name, builder.Definition.Namespace, nodeName)
+}
+
+// GetGVR returns daemonset's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "daemonsets"}
+}
+
+func (builder *Builder) waitUntilRolledOut(ctx context.Context, sinceGeneration int64, timeout time.Duration) error {
+	return wait.PollUntilContextTimeout(
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			daemonSet, err := builder.apiClient.DaemonSets(builder.Definition.Namespace).Get(
+				ctx, builder.Definition.Name, metav1.GetOptions{})
+			if err != nil {
+				builder.log().V(logging.LevelTrace).Info("Failed to get daemonset", "error", err)
+
+				return false, nil
+			}
+
+			builder.Object = daemonSet
+
+			return isRolledOut(daemonSet, sinceGeneration), nil
+		})
+}
+
+// isRolledOut returns true when the daemonset generation is newer than sinceGeneration and observed, and its pods
+// are updated and available on every desired node, which must be at least one.
+func isRolledOut(daemonSet *appsv1.DaemonSet, sinceGeneration int64) bool {
+	status := daemonSet.Status
+
+	return daemonSet.Generation > sinceGeneration &&
+		status.ObservedGeneration >= daemonSet.Generation &&
+		status.DesiredNumberScheduled > 0 &&
+		status.UpdatedNumberScheduled == status.DesiredNumberScheduled &&
+		status.NumberAvailable == status.DesiredNumberScheduled
+}
+
+func (builder *Builder) describeStatus() string {
+	if builder.Object == nil {
+		return "no status observed"
+	}
+
+	status := builder.Object.Status
+
+	return fmt.Sprintf("generation %d, observed generation %d, desired %d, updated %d, available %d",
+		builder.Object.Generation, status.ObservedGeneration, status.DesiredNumberScheduled,
+		status.UpdatedNumberScheduled, status.NumberAvailable)
+}
+
+// log returns the logger with the daemonset kind, name and namespace fields.
+func (builder *Builder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", "DaemonSet")
+	}
+
+	return logging.WithResource(logger, "DaemonSet", builder.Definition.Name, builder.Definition.Namespace)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "DaemonSet"
+
+	if builder == nil {
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
+
+		return false, errors.New(msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		logger.V(logging.LevelTrace).Info("The builder apiclient is nil", "kind", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		logger.V(logging.LevelTrace).Info("The builder has error message", "kind", resourceCRD,
+			"error", builder.errorMsg)
+
+		return false, errors.New(builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,181 @@
+package daemonset
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	testDaemonSetName = "nvidia-device-plugin-daemonset"
+	testNamespace     = "nvidia-gpu-operator"
+)
+
+func testDaemonSet(status appsv1.DaemonSetStatus) *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testDaemonSetName,
+			Namespace:  testNamespace,
+			UID:        types.UID("device-plugin-uid"),
+			Generation: 2,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": testDaemonSetName}},
+		},
+		Status: status,
+	}
+}
+
+func testPod(name, nodeName string, owner *appsv1.DaemonSet) *corev1.Pod {
+	testPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+			Labels:    map[string]string{"app": testDaemonSetName},
+		},
+		Spec: corev1.PodSpec{NodeName: nodeName},
+	}
+
+	if owner != nil {
+		testPod.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "apps/v1",
+			Kind:       "DaemonSet",
+			Name:       owner.Name,
+			UID:        owner.UID,
+			Controller: ptr.To(true),
+		}}
+	}
+
+	return testPod
+}
+
+func TestPull(t *testing.T) {
+	apiClient := clients.NewFakeSettings(testDaemonSet(appsv1.DaemonSetStatus{}))
+
+	builder, err := Pull(apiClient, testDaemonSetName, testNamespace)
+	if err != nil {
+		t.Fatalf("unexpected error pulling daemonset: %v", err)
+	}
+
+	if builder.Generation() != 2 {
+		t.Errorf("expected generation 2, got %d", builder.Generation())
+	}
+
+	if _, err := Pull(apiClient, "missing", testNamespace); err == nil {
+		t.Error("expected error pulling missing daemonset")
+	}
+
+	if _, err := Pull(apiClient, "", testNamespace); err == nil {
+		t.Error("expected error pulling daemonset with empty name")
+	}
+}
+
+func TestIsRolledOut(t *testing.T) {
+	testCases := []struct {
+		name            string
+		status          appsv1.DaemonSetStatus
+		sinceGeneration int64
+		expected        bool
+	}{
+		{
+			name: "rolled out",
+			status: appsv1.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 3,
+				UpdatedNumberScheduled: 3, NumberAvailable: 3},
+			expected: true,
+		},
+		{
+			name: "generation not observed",
+			status: appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 3,
+				UpdatedNumberScheduled: 3, NumberAvailable: 3},
+		},
+		{
+			name: "pods not updated",
+			status: appsv1.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 3,
+				UpdatedNumberScheduled: 2, NumberAvailable: 3},
+		},
+		{
+			name: "pods not available",
+			status: appsv1.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 3,
+				UpdatedNumberScheduled: 3, NumberAvailable: 2},
+		},
+		{
+			name:   "no desired node",
+			status: appsv1.DaemonSetStatus{ObservedGeneration: 2},
+		},
+		{
+			name: "generation not newer",
+			status: appsv1.DaemonSetStatus{ObservedGeneration: 2, DesiredNumberScheduled: 3,
+				UpdatedNumberScheduled: 3, NumberAvailable: 3},
+			sinceGeneration: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			actual := isRolledOut(testDaemonSet(testCase.status), testCase.sinceGeneration)
+			if actual != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWaitUntilRolledOutTimeout(t *testing.T) {
+	apiClient := clients.NewFakeSettings(testDaemonSet(appsv1.DaemonSetStatus{
+		ObservedGeneration: 2, DesiredNumberScheduled: 3, UpdatedNumberScheduled: 3, NumberAvailable: 3}))
+
+	builder, err := Pull(apiClient, testDaemonSetName, testNamespace)
+	if err != nil {
+		t.Fatalf("unexpected error pulling daemonset: %v", err)
+	}
+
+	if !builder.IsReadyWithContext(context.TODO(), time.Second) {
+		t.Error("expected daemonset to be ready")
+	}
+
+	if err := builder.WaitUntilRolledOut(builder.Generation(), 100*time.Millisecond); err == nil {
+		t.Error("expected timeout waiting for a newer generation")
+	}
+}
+
+func TestPodOnNode(t *testing.T) {
+	daemonSet := testDaemonSet(appsv1.DaemonSetStatus{})
+	apiClient := clients.NewFakeSettings(daemonSet,
+		testPod("device-plugin-a", "worker-0", daemonSet),
+		testPod("device-plugin-b", "worker-1", daemonSet),
+		testPod("orphan", "worker-2", nil))
+
+	builder, err := Pull(apiClient, testDaemonSetName, testNamespace)
+	if err != nil {
+		t.Fatalf("unexpected error pulling daemonset: %v", err)
+	}
+
+	pods, err := builder.ListPods()
+	if err != nil {
+		t.Fatalf("unexpected error listing pods: %v", err)
+	}
+
+	if len(pods) != 2 {
+		t.Errorf("expected 2 pods owned by the daemonset, got %d", len(pods))
+	}
+
+	podBuilder, err := builder.PodOnNode("worker-1")
+	if err != nil {
+		t.Fatalf("unexpected error getting pod on node: %v", err)
+	}
+
+	if podBuilder.Object.Name != "device-plugin-b" {
+		t.Errorf("expected pod device-plugin-b, got %s", podBuilder.Object.Name)
+	}
+
+	if _, err := builder.PodOnNode("worker-2"); err == nil {
+		t.Error("expected error getting pod not owned by the daemonset")
+	}
+}
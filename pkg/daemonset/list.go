@@ -0,0 +1,69 @@
+package daemonset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// List returns daemonset inventory in the given namespace.
+func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.TODO(), apiClient, nsname, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func ListWithContext(
+	ctx context.Context, apiClient *clients.Settings, nsname string,
+	options ...metav1.ListOptions) ([]*Builder, error) {
+	if apiClient == nil {
+		logger.V(logging.LevelTrace).Info("The apiClient is nil")
+
+		return nil, fmt.Errorf("failed to list daemonsets, 'apiClient' parameter is nil")
+	}
+
+	if nsname == "" {
+		logger.V(logging.LevelTrace).Info("daemonset 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list daemonsets, 'nsname' parameter is empty")
+	}
+
+	passedOptions := metav1.ListOptions{}
+
+	if len(options) > 1 {
+		logger.V(logging.LevelTrace).Info("'options' parameter must be empty or single-valued")
+
+		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+	}
+
+	if len(options) == 1 {
+		passedOptions = options[0]
+	}
+
+	logger.V(logging.LevelTrace).Info("Listing daemonsets", "namespace", nsname, "options", passedOptions)
+
+	daemonSetList, err := apiClient.DaemonSets(nsname).List(ctx, passedOptions)
+
+	if err != nil {
+		logger.V(logging.LevelTrace).Info("Failed to list daemonsets", "namespace", nsname, "error", err)
+
+		return nil, err
+	}
+
+	var daemonSetObjects []*Builder
+
+	for _, runningDaemonSet := range daemonSetList.Items {
+		copiedDaemonSet := runningDaemonSet
+		daemonSetBuilder := &Builder{
+			apiClient:  apiClient,
+			Object:     &copiedDaemonSet,
+			Definition: &copiedDaemonSet,
+		}
+
+		daemonSetObjects = append(daemonSetObjects, daemonSetBuilder)
+	}
+
+	return daemonSetObjects, nil
+}
@@ -31,6 +31,8 @@ type Builder struct {
 	// object is created.
 	errorMsg  string
 	apiClient appsv1Typed.AppsV1Interface
+	// dryRunRecorder records the mutations of the builder instead of applying them when set.
+	dryRunRecorder *clients.DryRunRecorder
 }
 
 // AdditionalOptions additional options for deployment object.
@@ -45,7 +47,8 @@ func NewBuilder(
 		name, nsname, labels, containerSpec)
 
 	builder := Builder{
-		apiClient: apiClient.AppsV1Interface,
+		apiClient:      apiClient.AppsV1Interface,
+		dryRunRecorder: apiClient.DryRunRecorder,
 		Definition: &appsv1.Deployment{
 			Spec: appsv1.DeploymentSpec{
 				Selector: &metav1.LabelSelector{
@@ -89,6 +92,11 @@ func NewBuilder(
 
 // Pull loads an existing deployment into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	return PullWithContext(context.TODO(), apiClient, name, nsname)
+}
+
+// PullWithContext is the context-aware variant of Pull.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	// Safeguard against nil apiClient interfaces.
 	if apiClient == nil {
 		glog.V(100).Infof("The apiClient is nil")
@@ -99,7 +107,8 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	glog.V(100).Infof("Pulling existing deployment name: %s under namespace: %s", name, nsname)
 
 	builder := Builder{
-		apiClient: apiClient.AppsV1Interface,
+		apiClient:      apiClient.AppsV1Interface,
+		dryRunRecorder: apiClient.DryRunRecorder,
 		Definition: &appsv1.Deployment{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
@@ -120,7 +129,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 		return nil, fmt.Errorf("deployment 'namespace' cannot be empty")
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("deployment object %s doesn't exist in namespace %s", name, nsname)
 	}
 
@@ -383,16 +392,27 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 // Create generates a deployment in cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	glog.V(100).Infof("Creating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
+	if builder.dryRunRecorder != nil {
+		builder.Object = builder.Definition
+
+		return builder, builder.dryRunRecorder.Record(clients.DryRunCreate, builder.Definition)
+	}
+
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
 	}
 
 	return builder, err
@@ -400,21 +420,37 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Update renovates the existing deployment object with the deployment definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.TODO())
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	glog.V(100).Infof("Updating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
+	if builder.dryRunRecorder != nil {
+		builder.Object = builder.Definition
+
+		return builder, builder.dryRunRecorder.Record(clients.DryRunUpdate, builder.Definition)
+	}
+
 	var err error
 	builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		ctx, builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
 
 // Delete removes a deployment.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -422,14 +458,18 @@ func (builder *Builder) Delete() error {
 	glog.V(100).Infof("Deleting deployment %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if builder.dryRunRecorder != nil {
+		return builder.dryRunRecorder.Record(clients.DryRunDelete, builder.Definition)
+	}
+
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object = nil
 
 		return nil
 	}
 
 	err := builder.apiClient.Deployments(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		ctx, builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -442,6 +482,12 @@ func (builder *Builder) Delete() error {
 
 // CreateAndWaitUntilReady creates a deployment in the cluster and waits until the deployment is available.
 func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder, error) {
+	return builder.CreateAndWaitUntilReadyWithContext(context.TODO(), timeout)
+}
+
+// CreateAndWaitUntilReadyWithContext is the context-aware variant of CreateAndWaitUntilReady.
+func (builder *Builder) CreateAndWaitUntilReadyWithContext(
+	ctx context.Context, timeout time.Duration) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -449,11 +495,11 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 	glog.V(100).Infof("Creating deployment %s in namespace %s and waiting for the defined period until it's ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if _, err := builder.Create(); err != nil {
+	if _, err := builder.CreateWithContext(ctx); err != nil {
 		return nil, err
 	}
 
-	if builder.IsReady(timeout) {
+	if builder.IsReadyWithContext(ctx, timeout) {
 		return builder, nil
 	}
 
@@ -464,6 +510,11 @@ func (builder *Builder) CreateAndWaitUntilReady(timeout time.Duration) (*Builder
 
 // IsReady periodically checks if deployment is in ready status.
 func (builder *Builder) IsReady(timeout time.Duration) bool {
+	return builder.IsReadyWithContext(context.TODO(), timeout)
+}
+
+// IsReadyWithContext is the context-aware variant of IsReady.
+func (builder *Builder) IsReadyWithContext(ctx context.Context, timeout time.Duration) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -471,15 +522,15 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 	glog.V(100).Infof("Running periodic check until deployment %s in namespace %s is ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return false
 	}
 
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			var err error
 			builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 
 			if err != nil {
 				return false, err
@@ -497,6 +548,11 @@ func (builder *Builder) IsReady(timeout time.Duration) bool {
 
 // DeleteAndWait deletes a deployment and waits until it is removed from the cluster.
 func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+	return builder.DeleteAndWaitWithContext(context.TODO(), timeout)
+}
+
+// DeleteAndWaitWithContext is the context-aware variant of DeleteAndWait.
+func (builder *Builder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -504,15 +560,15 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 	glog.V(100).Infof("Deleting deployment %s in namespace %s and waiting for the defined period until it's removed",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if err := builder.Delete(); err != nil {
+	if err := builder.DeleteWithContext(ctx); err != nil {
 		return err
 	}
 
 	// Polls the deployment every second until it's removed.
 	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			_, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 			if k8serrors.IsNotFound(err) {
 				return true, nil
 			}
@@ -523,6 +579,11 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 
 // Exists checks whether the given deployment exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -532,7 +593,7 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -540,6 +601,12 @@ func (builder *Builder) Exists() bool {
 // WaitUntilCondition waits for the duration of the defined timeout or until the
 // deployment gets to a specific condition.
 func (builder *Builder) WaitUntilCondition(condition appsv1.DeploymentConditionType, timeout time.Duration) error {
+	return builder.WaitUntilConditionWithContext(context.TODO(), condition, timeout)
+}
+
+// WaitUntilConditionWithContext is the context-aware variant of WaitUntilCondition.
+func (builder *Builder) WaitUntilConditionWithContext(
+	ctx context.Context, condition appsv1.DeploymentConditionType, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -547,14 +614,14 @@ func (builder *Builder) WaitUntilCondition(condition appsv1.DeploymentConditionT
 	glog.V(100).Infof("Waiting for the defined period until deployment %s in namespace %s has condition %v",
 		builder.Definition.Name, builder.Definition.Namespace, condition)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return fmt.Errorf("cannot wait for deployment condition because it does not exist")
 	}
 
 	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			updateDeployment, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 			if err != nil {
 				return false, nil
 			}
@@ -11,6 +11,13 @@ import (
 
 // List returns deployment inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.TODO(), apiClient, nsname, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func ListWithContext(
+	ctx context.Context, apiClient *clients.Settings, nsname string,
+	options ...metav1.ListOptions) ([]*Builder, error) {
 	if nsname == "" {
 		glog.V(100).Infof("deployment 'nsname' parameter can not be empty")
 
@@ -33,7 +40,7 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 	glog.V(100).Infof(logMessage)
 
-	deploymentList, err := apiClient.Deployments(nsname).List(context.TODO(), passedOptions)
+	deploymentList, err := apiClient.Deployments(nsname).List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list deployments in the namespace %s due to %s", nsname, err.Error())
@@ -46,9 +53,10 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 	for _, runningDeployment := range deploymentList.Items {
 		copiedDeployment := runningDeployment
 		deploymentBuilder := &Builder{
-			apiClient:  apiClient.AppsV1Interface,
-			Object:     &copiedDeployment,
-			Definition: &copiedDeployment,
+			apiClient:      apiClient.AppsV1Interface,
+			dryRunRecorder: apiClient.DryRunRecorder,
+			Object:         &copiedDeployment,
+			Definition:     &copiedDeployment,
 		}
 
 		deploymentObjects = append(deploymentObjects, deploymentBuilder)
@@ -59,6 +67,12 @@ func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOpti
 
 // ListInAllNamespaces returns deployment inventory in the all the namespaces.
 func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
+	return ListInAllNamespacesWithContext(context.TODO(), apiClient, options...)
+}
+
+// ListInAllNamespacesWithContext is the context-aware variant of ListInAllNamespaces.
+func ListInAllNamespacesWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
 	passedOptions := metav1.ListOptions{}
 	logMessage := "Listing deployments in all namespaces"
 
@@ -75,7 +89,7 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOpti
 
 	glog.V(100).Infof(logMessage)
 
-	deploymentList, err := apiClient.Deployments("").List(context.TODO(), passedOptions)
+	deploymentList, err := apiClient.Deployments("").List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list deployments in all namespaces due to %s", err.Error())
@@ -88,9 +102,10 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOpti
 	for _, runningDeployment := range deploymentList.Items {
 		copiedDeployment := runningDeployment
 		deploymentBuilder := &Builder{
-			apiClient:  apiClient.AppsV1Interface,
-			Object:     &copiedDeployment,
-			Definition: &copiedDeployment,
+			apiClient:      apiClient.AppsV1Interface,
+			dryRunRecorder: apiClient.DryRunRecorder,
+			Object:         &copiedDeployment,
+			Definition:     &copiedDeployment,
 		}
 
 		deploymentObjects = append(deploymentObjects, deploymentBuilder)
@@ -5,4 +5,6 @@ const (
 	UndefinedValue       = "undefined"
 	OperatorVersionFile  = "operator.version"
 	OpenShiftVersionFile = "ocp.version"
+	// DryRunPlanFileFormat is the name format of the dry-run plan of a suite, one plan per suite process.
+	DryRunPlanFileFormat = "dry-run-plan-%s.yaml"
 )
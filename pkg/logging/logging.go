@@ -0,0 +1,253 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	"github.com/golang/glog"
+)
+
+// Subsystem is the name of a logging subsystem whose verbosity can be configured independently.
+type Subsystem string
+
+const (
+	// OLM is the subsystem of the OLM builders and helpers.
+	OLM Subsystem = "olm"
+	// NFD is the subsystem of the Node Feature Discovery builders and helpers.
+	NFD Subsystem = "nfd"
+	// GPU is the subsystem of the GPU operator builders and helpers.
+	GPU Subsystem = "gpu"
+	// Network is the subsystem of the Network operator builders and helpers.
+	Network Subsystem = "network"
+	// Machine is the subsystem of the MachineSet builders.
+	Machine Subsystem = "machine"
+	// Reporter is the subsystem of the failed test reporter.
+	Reporter Subsystem = "reporter"
+)
+
+const (
+	// LevelInfo is the verbosity of messages which are always logged.
+	LevelInfo = 0
+	// LevelDebug is the verbosity of the test flow messages, formerly logged at glog.V(90).
+	LevelDebug = 90
+	// LevelTrace is the verbosity of the builder internals messages, formerly logged at glog.V(100).
+	LevelTrace = 100
+)
+
+var (
+	// Subsystems lists all known logging subsystems.
+	Subsystems = []Subsystem{OLM, NFD, GPU, Network, Machine, Reporter}
+
+	verbosityMutex   sync.RWMutex
+	defaultVerbosity = LevelInfo
+	verbosity        = map[Subsystem]int{}
+
+	specLogMutex sync.Mutex
+	specLogFile  *os.File
+)
+
+// Logger returns the logger of the given subsystem. Messages are written to the console through glog and,
+// while a spec log is open, as JSON lines into the spec log file.
+func Logger(subsystem Subsystem) logr.Logger {
+	return logr.New(newSink(subsystem))
+}
+
+// WithResource returns a logger adding the kind, name and namespace of a resource to every message.
+func WithResource(logger logr.Logger, kind, name, namespace string) logr.Logger {
+	keysAndValues := []interface{}{"kind", kind, "name", name}
+
+	if namespace != "" {
+		keysAndValues = append(keysAndValues, "namespace", namespace)
+	}
+
+	return logger.WithValues(keysAndValues...)
+}
+
+// Configure sets the verbosity of every subsystem to defaultLevel, then overrides it with the
+// per-subsystem levels. An error is returned when levels contains an unknown subsystem.
+func Configure(defaultLevel int, levels map[string]int) error {
+	newVerbosity := map[Subsystem]int{}
+
+	for name, level := range levels {
+		subsystem := Subsystem(strings.ToLower(strings.TrimSpace(name)))
+
+		if !isKnown(subsystem) {
+			return fmt.Errorf("unknown logging subsystem '%s', must be one of %v", name, Subsystems)
+		}
+
+		newVerbosity[subsystem] = level
+	}
+
+	verbosityMutex.Lock()
+	defer verbosityMutex.Unlock()
+
+	defaultVerbosity = defaultLevel
+	verbosity = newVerbosity
+
+	return nil
+}
+
+// Verbosity returns the verbosity configured for the given subsystem.
+func Verbosity(subsystem Subsystem) int {
+	verbosityMutex.RLock()
+	defer verbosityMutex.RUnlock()
+
+	if level, ok := verbosity[subsystem]; ok {
+		return level
+	}
+
+	return defaultVerbosity
+}
+
+// StartSpecLog opens the given file and writes the messages of all subsystems into it as JSON lines
+// until StopSpecLog is called. A spec log already open is closed first.
+func StartSpecLog(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create spec log file %s: %w", path, err)
+	}
+
+	specLogMutex.Lock()
+	defer specLogMutex.Unlock()
+
+	if specLogFile != nil {
+		_ = specLogFile.Close()
+	}
+
+	specLogFile = file
+
+	return nil
+}
+
+// StopSpecLog closes the spec log opened by StartSpecLog. Nothing is done when no spec log is open.
+func StopSpecLog() error {
+	specLogMutex.Lock()
+	defer specLogMutex.Unlock()
+
+	if specLogFile == nil {
+		return nil
+	}
+
+	err := specLogFile.Close()
+	specLogFile = nil
+
+	return err
+}
+
+// SpecLogFileName returns a file system friendly name of the JSON log file of the given spec.
+func SpecLogFileName(specText string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, strings.TrimSpace(specText))
+
+	return fmt.Sprintf("spec-log-%s.json", name)
+}
+
+func isKnown(subsystem Subsystem) bool {
+	for _, known := range Subsystems {
+		if known == subsystem {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeSpecLog(line string) {
+	specLogMutex.Lock()
+	defer specLogMutex.Unlock()
+
+	if specLogFile == nil {
+		return
+	}
+
+	_, _ = fmt.Fprintln(specLogFile, line)
+}
+
+// sink is a logr.LogSink writing text messages to glog and JSON messages to the spec log file.
+type sink struct {
+	subsystem Subsystem
+	console   funcr.Formatter
+	json      funcr.Formatter
+}
+
+var _ logr.LogSink = &sink{}
+var _ logr.CallDepthLogSink = &sink{}
+
+func newSink(subsystem Subsystem) *sink {
+	newSink := &sink{
+		subsystem: subsystem,
+		console:   funcr.NewFormatter(funcr.Options{}),
+		json:      funcr.NewFormatterJSON(funcr.Options{LogTimestamp: true, LogCaller: funcr.All}),
+	}
+
+	newSink.console.AddName(string(subsystem))
+	newSink.json.AddName(string(subsystem))
+
+	return newSink
+}
+
+// Init receives the runtime info of the logr.Logger.
+func (logSink *sink) Init(info logr.RuntimeInfo) {
+	logSink.console.Init(info)
+	logSink.json.Init(info)
+}
+
+// Enabled returns true when the level is lower or equal to the subsystem verbosity.
+func (logSink *sink) Enabled(level int) bool {
+	return level <= Verbosity(logSink.subsystem)
+}
+
+// Info logs a non-error message.
+func (logSink *sink) Info(level int, msg string, keysAndValues ...interface{}) {
+	prefix, args := logSink.console.FormatInfo(level, msg, keysAndValues)
+	glog.InfoDepth(logSink.console.GetDepth()+1, prefix+": "+args)
+
+	_, jsonLine := logSink.json.FormatInfo(level, msg, keysAndValues)
+	writeSpecLog(jsonLine)
+}
+
+// Error logs an error message.
+func (logSink *sink) Error(err error, msg string, keysAndValues ...interface{}) {
+	prefix, args := logSink.console.FormatError(err, msg, keysAndValues)
+	glog.ErrorDepth(logSink.console.GetDepth()+1, prefix+": "+args)
+
+	_, jsonLine := logSink.json.FormatError(err, msg, keysAndValues)
+	writeSpecLog(jsonLine)
+}
+
+// WithValues returns a copy of the sink with additional key/value pairs.
+func (logSink *sink) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	newLogSink := *logSink
+	newLogSink.console.AddValues(keysAndValues)
+	newLogSink.json.AddValues(keysAndValues)
+
+	return &newLogSink
+}
+
+// WithName returns a copy of the sink with the name appended.
+func (logSink *sink) WithName(name string) logr.LogSink {
+	newLogSink := *logSink
+	newLogSink.console.AddName(name)
+	newLogSink.json.AddName(name)
+
+	return &newLogSink
+}
+
+// WithCallDepth returns a copy of the sink reporting callers further up the stack.
+func (logSink *sink) WithCallDepth(depth int) logr.LogSink {
+	newLogSink := *logSink
+	newLogSink.console.AddCallDepth(depth)
+	newLogSink.json.AddCallDepth(depth)
+
+	return &newLogSink
+}
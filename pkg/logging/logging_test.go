@@ -0,0 +1,84 @@
+package logging
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConfigure(t *testing.T) {
+	defer func() {
+		_ = Configure(LevelInfo, nil)
+	}()
+
+	if err := Configure(LevelDebug, map[string]int{" GPU ": LevelTrace, "olm": LevelInfo}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for subsystem, expected := range map[Subsystem]int{GPU: LevelTrace, OLM: LevelInfo, NFD: LevelDebug} {
+		if level := Verbosity(subsystem); level != expected {
+			t.Errorf("expected verbosity %d for subsystem %s, got %d", expected, subsystem, level)
+		}
+	}
+
+	if err := Configure(LevelDebug, map[string]int{"unknown": LevelTrace}); err == nil {
+		t.Error("expected error for unknown subsystem")
+	}
+}
+
+func TestSpecLog(t *testing.T) {
+	defer func() {
+		_ = Configure(LevelInfo, nil)
+	}()
+
+	if err := Configure(LevelInfo, map[string]int{"nfd": LevelDebug}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	specLogPath := filepath.Join(t.TempDir(), SpecLogFileName("Deploy NFD: operator"))
+	if filepath.Base(specLogPath) != "spec-log-Deploy_NFD__operator.json" {
+		t.Errorf("unexpected spec log file name %s", filepath.Base(specLogPath))
+	}
+
+	if err := StartSpecLog(specLogPath); err != nil {
+		t.Fatalf("unexpected error starting spec log: %v", err)
+	}
+
+	resourceLogger := WithResource(Logger(NFD), "NodeFeatureDiscovery", "nfd-instance", "openshift-nfd")
+	resourceLogger.V(LevelDebug).Info("Creating the NodeFeatureDiscovery")
+	resourceLogger.V(LevelTrace).Info("Not logged above the subsystem verbosity")
+	Logger(GPU).V(LevelDebug).Info("Not logged above the default verbosity")
+
+	if err := StopSpecLog(); err != nil {
+		t.Fatalf("unexpected error stopping spec log: %v", err)
+	}
+
+	content, err := os.ReadFile(specLogPath)
+	if err != nil {
+		t.Fatalf("unexpected error reading spec log: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 spec log line, got %d: %q", len(lines), content)
+	}
+
+	var message map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[0]), &message); err != nil {
+		t.Fatalf("unexpected error parsing spec log line: %v", err)
+	}
+
+	for key, expected := range map[string]interface{}{
+		"logger":    "nfd",
+		"msg":       "Creating the NodeFeatureDiscovery",
+		"kind":      "NodeFeatureDiscovery",
+		"name":      "nfd-instance",
+		"namespace": "openshift-nfd",
+	} {
+		if message[key] != expected {
+			t.Errorf("expected %s to be %v, got %v", key, expected, message[key])
+		}
+	}
+}
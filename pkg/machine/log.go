@@ -0,0 +1,5 @@
+package machine
+
+import "github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+
+var logger = logging.Logger(logging.Machine)
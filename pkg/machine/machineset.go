@@ -9,9 +9,10 @@ import (
 	"strings"
 	"time"
 
-	"github.com/golang/glog"
+	"github.com/go-logr/logr"
 	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -49,9 +50,8 @@ func NewSetBuilderFromCopy(
 	instanceType string,
 	workerLabel string,
 	replicas int32) *SetBuilder {
-	glog.V(100).Infof("Initializing new SetBuilder structure from copied MachineSet with the following"+
-		" params: namespace: %s, instanceType: %s, workerLabel: %s, and replicas: %v", nsName, instanceType,
-		workerLabel, replicas)
+	logger.V(logging.LevelTrace).Info("Initializing new SetBuilder structure from copied MachineSet",
+		"namespace", nsName, "instanceType", instanceType, "workerLabel", workerLabel, "replicas", replicas)
 
 	builder := SetBuilder{
 		apiClient: apiClient,
@@ -60,7 +60,7 @@ func NewSetBuilderFromCopy(
 	newSetBuilder, err := createNewWorkerMachineSetFromCopy(apiClient, nsName, instanceType, workerLabel, replicas)
 
 	if err != nil {
-		glog.V(100).Infof("Error initializing MachineSet from copy: %s", err.Error())
+		logger.V(logging.LevelTrace).Info("Error initializing MachineSet from copy", "error", err)
 
 		builder.errorMsg = fmt.Sprintf("Error initializing MachineSet from copy: %s", err.Error())
 
@@ -75,7 +75,7 @@ func NewSetBuilderFromCopy(
 		builder.errorMsg = fmt.Sprintf("error getting the public cloud kind: %v", err.Error())
 	}
 
-	glog.V(100).Infof("Updating copied MachineSet provider instanceType to: %s", instanceType)
+	logger.V(logging.LevelTrace).Info("Updating copied MachineSet provider instanceType", "instanceType", instanceType)
 
 	err = builder.ChangeCloudProviderInstanceType(instanceType)
 
@@ -84,31 +84,31 @@ func NewSetBuilderFromCopy(
 	}
 
 	if nsName == "" {
-		glog.V(100).Infof("The Namespace of the MachineSet is empty")
+		logger.V(logging.LevelTrace).Info("The Namespace of the MachineSet is empty")
 
 		builder.errorMsg = "MachineSet 'nsName' cannot be empty"
 	}
 
 	if instanceType == "" {
-		glog.V(100).Infof("The instanceType of the MachineSet is empty")
+		logger.V(logging.LevelTrace).Info("The instanceType of the MachineSet is empty")
 
 		builder.errorMsg = "MachineSet 'instanceType' cannot be empty"
 	}
 
 	if replicas == 0 {
-		glog.V(100).Infof("The replicas of the MachineSet is zero")
+		logger.V(logging.LevelTrace).Info("The replicas of the MachineSet is zero")
 
 		builder.errorMsg = "MachineSet 'replicas' cannot be zero"
 	}
 
 	if workerLabel == "" {
-		glog.V(100).Infof("The workerLabel of the MachineSet is empty")
+		logger.V(logging.LevelTrace).Info("The workerLabel of the MachineSet is empty")
 
 		builder.errorMsg = "MachineSet 'workerLabel' cannot be empty"
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The MachineSet object definition is nil")
+		logger.V(logging.LevelTrace).Info("The MachineSet object definition is nil")
 
 		builder.errorMsg = "MachineSet 'Object.Definition' is nil"
 	}
@@ -118,7 +118,12 @@ func NewSetBuilderFromCopy(
 
 // PullSet loads an existing MachineSet into Builder struct.
 func PullSet(apiClient *clients.Settings, name, namespace string) (*SetBuilder, error) {
-	glog.V(100).Infof("Pulling existing machineSet name %s in namespace %s", name, namespace)
+	return PullSetWithContext(context.TODO(), apiClient, name, namespace)
+}
+
+// PullSetWithContext is the context-aware variant of PullSet.
+func PullSetWithContext(ctx context.Context, apiClient *clients.Settings, name, namespace string) (*SetBuilder, error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing machineSet", "name", name, "namespace", namespace)
 
 	builder := SetBuilder{
 		apiClient: apiClient,
@@ -138,7 +143,7 @@ func PullSet(apiClient *clients.Settings, name, namespace string) (*SetBuilder,
 		builder.errorMsg = "MachineSet 'namespace' cannot be empty"
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("machineSet object %s doesn't exist in namespace %s", name, namespace)
 	}
 
@@ -149,20 +154,23 @@ func PullSet(apiClient *clients.Settings, name, namespace string) (*SetBuilder,
 
 // Exists checks whether the given MachineSet exists.
 func (builder *SetBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *SetBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof(
-		"Checking if MachineSet %s exists in namespace %s", builder.Definition.Name,
-		builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Checking if MachineSet exists")
 
 	var err error
-	builder.Object, err = builder.apiClient.MachineSets(builder.Definition.Namespace).Get(context.TODO(),
+	builder.Object, err = builder.apiClient.MachineSets(builder.Definition.Namespace).Get(ctx,
 		builder.Definition.Name, metav1.GetOptions{})
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect MachineSet object due to %s", err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to collect MachineSet object", "error", err)
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -170,16 +178,27 @@ func (builder *SetBuilder) Exists() bool {
 
 // Create makes a MachineSet in cluster and stores the created object in struct.
 func (builder *SetBuilder) Create() (*SetBuilder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *SetBuilder) CreateWithContext(ctx context.Context) (*SetBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the MachineSet %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Creating the MachineSet")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunCreate, builder.Definition)
+	}
 
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.MachineSets(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
 	}
 
 	return builder, err
@@ -187,19 +206,27 @@ func (builder *SetBuilder) Create() (*SetBuilder, error) {
 
 // Delete removes a MachineSet object from a cluster.
 func (builder *SetBuilder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *SetBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting the MachineSet object %s",
-		builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Deleting the MachineSet object")
 
-	if !builder.Exists() {
+	if builder.apiClient.IsDryRun() {
+		return builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
+
+	if !builder.ExistsWithContext(ctx) {
 		return fmt.Errorf("machineSet cannot be deleted because it does not exist")
 	}
 
 	err := builder.apiClient.MachineSets(builder.Object.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		ctx, builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return fmt.Errorf("cannot delete MachineSet: %w", err)
@@ -210,31 +237,41 @@ func (builder *SetBuilder) Delete() error {
 
 // WaitForMachineSetReady waits until MachineSet first replica is Ready.
 func WaitForMachineSetReady(
+	apiClient *clients.Settings,
+	namespace,
+	machineSetName string,
+	timeout time.Duration) error {
+	return WaitForMachineSetReadyWithContext(context.TODO(), apiClient, namespace, machineSetName, timeout)
+}
+
+// WaitForMachineSetReadyWithContext is the context-aware variant of WaitForMachineSetReady.
+func WaitForMachineSetReadyWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	namespace,
 	machineSetName string,
 	timeout time.Duration) error {
 	return wait.PollUntilContextTimeout(
-		context.TODO(), 30*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			machineSetPulled, err := PullSet(apiClient, namespace, machineSetName)
+		ctx, 30*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			machineSetPulled, err := PullSetWithContext(ctx, apiClient, namespace, machineSetName)
 
 			if err != nil {
-				glog.V(100).Infof("MachineSet pull from cluster error: %v\n", err)
+				logger.V(logging.LevelTrace).Info("MachineSet pull from cluster error", "error", err)
 
 				return false, err
 			}
 
 			if machineSetPulled.Object.Status.ReadyReplicas > 0 &&
 				machineSetPulled.Object.Status.Replicas == machineSetPulled.Object.Status.ReadyReplicas {
-				glog.V(100).Infof("MachineSet %s has %v replicas in Ready state",
-					machineSetPulled.Object.Name, machineSetPulled.Object.Status.ReadyReplicas)
+				logger.V(logging.LevelTrace).Info("MachineSet has replicas in Ready state",
+					"name", machineSetPulled.Object.Name, "readyReplicas", machineSetPulled.Object.Status.ReadyReplicas)
 
 				// this exits out of the wait.PollUntilContextTimeout()
 				return true, nil
 			}
 
-			glog.V(100).Infof("MachineSet %s has %v replicas in Ready state",
-				machineSetPulled.Object.Name, machineSetPulled.Object.Status.ReadyReplicas)
+			logger.V(logging.LevelTrace).Info("MachineSet has replicas in Ready state",
+				"name", machineSetPulled.Object.Name, "readyReplicas", machineSetPulled.Object.Status.ReadyReplicas)
 
 			return false, err
 		})
@@ -246,11 +283,11 @@ func (builder *SetBuilder) ChangeCloudProviderInstanceType(instanceType string)
 		return err
 	}
 
-	glog.V(100).Infof("Updating the cloud provider instance type field")
+	logger.V(logging.LevelTrace).Info("Updating the cloud provider instance type field")
 
 	switch builder.publicCloud {
 	case AwsCloud:
-		glog.V(100).Infof("Updating ProviderSpec InstanceType param for AWS public cloud")
+		logger.V(logging.LevelTrace).Info("Updating ProviderSpec InstanceType param for AWS public cloud")
 
 		err := builder.AWSChangeProviderInstanceType(instanceType)
 
@@ -259,7 +296,7 @@ func (builder *SetBuilder) ChangeCloudProviderInstanceType(instanceType string)
 		}
 
 	case GcpCloud:
-		glog.V(100).Infof("Updating ProviderSpec MachineType and OnHostTerminate params for " +
+		logger.V(logging.LevelTrace).Info("Updating ProviderSpec MachineType and OnHostTerminate params for " +
 			"GCP public cloud")
 
 		err := builder.GCPChangeProviderMachineType(instanceType)
@@ -269,7 +306,7 @@ func (builder *SetBuilder) ChangeCloudProviderInstanceType(instanceType string)
 		}
 
 	case AzureCloud:
-		glog.V(100).Infof("Updating ProviderSpec VMSize param for Azure public cloud")
+		logger.V(logging.LevelTrace).Info("Updating ProviderSpec VMSize param for Azure public cloud")
 
 		err := builder.AzureChangeProviderVMSize(instanceType)
 
@@ -278,7 +315,8 @@ func (builder *SetBuilder) ChangeCloudProviderInstanceType(instanceType string)
 		}
 
 	default:
-		glog.V(100).Infof("Public cloud '%s' is not supported, must be 'aws', 'gcp' or azure'")
+		logger.V(logging.LevelTrace).Info("Public cloud is not supported, must be 'aws', 'gcp' or azure'",
+			"publicCloud", builder.publicCloud)
 
 		return fmt.Errorf("could not find supported public cloud")
 	}
@@ -302,26 +340,29 @@ func (builder *SetBuilder) AWSChangeProviderInstanceType(instanceType string) er
 		return fmt.Errorf("error marshalling machineSet providerSpec.Value into byte array: %w", err)
 	}
 
-	glog.V(100).Infof("Updating ProviderSpec InstanceType param '%s' for AWS public cloud",
-		instanceType)
+	logger.V(logging.LevelTrace).Info("Updating ProviderSpec InstanceType param for AWS public cloud",
+		"instanceType", instanceType)
 
 	var AWSProviderSpecObject *machinev1beta1.AWSMachineProviderConfig
 	err = json.Unmarshal(byteArray, &AWSProviderSpecObject)
 
 	if err != nil {
-		glog.V(100).Infof("error unmarshalling byte array into AWSMachineProviderConfig object: %v", err)
+		logger.V(logging.LevelTrace).Info("Error unmarshalling byte array into AWSMachineProviderConfig object",
+			"error", err)
 
 		return fmt.Errorf("could not update InstanceType param: %w", err)
 	}
 
-	glog.V(100).Infof("Setting AWSMachineProviderConfig.InstanceType param value to: %s", instanceType)
+	logger.V(logging.LevelTrace).Info("Setting AWSMachineProviderConfig.InstanceType param value",
+		"instanceType", instanceType)
 
 	AWSProviderSpecObject.InstanceType = instanceType
 
 	byteArrayAWS, err := json.Marshal(AWSProviderSpecObject)
 
 	if err != nil {
-		glog.V(100).Infof("error marshalling AWSMachineProviderConfig object into byte array: %v", err)
+		logger.V(logging.LevelTrace).Info("Error marshalling AWSMachineProviderConfig object into byte array",
+			"error", err)
 
 		return fmt.Errorf("could not update InstanceType param: %w", err)
 	}
@@ -329,8 +370,8 @@ func (builder *SetBuilder) AWSChangeProviderInstanceType(instanceType string) er
 	err = json.Unmarshal(byteArrayAWS, builder.Definition.Spec.Template.Spec.ProviderSpec.Value)
 
 	if err != nil {
-		glog.V(100).Infof("error unmarshalling AWSMachineProviderConfig byte array into "+
-			"ProviderSpec.Value object: %v", err)
+		logger.V(logging.LevelTrace).Info("Error unmarshalling AWSMachineProviderConfig byte array into "+
+			"ProviderSpec.Value object", "error", err)
 
 		return fmt.Errorf("could not update InstanceType param: %w", err)
 	}
@@ -354,25 +395,29 @@ func (builder *SetBuilder) GCPChangeProviderMachineType(machineType string) erro
 		return fmt.Errorf("error marshalling machineSet providerSpec.Value into byte array: %w", err)
 	}
 
-	glog.V(100).Infof("Updating ProviderSpec MachineType param '%s' for GCP public cloud", machineType)
+	logger.V(logging.LevelTrace).Info("Updating ProviderSpec MachineType param for GCP public cloud",
+		"machineType", machineType)
 
 	var GCPProviderSpecObject *machinev1beta1.GCPMachineProviderSpec
 	err = json.Unmarshal(byteArray, &GCPProviderSpecObject)
 
 	if err != nil {
-		glog.V(100).Infof("error unmarshalling byte array into GCPMachineProviderSpec object: %v", err)
+		logger.V(logging.LevelTrace).Info("Error unmarshalling byte array into GCPMachineProviderSpec object",
+			"error", err)
 
 		return fmt.Errorf("could not update MachineType param: %w", err)
 	}
 
-	glog.V(100).Infof("Setting GCPMachineProviderConfig.MachineType param value to: %s", machineType)
+	logger.V(logging.LevelTrace).Info("Setting GCPMachineProviderConfig.MachineType param value",
+		"machineType", machineType)
 
 	GCPProviderSpecObject.MachineType = machineType
 
 	byteArrayGCP, err := json.Marshal(GCPProviderSpecObject)
 
 	if err != nil {
-		glog.V(100).Infof("error marshalling GCPMachineProviderSpec object into byte array: %v", err)
+		logger.V(logging.LevelTrace).Info("Error marshalling GCPMachineProviderSpec object into byte array",
+			"error", err)
 
 		return fmt.Errorf("could not update MachineType param: %w", err)
 	}
@@ -380,7 +425,8 @@ func (builder *SetBuilder) GCPChangeProviderMachineType(machineType string) erro
 	err = json.Unmarshal(byteArrayGCP, builder.Definition.Spec.Template.Spec.ProviderSpec.Value)
 
 	if err != nil {
-		glog.V(100).Infof("error unmarshalling ProviderSpec byte array into ProviderSpec.Value: %v", err)
+		logger.V(logging.LevelTrace).Info("Error unmarshalling ProviderSpec byte array into ProviderSpec.Value",
+			"error", err)
 
 		return fmt.Errorf("could not update MachineType param: %w", err)
 	}
@@ -404,26 +450,28 @@ func (builder *SetBuilder) AzureChangeProviderVMSize(vmSize string) error {
 		return fmt.Errorf("error marshalling machineSet providerSpec.Value into byte array: %w", err)
 	}
 
-	glog.V(100).Infof("Updating ProviderSpec Value VMSize param '%s' for Azure public cloud",
-		vmSize)
+	logger.V(logging.LevelTrace).Info("Updating ProviderSpec Value VMSize param for Azure public cloud",
+		"vmSize", vmSize)
 
 	var AzureProviderSpecObject *machinev1beta1.AzureMachineProviderSpec
 	err = json.Unmarshal(byteArray, &AzureProviderSpecObject)
 
 	if err != nil {
-		glog.V(100).Infof("error unmarshalling byte array into AzureMachineProviderSpec object: %v", err)
+		logger.V(logging.LevelTrace).Info("Error unmarshalling byte array into AzureMachineProviderSpec object",
+			"error", err)
 
 		return fmt.Errorf("could not update VMSize param: %w", err)
 	}
 
-	glog.V(100).Infof("Setting AzureMachineProviderSpec.VMSize param value to: %s", vmSize)
+	logger.V(logging.LevelTrace).Info("Setting AzureMachineProviderSpec.VMSize param value", "vmSize", vmSize)
 
 	AzureProviderSpecObject.VMSize = vmSize
 
 	byteArrayAzure, err := json.Marshal(AzureProviderSpecObject)
 
 	if err != nil {
-		glog.V(100).Infof("error marshalling AzureMachineProviderSpec object into byte array: %v", err)
+		logger.V(logging.LevelTrace).Info("Error marshalling AzureMachineProviderSpec object into byte array",
+			"error", err)
 
 		return fmt.Errorf("could not update VMSize param: %w", err)
 	}
@@ -431,8 +479,8 @@ func (builder *SetBuilder) AzureChangeProviderVMSize(vmSize string) error {
 	err = json.Unmarshal(byteArrayAzure, builder.Definition.Spec.Template.Spec.ProviderSpec.Value)
 
 	if err != nil {
-		glog.V(100).Infof("error unmarshalling AzureMachineProviderSpec byte array into "+
-			"ProviderSpec.Value object: %v", err)
+		logger.V(logging.LevelTrace).Info("Error unmarshalling AzureMachineProviderSpec byte array into "+
+			"ProviderSpec.Value object", "error", err)
 
 		return fmt.Errorf("could not update VMSize param: %w", err)
 	}
@@ -454,7 +502,7 @@ func createNewWorkerMachineSetFromCopy(
 	}
 
 	if len(workerSetBuilders) == 0 {
-		glog.V(100).Infof("The array of worker MachineSets is empty")
+		logger.V(logging.LevelTrace).Info("The array of worker MachineSets is empty")
 
 		return nil, fmt.Errorf("no worker MachineSets were found")
 	}
@@ -462,8 +510,8 @@ func createNewWorkerMachineSetFromCopy(
 	// picking the first worker SetBuilder in array
 	baseSetBuilder := workerSetBuilders[0]
 
-	glog.V(100).Infof("Creating new SetBuilder copy of first existing worker MachineSet: %s",
-		baseSetBuilder.Definition.Name)
+	logger.V(logging.LevelTrace).Info("Creating new SetBuilder copy of first existing worker MachineSet",
+		"name", baseSetBuilder.Definition.Name)
 
 	copiedSetBuilder := &SetBuilder{
 		apiClient: apiClient,
@@ -473,15 +521,14 @@ func createNewWorkerMachineSetFromCopy(
 		},
 	}
 
-	glog.V(100).Infof("Renaming copied SetBuilder to: %s",
-		copiedSetBuilder.Definition.ObjectMeta.Name)
+	logger.V(logging.LevelTrace).Info("Renaming copied SetBuilder", "name", copiedSetBuilder.Definition.ObjectMeta.Name)
 
 	// replace dots in name with dashes.  Cannot have dots or underscores in machineSet name, must also be lower case
 	copiedSetBuilder.Definition.ObjectMeta.Name = fmt.Sprintf("%v-%v",
 		copiedSetBuilder.Definition.Name,
 		strings.ToLower(regexp.MustCompile(`[\.|\_]`).ReplaceAllString(instanceType, "-")))
 
-	glog.V(100).Infof("Updating copied MachineSet name in metadata, selector and template parameters")
+	logger.V(logging.LevelTrace).Info("Updating copied MachineSet name in metadata, selector and template parameters")
 
 	copiedSetBuilder.Definition.ObjectMeta.UID = ""
 	copiedSetBuilder.Definition.ObjectMeta.ResourceVersion = ""
@@ -492,7 +539,7 @@ func createNewWorkerMachineSetFromCopy(
 	copiedSetBuilder.Definition.Spec.Template.ObjectMeta.Labels["machine.openshift.io/cluster-api-machineset"] =
 		copiedSetBuilder.Definition.ObjectMeta.Name
 
-	glog.V(100).Infof("Updating copied MachineSet replicas value to: %v", replicas)
+	logger.V(logging.LevelTrace).Info("Updating copied MachineSet replicas value", "replicas", replicas)
 	copiedSetBuilder.Definition.Spec.Replicas = &replicas
 
 	return copiedSetBuilder, nil
@@ -504,7 +551,7 @@ func (builder *SetBuilder) getPublicCloudKind() error {
 		return err
 	}
 
-	glog.V(100).Infof("Determining the public cloud kind")
+	logger.V(logging.LevelTrace).Info("Determining the public cloud kind")
 
 	providerSpecMap := make(map[string]interface{})
 
@@ -534,7 +581,8 @@ func (builder *SetBuilder) getPublicCloudKind() error {
 		return fmt.Errorf("failed to detect public cloud kind")
 	}
 
-	glog.V(100).Infof("ProviderSpec kind param is '%s'", publicCloud)
+	logger.V(logging.LevelTrace).Info("Determined the public cloud kind from the ProviderSpec kind param",
+		"publicCloud", publicCloud)
 
 	switch publicCloud {
 	case "AWSMachineProviderConfig":
@@ -552,31 +600,41 @@ func (builder *SetBuilder) getPublicCloudKind() error {
 	return nil
 }
 
+// log returns the logger with the MachineSet kind, name and namespace fields.
+func (builder *SetBuilder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", "MachineSet")
+	}
+
+	return logging.WithResource(logger, "MachineSet", builder.Definition.Name, builder.Definition.Namespace)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *SetBuilder) validate() (bool, error) {
 	resourceCRD := "MachineSet"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiClient is nil", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder apiClient is nil", "kind", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelTrace).Info("The builder has error message", "kind", resourceCRD,
+			"error", builder.errorMsg)
 
 		return false, errors.New(builder.errorMsg)
 	}
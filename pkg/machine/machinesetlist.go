@@ -4,25 +4,35 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ListWorkerMachineSets returns a slice of SetBuilder objects in a namespace on a cluster.
 func ListWorkerMachineSets(
+	apiClient *clients.Settings,
+	namespace string,
+	workerLabel string,
+	options ...metav1.ListOptions) ([]*SetBuilder, error) {
+	return ListWorkerMachineSetsWithContext(context.TODO(), apiClient, namespace, workerLabel, options...)
+}
+
+// ListWorkerMachineSetsWithContext is the context-aware variant of ListWorkerMachineSets.
+func ListWorkerMachineSetsWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	namespace string,
 	workerLabel string,
 	options ...metav1.ListOptions) ([]*SetBuilder, error) {
 	if namespace == "" {
-		glog.V(100).Infof("machineSet 'namespace' parameter can not be empty")
+		logger.V(logging.LevelTrace).Info("machineSet 'namespace' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list MachineSets, 'namespace' parameter is empty")
 	}
 
 	if workerLabel == "" {
-		glog.V(100).Infof("machineSet 'workerLabel' parameter can not be empty")
+		logger.V(logging.LevelTrace).Info("machineSet 'workerLabel' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list MachineSets, 'workerLabel' parameter is empty")
 	}
@@ -31,7 +41,7 @@ func ListWorkerMachineSets(
 	passedOptions := metav1.ListOptions{}
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logger.V(logging.LevelTrace).Info("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -41,13 +51,12 @@ func ListWorkerMachineSets(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logger.V(logging.LevelTrace).Info(logMessage)
 
-	machineSetList, err := apiClient.MachineSets(namespace).List(context.TODO(), passedOptions)
+	machineSetList, err := apiClient.MachineSets(namespace).List(ctx, passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list MachineSets in the namespace %s due to %s",
-			namespace, err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to list MachineSets", "namespace", namespace, "error", err)
 
 		return nil, err
 	}
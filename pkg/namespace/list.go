@@ -11,6 +11,11 @@ import (
 
 // List returns namespace inventory.
 func List(apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.TODO(), apiClient, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func ListWithContext(ctx context.Context, apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, error) {
 	logMessage := "Listing all namespace resources"
 	passedOptions := v1.ListOptions{}
 
@@ -27,7 +32,7 @@ func List(apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, e
 
 	glog.V(100).Infof(logMessage)
 
-	namespacesList, err := apiClient.CoreV1Interface.Namespaces().List(context.TODO(), passedOptions)
+	namespacesList, err := apiClient.CoreV1Interface.Namespaces().List(ctx, passedOptions)
 	if err != nil {
 		glog.V(100).Infof("Failed to list namespaces due to %s", err.Error())
 
@@ -118,16 +118,27 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 // Create makes a namespace in the cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	glog.V(100).Infof("Creating namespace %s", builder.Definition.Name)
 
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunCreate, builder.Definition)
+	}
+
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.Namespaces().Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
 	}
 
 	return builder, err
@@ -135,32 +146,52 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Update renovates the existing namespace object with the namespace definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.TODO())
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	glog.V(100).Infof("Updating the namespace %s with the namespace definition in the builder", builder.Definition.Name)
 
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunUpdate, builder.Definition)
+	}
+
 	var err error
 	builder.Object, err = builder.apiClient.Namespaces().Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		ctx, builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
 
 // Delete removes a namespace.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	glog.V(100).Infof("Deleting namespace %s", builder.Definition.Name)
 
-	if !builder.Exists() {
+	if builder.apiClient.IsDryRun() {
+		return builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
+
+	if !builder.ExistsWithContext(ctx) {
 		return nil
 	}
 
-	err := builder.apiClient.Namespaces().Delete(context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+	err := builder.apiClient.Namespaces().Delete(ctx, builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -173,19 +204,28 @@ func (builder *Builder) Delete() error {
 
 // DeleteAndWait deletes a namespace and waits until it's removed from the cluster.
 func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
+	return builder.DeleteAndWaitWithContext(context.TODO(), timeout)
+}
+
+// DeleteAndWaitWithContext is the context-aware variant of DeleteAndWait.
+func (builder *Builder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	glog.V(100).Infof("Deleting namespace %s and waiting for the removal to complete", builder.Definition.Name)
 
-	if err := builder.Delete(); err != nil {
+	if err := builder.DeleteWithContext(ctx); err != nil {
 		return err
 	}
 
+	if builder.apiClient.IsDryRun() {
+		return nil
+	}
+
 	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			_, err := builder.apiClient.Namespaces().Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			_, err := builder.apiClient.Namespaces().Get(ctx, builder.Definition.Name, metav1.GetOptions{})
 			if k8serrors.IsNotFound(err) {
 				return true, nil
 			}
@@ -196,6 +236,11 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) error {
 
 // Exists checks whether the given namespace exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -204,13 +249,18 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.Namespaces().Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Pull loads existing namespace in to Builder struct.
 func Pull(apiClient *clients.Settings, nsname string) (*Builder, error) {
+	return PullWithContext(context.TODO(), apiClient, nsname)
+}
+
+// PullWithContext is the context-aware variant of Pull.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, nsname string) (*Builder, error) {
 	glog.V(100).Infof("Pulling existing namespace: %s from cluster", nsname)
 
 	builder := Builder{
@@ -226,7 +276,7 @@ func Pull(apiClient *clients.Settings, nsname string) (*Builder, error) {
 		builder.errorMsg = "'namespace' cannot be empty"
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("namespace object %s doesn't exist", nsname)
 	}
 
@@ -237,6 +287,12 @@ func Pull(apiClient *clients.Settings, nsname string) (*Builder, error) {
 
 // CleanObjects removes given objects from the namespace.
 func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...schema.GroupVersionResource) error {
+	return builder.CleanObjectsWithContext(context.TODO(), cleanTimeout, objects...)
+}
+
+// CleanObjectsWithContext is the context-aware variant of CleanObjects.
+func (builder *Builder) CleanObjectsWithContext(
+	ctx context.Context, cleanTimeout time.Duration, objects ...schema.GroupVersionResource) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -248,7 +304,7 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 			builder.Definition.Name)
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return fmt.Errorf("failed to remove resources from non-existent namespace %s",
 			builder.Definition.Name)
 	}
@@ -258,7 +314,7 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 			resource.Resource, builder.Definition.Name)
 
 		err := builder.apiClient.Resource(resource).Namespace(builder.Definition.Name).DeleteCollection(
-			context.TODO(), metav1.DeleteOptions{
+			ctx, metav1.DeleteOptions{
 				GracePeriodSeconds: ptr.To(int64(0)),
 			}, metav1.ListOptions{})
 
@@ -270,9 +326,9 @@ func (builder *Builder) CleanObjects(cleanTimeout time.Duration, objects ...sche
 		}
 
 		err = wait.PollUntilContextTimeout(
-			context.TODO(), 3*time.Second, cleanTimeout, true, func(ctx context.Context) (bool, error) {
+			ctx, 3*time.Second, cleanTimeout, true, func(ctx context.Context) (bool, error) {
 				objList, err := builder.apiClient.Resource(resource).Namespace(builder.Definition.Name).List(
-					context.TODO(), metav1.ListOptions{})
+					ctx, metav1.ListOptions{})
 
 				if err != nil || len(objList.Items) > 1 {
 					// avoid timeout due to default automatically created openshift
@@ -6,13 +6,11 @@ import (
 	"fmt"
 	"time"
 
-	"gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/logging"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 
-	"github.com/golang/glog"
 	. "github.com/onsi/gomega"
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/get"
-	"github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
 	nvidiagpuwait "github.com/rh-ecosystem-edge/nvidia-ci/internal/wait"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/deployment"
@@ -37,25 +35,24 @@ const (
 
 // CreateNFDNamespace creates and labels NFD namespace.
 func CreateNFDNamespace(apiClient *clients.Settings) error {
-	glog.V(gpuparams.GpuLogLevel).Infof("Check if NFD Operator namespace exists, otherwise created it")
+	logger.V(logging.LevelDebug).Info("Check if NFD Operator namespace exists, otherwise created it")
 
 	nfdNsBuilder := namespace.NewBuilder(apiClient, nfdOperatorNamespace)
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Creating the namespace:  %v", nfdOperatorNamespace)
+	logger.V(logging.LevelDebug).Info("Creating the namespace", "namespace", nfdOperatorNamespace)
 
 	createdNfdNsBuilder, err := nfdNsBuilder.Create()
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("error creating NFD namespace '%s' :  %v ", createdNfdNsBuilder.Definition.Name, err)
+		logger.V(logging.LevelDebug).Info("Error creating NFD namespace", "name", createdNfdNsBuilder.Definition.Name,
+			"error", err)
 
 		return err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Successfully created NFD namespace '%s'",
-		createdNfdNsBuilder.Object.Name)
+	logger.V(logging.LevelDebug).Info("Successfully created NFD namespace", "name", createdNfdNsBuilder.Object.Name)
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Labeling the newly created NFD namespace '%s'",
-		nfdNsBuilder.Object.Name)
+	logger.V(logging.LevelDebug).Info("Labeling the newly created NFD namespace", "name", nfdNsBuilder.Object.Name)
 
 	labeledNfdNsBuilder := createdNfdNsBuilder.WithMultipleLabels(map[string]string{
 		"openshift.io/cluster-monitoring":    "true",
@@ -65,35 +62,34 @@ func CreateNFDNamespace(apiClient *clients.Settings) error {
 	newLabeledNfdNsBuilder, err := labeledNfdNsBuilder.Update()
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("error labeling NFD namespace %s: %v", newLabeledNfdNsBuilder.Definition.Name, err)
+		logger.V(logging.LevelDebug).Info("Error labeling NFD namespace",
+			"name", newLabeledNfdNsBuilder.Definition.Name, "error", err)
 
 		return err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("The NFD labeled namespace has "+
-		"labels:  %v", newLabeledNfdNsBuilder.Object.Labels)
+	logger.V(logging.LevelDebug).Info("The NFD labeled namespace has labels",
+		"labels", newLabeledNfdNsBuilder.Object.Labels)
 
 	return nil
 }
 
 // CreateNFDOperatorGroup creates NFD OperatorGroup in NFD namespace.
 func CreateNFDOperatorGroup(apiClient *clients.Settings) error {
-	glog.V(gpuparams.GpuLogLevel).Infof("Create the NFD operatorgroup")
+	logger.V(logging.LevelDebug).Info("Create the NFD operatorgroup")
 
 	nfdOgBuilder := olm.NewOperatorGroupBuilder(apiClient, nfdOperatorGroupName, nfdOperatorNamespace)
 
 	if nfdOgBuilder.Exists() {
-		glog.V(gpuparams.GpuLogLevel).Infof("The nfdOgBuilder that exists has name:  %v",
-			nfdOgBuilder.Object.Name)
+		logger.V(logging.LevelDebug).Info("The NFD OperatorGroup already exists", "name", nfdOgBuilder.Object.Name)
 	} else {
-		glog.V(gpuparams.GpuLogLevel).Infof("Create a new NFD OperatorGroup with name:  %s",
-			nfdOperatorGroupName)
+		logger.V(logging.LevelDebug).Info("Create a new NFD OperatorGroup", "name", nfdOperatorGroupName)
 
 		nfdOgBuilderCreated, err := nfdOgBuilder.Create()
 
 		if err != nil {
-			glog.V(gpuparams.GpuLogLevel).Infof("error creating NFD operatorgroup %v :  %v ",
-				nfdOgBuilderCreated.Definition.Name, err)
+			logger.V(logging.LevelDebug).Info("Error creating NFD operatorgroup",
+				"name", nfdOgBuilderCreated.Definition.Name, "error", err)
 
 			return err
 		}
@@ -104,7 +100,7 @@ func CreateNFDOperatorGroup(apiClient *clients.Settings) error {
 
 // CreateNFDSubscription creates NFD Subscription in NFD namespace.
 func CreateNFDSubscription(apiClient *clients.Settings, nfdCatalogSource string) error {
-	glog.V(gpuparams.GpuLogLevel).Info("Create Subscription in NFD Operator Namespace")
+	logger.V(logging.LevelDebug).Info("Create Subscription in NFD Operator Namespace")
 
 	nfdSubBuilder := olm.NewSubscriptionBuilder(apiClient, nfdSubscriptionName, nfdOperatorNamespace,
 		nfdCatalogSource, nfdCatalogSourceNamespace, nfdPackage)
@@ -112,23 +108,23 @@ func CreateNFDSubscription(apiClient *clients.Settings, nfdCatalogSource string)
 	nfdSubBuilder.WithChannel(nfdChannel)
 	nfdSubBuilder.WithInstallPlanApproval(nfdInstallPlanApproval)
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Creating the NFD subscription, i.e Deploy the NFD operator")
+	logger.V(logging.LevelDebug).Info("Creating the NFD subscription, i.e Deploy the NFD operator")
 
 	createdNfdSub, err := nfdSubBuilder.Create()
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("error creating NFD subscription %v :  %v ",
-			createdNfdSub.Definition.Name, err)
+		logger.V(logging.LevelDebug).Info("Error creating NFD subscription", "name", createdNfdSub.Definition.Name,
+			"error", err)
 
 		return err
 	}
 
 	if createdNfdSub.Exists() {
-		glog.V(gpuparams.GpuLogLevel).Infof("Newly created NFD subscription: %s was successfully created",
-			createdNfdSub.Object.Name)
-		glog.V(gpuparams.GpuLogLevel).Infof("The newly created subscription: %s in namespace: %v "+
-			"has current CSV:  %v", createdNfdSub.Object.Name, createdNfdSub.Object.Namespace,
-			createdNfdSub.Object.Status.CurrentCSV)
+		logger.V(logging.LevelDebug).Info("Newly created NFD subscription was successfully created",
+			"name", createdNfdSub.Object.Name)
+		logger.V(logging.LevelDebug).Info("The newly created NFD subscription has current CSV",
+			"name", createdNfdSub.Object.Name, "namespace", createdNfdSub.Object.Namespace,
+			"currentCSV", createdNfdSub.Object.Status.CurrentCSV)
 	} else {
 		return fmt.Errorf("could not determine the current CSV from newly created subscription: %s in"+
 			" namespace %s", createdNfdSub.Object.Name, createdNfdSub.Object.Namespace)
@@ -139,87 +135,85 @@ func CreateNFDSubscription(apiClient *clients.Settings, nfdCatalogSource string)
 
 // CheckNFDOperatorDeployed checks that NFD Operator is successfully deployed in NFD namespace.
 func CheckNFDOperatorDeployed(apiClient *clients.Settings, waitTime time.Duration) (bool, error) {
-	glog.V(gpuparams.GpuLogLevel).Infof("Check if the NFD operator deployment is ready")
+	logger.V(logging.LevelDebug).Info("Check if the NFD operator deployment is ready")
 
 	nfdOperatorDeployment, err := deployment.Pull(apiClient, nfdOperatorDeploymentName, nfdOperatorNamespace)
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("Error trying to pull NFD operator "+
-			"deployment is: %v", err)
+		logger.V(logging.LevelDebug).Info("Error trying to pull NFD operator deployment", "error", err)
 
 		return false, err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Pulled NFD operator deployment is:  %v ",
-		nfdOperatorDeployment.Definition.Name)
+	logger.V(logging.LevelDebug).Info("Pulled NFD operator deployment", "name", nfdOperatorDeployment.Definition.Name)
 
 	if nfdOperatorDeployment.IsReady(waitTime) {
-		glog.V(gpuparams.GpuLogLevel).Infof("Pulled NFD operator deployment is:  %v is Ready ",
-			nfdOperatorDeployment.Definition.Name)
+		logger.V(logging.LevelDebug).Info("Pulled NFD operator deployment is Ready",
+			"name", nfdOperatorDeployment.Definition.Name)
 	} else {
 		return false, fmt.Errorf("NFD operator deployment:  %v is still not Ready "+
 			"after waiting %v time duration", nfdOperatorDeployment.Definition.Name, waitTime)
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Get currentCSV from NFD subscription")
+	logger.V(logging.LevelDebug).Info("Get currentCSV from NFD subscription")
 
 	nfdCurrentCSVFromSub, err := get.CurrentCSVFromSubscription(apiClient, nfdSubscriptionName,
 		nfdOperatorNamespace)
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("error pulling NFD currentCSV from cluster:  %v", err)
+		logger.V(logging.LevelDebug).Info("Error pulling NFD currentCSV from cluster", "error", err)
 
 		return false, err
 	}
 
 	if nfdCurrentCSVFromSub == "" {
-		glog.V(gpuparams.GpuLogLevel).Infof("NFD currentCSV from subscription is null:  %s",
-			nfdCurrentCSVFromSub)
+		logger.V(logging.LevelDebug).Info("NFD currentCSV from subscription is null",
+			"currentCSV", nfdCurrentCSVFromSub)
 
 		return false, err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("currentCSV %s extracted from NFD Subscription %s",
-		nfdCurrentCSVFromSub, nfdSubscriptionName)
+	logger.V(logging.LevelDebug).Info("Extracted currentCSV from NFD Subscription",
+		"currentCSV", nfdCurrentCSVFromSub, "subscription", nfdSubscriptionName)
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Wait for NFD ClusterServiceVersion to be in " +
+	logger.V(logging.LevelDebug).Info("Wait for NFD ClusterServiceVersion to be in " +
 		"Succeeded phase")
-	glog.V(gpuparams.GpuLogLevel).Infof("Waiting for NFD ClusterServiceVersion to be Succeeded phase")
+	logger.V(logging.LevelDebug).Info("Waiting for NFD ClusterServiceVersion to be Succeeded phase")
 
 	err = nvidiagpuwait.CSVSucceeded(
 		apiClient, nfdCurrentCSVFromSub, nfdOperatorNamespace, 60*time.Second, 5*time.Minute)
 
-	glog.V(gpuparams.GpuLogLevel).Infof("error waiting for NFD ClusterServiceVersion to be "+
-		"in Succeeded phase:  %v ", err)
+	logger.V(logging.LevelDebug).Info("Error waiting for NFD ClusterServiceVersion to be in Succeeded phase",
+		"error", err)
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("error waiting for NFD ClusterServiceVersion"+
-			" to be in Succeeded phase:  %v ", err)
+		logger.V(logging.LevelDebug).Info("Error waiting for NFD ClusterServiceVersion to be in Succeeded phase",
+			"error", err)
 
 		return false, err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Pull existing CSV in NFD Operator Namespace")
+	logger.V(logging.LevelDebug).Info("Pull existing CSV in NFD Operator Namespace")
 
 	clusterNfdCSV, err := olm.PullClusterServiceVersion(apiClient, nfdCurrentCSVFromSub, nfdOperatorNamespace)
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("error pulling CSV %v from cluster:  %v",
-			nfdCurrentCSVFromSub, err)
+		logger.V(logging.LevelDebug).Info("Error pulling CSV from cluster", "name", nfdCurrentCSVFromSub,
+			"error", err)
 
 		return false, err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("NFD clusterCSV from cluster lastUpdatedTime is : %v ",
-		clusterNfdCSV.Definition.Status.LastUpdateTime)
+	logger.V(logging.LevelDebug).Info("Pulled NFD clusterCSV lastUpdateTime from cluster",
+		"lastUpdateTime", clusterNfdCSV.Definition.Status.LastUpdateTime)
 
-	glog.V(gpuparams.GpuLogLevel).Infof("clusterCSV from cluster Phase is : \"%v\"",
-		clusterNfdCSV.Definition.Status.Phase)
+	logger.V(logging.LevelDebug).Info("Pulled NFD clusterCSV phase from cluster",
+		"phase", clusterNfdCSV.Definition.Status.Phase)
 
 	succeeded := v1alpha1.ClusterServiceVersionPhase("Succeeded")
 
 	if clusterNfdCSV.Definition.Status.Phase != succeeded {
-		glog.V(gpuparams.GpuLogLevel).Infof("CSV Phase is not succeeded")
+		logger.V(logging.LevelDebug).Info("CSV Phase is not succeeded")
 
 		return false, fmt.Errorf("CSV Phase is not 'succeeded'")
 	}
@@ -229,24 +223,24 @@ func CheckNFDOperatorDeployed(apiClient *clients.Settings, waitTime time.Duratio
 
 // DeployCRInstance deploys NodeFeatureDiscovery instance from current CSV almExamples.
 func DeployCRInstance(apiClient *clients.Settings) error {
-	glog.V(gpuparams.GpuLogLevel).Infof("Get ALM examples block form NFD CSV")
-	glog.V(gpuparams.GpuLogLevel).Infof("Get currentCSV from NFD subscription")
+	logger.V(logging.LevelDebug).Info("Get ALM examples block form NFD CSV")
+	logger.V(logging.LevelDebug).Info("Get currentCSV from NFD subscription")
 
 	nfdCurrentCSVFromSub, err := get.CurrentCSVFromSubscription(apiClient, nfdSubscriptionName,
 		nfdOperatorNamespace)
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("Error from getting CurrentCSVFromSubscription:  %v ", err)
+		logger.V(logging.LevelDebug).Info("Error from getting CurrentCSVFromSubscription", "error", err)
 
 		return err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Pull existing CSV in NFD Operator Namespace")
+	logger.V(logging.LevelDebug).Info("Pull existing CSV in NFD Operator Namespace")
 
 	clusterNfdCSV, err := olm.PullClusterServiceVersion(apiClient, nfdCurrentCSVFromSub, nfdOperatorNamespace)
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("Error from PullClusterServiceVersion:  %v ", err)
+		logger.V(logging.LevelDebug).Info("Error from PullClusterServiceVersion", "error", err)
 
 		return err
 	}
@@ -254,53 +248,51 @@ func DeployCRInstance(apiClient *clients.Settings) error {
 	almExamples, err := clusterNfdCSV.GetAlmExamples()
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("Error from pulling almExamples from NFD CSV:  %v ", err)
+		logger.V(logging.LevelDebug).Info("Error from pulling almExamples from NFD CSV", "error", err)
 
 		return err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("almExamples block from cluster NFD CSV is : %v ", almExamples)
+	logger.V(logging.LevelDebug).Info("Pulled almExamples block from cluster NFD CSV", "almExamples", almExamples)
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Creating NodeFeatureDiscovery instance from CSV almExamples")
+	logger.V(logging.LevelDebug).Info("Creating NodeFeatureDiscovery instance from CSV almExamples")
 
 	nodeFeatureDiscoveryBuilder := NewBuilderFromObjectString(apiClient, almExamples)
 
 	_, err = nodeFeatureDiscoveryBuilder.Create()
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("Error Creating NodeFeatureDiscovery instance from CSV "+
-			"almExamples  %v ", err)
+		logger.V(logging.LevelDebug).Info("Error Creating NodeFeatureDiscovery instance from CSV almExamples",
+			"error", err)
 
 		return err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Waiting for NFD CR deployment '%s' to be created", nfdCRDeploymentName)
+	logger.V(logging.LevelDebug).Info("Waiting for NFD CR deployment to be created", "name", nfdCRDeploymentName)
 
 	nfdCRDeploymentCreated := nvidiagpuwait.DeploymentCreated(apiClient, nfdCRDeploymentName, nfdOperatorNamespace,
 		30*time.Second, 4*time.Minute)
 
 	if !nfdCRDeploymentCreated {
-		glog.V(gpuparams.GpuLogLevel).Infof("timed out waiting to deploy NFD CR deployment")
+		logger.V(logging.LevelDebug).Info("timed out waiting to deploy NFD CR deployment")
 
 		return fmt.Errorf("timed out waiting to deploy NFD CR deployment")
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Check if the NFD CR deployment is ready")
+	logger.V(logging.LevelDebug).Info("Check if the NFD CR deployment is ready")
 
 	nfdCRDeployment, err := deployment.Pull(apiClient, nfdCRDeploymentName, nfdOperatorNamespace)
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("Error pulling NFD CR deployment  %v ", err)
+		logger.V(logging.LevelDebug).Info("Error pulling NFD CR deployment", "error", err)
 
 		return err
 	}
 
-	glog.V(gpuparams.GpuLogLevel).Infof("Pulled NFD CR deployment is:  %v ",
-		nfdCRDeployment.Definition.Name)
+	logger.V(logging.LevelDebug).Info("Pulled NFD CR deployment", "name", nfdCRDeployment.Definition.Name)
 
 	if nfdCRDeployment.IsReady(180 * time.Second) {
-		glog.V(gpuparams.GpuLogLevel).Infof("Pulled NFD operator deployment is:  %v is Ready ",
-			nfdCRDeployment.Definition.Name)
+		logger.V(logging.LevelDebug).Info("Pulled NFD CR deployment is Ready", "name", nfdCRDeployment.Definition.Name)
 	} else {
 		return fmt.Errorf("NFD CR deployment is not ready after wait period")
 	}
@@ -310,14 +302,14 @@ func DeployCRInstance(apiClient *clients.Settings) error {
 
 // GetNFDCRJson outputs the NFD CR instance json file.
 func GetNFDCRJson(apiClient *clients.Settings, nfdCRName string, nfdNamespace string) error {
-	glog.V(gpuparams.GpuLogLevel).Infof("Pull the NodeFeatureDiscovery just created from cluster, " +
+	logger.V(logging.LevelDebug).Info("Pull the NodeFeatureDiscovery just created from cluster, " +
 		"with updated fields")
 
 	pulledNodeFeatureDiscovery, err := Pull(apiClient, nfdCRName, nfdNamespace)
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("error pulling NodeFeatureDiscovery %s from "+
-			"cluster: %v ", nfdCRName, err)
+		logger.V(logging.LevelDebug).Info("Error pulling NodeFeatureDiscovery from cluster", "name", nfdCRName,
+			"error", err)
 
 		return err
 	}
@@ -325,13 +317,12 @@ func GetNFDCRJson(apiClient *clients.Settings, nfdCRName string, nfdNamespace st
 	nfdCRJson, err := json.MarshalIndent(pulledNodeFeatureDiscovery, "", " ")
 
 	if err == nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("The NodeFeatureDiscovery just created has name:  %v",
-			pulledNodeFeatureDiscovery.Definition.Name)
-		glog.V(gpuparams.GpuLogLevel).Infof("The NodeFeatureDiscovery just created marshalled "+
-			"in json: %v", string(nfdCRJson))
+		logger.V(logging.LevelDebug).Info("The NodeFeatureDiscovery just created has name",
+			"name", pulledNodeFeatureDiscovery.Definition.Name)
+		logger.V(logging.LevelDebug).Info("The NodeFeatureDiscovery just created marshalled in json",
+			"json", string(nfdCRJson))
 	} else {
-		glog.V(gpuparams.GpuLogLevel).Infof("Error Marshalling NodeFeatureDiscovery into json:  %v",
-			err)
+		logger.V(logging.LevelDebug).Info("Error Marshalling NodeFeatureDiscovery into json", "error", err)
 	}
 
 	return nil
@@ -345,7 +336,7 @@ func NFDCRDeleteAndWait(apiClient *clients.Settings) error {
 			nfdCR, err := Pull(apiClient, CRName, OperatorNamespace)
 
 			if err != nil {
-				glog.V(gpuparams.GpuLogLevel).Infof("NodeFeatureDiscovery pull from cluster error: %s\n", err)
+				logger.V(logging.LevelDebug).Info("NodeFeatureDiscovery pull from cluster error", "error", err)
 
 				return false, err
 			}
@@ -356,15 +347,15 @@ func NFDCRDeleteAndWait(apiClient *clients.Settings) error {
 			}
 
 			if !nfdCR.Exists() {
-				glog.V(gpuparams.GpuLogLevel).Infof("NodeFeatureDiscovery instance '%s' in namespace '%s' does "+
-					"not exist", CRName, OperatorNamespace)
+				logger.V(logging.LevelDebug).Info("NodeFeatureDiscovery instance does not exist", "name", CRName,
+					"namespace", OperatorNamespace)
 
 				// this exists out of the wait.PollImmediate()
 				return true, nil
 			}
 
-			glog.V(gpuparams.GpuLogLevel).Infof("NodeFeatureDiscovery instance %s in namespace %s still exists",
-				nfdCR.Object.Name, nfdCR.Object.Namespace)
+			logger.V(logging.LevelDebug).Info("NodeFeatureDiscovery instance still exists", "name", nfdCR.Object.Name,
+				"namespace", nfdCR.Object.Namespace)
 
 			return false, err
 		})
@@ -372,13 +363,13 @@ func NFDCRDeleteAndWait(apiClient *clients.Settings) error {
 
 // DeleteNFDNamespace creates and labels NFD namespace.
 func DeleteNFDNamespace(apiClient *clients.Settings) error {
-	glog.V(gpuparams.GpuLogLevel).Infof("Deleting NFD namespace '%s'", nfdOperatorNamespace)
+	logger.V(logging.LevelDebug).Info("Deleting NFD namespace", "namespace", nfdOperatorNamespace)
 
 	pulledNFDNsBuilder, err := namespace.Pull(apiClient, nfdOperatorNamespace)
 
 	if err != nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("error pulling NFD namespace '%s' :  %v ",
-			nfdOperatorNamespace, err)
+		logger.V(logging.LevelDebug).Info("Error pulling NFD namespace", "namespace", nfdOperatorNamespace,
+			"error", err)
 
 		return err
 	}
@@ -390,13 +381,13 @@ func DeleteNFDNamespace(apiClient *clients.Settings) error {
 
 // DeleteNFDOperatorGroup creates NFD OperatorGroup in NFD namespace.
 func DeleteNFDOperatorGroup(apiClient *clients.Settings) error {
-	glog.V(gpuparams.GpuLogLevel).Infof("Deleting NFD OperatorGroup '%s' in namespace '%s'",
-		nfdOperatorGroupName, nfdOperatorNamespace)
+	logger.V(logging.LevelDebug).Info("Deleting NFD OperatorGroup", "name", nfdOperatorGroupName,
+		"namespace", nfdOperatorNamespace)
 
 	pulledNFDOg, err := olm.PullOperatorGroup(apiClient, nfdOperatorGroupName, nfdOperatorNamespace)
 
 	if !pulledNFDOg.Exists() {
-		glog.V(gpuparams.GpuLogLevel).Infof("The NFD OperatorGroup %s does not exist", nfdOperatorGroupName)
+		logger.V(logging.LevelDebug).Info("The NFD OperatorGroup does not exist", "name", nfdOperatorGroupName)
 
 		return err
 	}
@@ -408,13 +399,13 @@ func DeleteNFDOperatorGroup(apiClient *clients.Settings) error {
 
 // DeleteNFDSubscription Deletes NFD Subscription in NFD namespace.
 func DeleteNFDSubscription(apiClient *clients.Settings) error {
-	glog.V(gpuparams.GpuLogLevel).Info("Deleting NFD Subscription '%s' in namespace '%s'",
-		nfdSubscriptionName, nfdOperatorNamespace)
+	logger.V(logging.LevelDebug).Info("Deleting NFD Subscription", "name", nfdSubscriptionName,
+		"namespace", nfdOperatorNamespace)
 
 	pulledNFDSub, err := olm.PullSubscription(apiClient, nfdSubscriptionName, nfdOperatorNamespace)
 
 	if !pulledNFDSub.Exists() {
-		glog.V(gpuparams.GpuLogLevel).Infof("The NFD Subscription %s does not exist", nfdOperatorGroupName)
+		logger.V(logging.LevelDebug).Info("The NFD Subscription does not exist", "name", nfdSubscriptionName)
 
 		return err
 	}
@@ -426,7 +417,7 @@ func DeleteNFDSubscription(apiClient *clients.Settings) error {
 
 // DeleteNFDCSV Deletes NFD CSV in NFD namespace.
 func DeleteNFDCSV(apiClient *clients.Settings) error {
-	glog.V(gpuparams.GpuLogLevel).Infof("Deleting currently installed NFD CSV")
+	logger.V(logging.LevelDebug).Info("Deleting currently installed NFD CSV")
 
 	nfdCurrentCSVFromSub, err := get.CurrentCSVFromSubscription(apiClient, nfdSubscriptionName,
 		nfdOperatorNamespace)
@@ -463,9 +454,18 @@ func DeleteAnyNFDCSV(apiClient *clients.Settings) error {
 		return err
 	}
 
-	for _, csv := range csvList.Items {
-		glog.V(gpuparams.GpuLogLevel).Infof("Attempt deleting NFD CSV %s in namespace %s", csv.Name,
-			nfdOperatorNamespace)
+	for index, csv := range csvList.Items {
+		logger.V(logging.LevelDebug).Info("Attempt deleting NFD CSV", "name", csv.Name,
+			"namespace", nfdOperatorNamespace)
+
+		if apiClient.IsDryRun() {
+			if err := apiClient.RecordDryRun(clients.DryRunDelete, &csvList.Items[index]); err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		if err := apiClient.ClusterServiceVersions(nfdOperatorNamespace).Delete(context.TODO(), csv.Name,
 			metav1.DeleteOptions{}); err != nil {
 			return err
@@ -475,19 +475,18 @@ func DeleteAnyNFDCSV(apiClient *clients.Settings) error {
 	return nil
 }
 
-func CreateNFDDeployment(apiClient *clients.Settings, catalogSource string, logLevel logging.Level) bool {
-	glog.V(glog.Level(logLevel)).Info("Deploying NFD Subscription")
+func CreateNFDDeployment(apiClient *clients.Settings, catalogSource string) bool {
+	logger.V(logging.LevelDebug).Info("Deploying NFD Subscription", "catalogSource", catalogSource)
 	err := CreateNFDSubscription(apiClient, catalogSource)
 	Expect(err).ToNot(HaveOccurred(), "error creating NFD Subscription: %v", err)
 
-	glog.V(glog.Level(logLevel)).Info("Sleeping for 2 minutes to allow the NFD Operator deployment to stabilize")
-	time.Sleep(2 * time.Minute)
-
-	glog.V(glog.Level(logLevel)).Infof("Waiting up to %v for NFD Operator deployment to be fully created", NFDOperatorTimeout)
-	nfdDeploymentCreated := nvidiagpuwait.DeploymentCreated(apiClient, OperatorDeploymentName, OperatorNamespace, NFDOperatorCheckInterval, NFDOperatorTimeout)
-	Expect(nfdDeploymentCreated).ToNot(BeFalse(), "timed out waiting for NFD operator deployment")
+	logger.V(logging.LevelDebug).Info("Waiting for NFD Operator deployment to be fully created",
+		"timeout", NFDOperatorTimeout)
+	err = nvidiagpuwait.WatchDeploymentCreated(context.TODO(), apiClient, OperatorDeploymentName, OperatorNamespace,
+		NFDOperatorTimeout)
+	Expect(err).ToNot(HaveOccurred(), "timed out waiting for NFD operator deployment: %v", err)
 
-	glog.V(glog.Level(logLevel)).Info("Checking if NFD Operator deployment is active")
+	logger.V(logging.LevelDebug).Info("Checking if NFD Operator deployment is active")
 	nfdDeployed, err := CheckNFDOperatorDeployed(apiClient, 4*time.Minute)
 	Expect(err).ToNot(HaveOccurred(), "error deploying NFD Operator in NFD namespace: %v", err)
 
@@ -7,9 +7,10 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/golang/glog"
+	"github.com/go-logr/logr"
 	nfdv1 "github.com/openshift/cluster-nfd-operator/api/v1"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/msg"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/olm"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -33,12 +34,12 @@ type Builder struct {
 
 // NewBuilderFromObjectString creates a Builder object from CSV alm-examples.
 func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string) *Builder {
-	glog.V(LogLevel).Infof(
+	logger.V(logging.LevelTrace).Info(
 		"Initializing new Builder structure from almExample string")
 
 	nodeFeatureDiscovery, err := getNodeFeatureDiscoveryFromAlmExample(almExample)
 
-	glog.V(LogLevel).Infof(
+	logger.V(logging.LevelTrace).Info(
 		"Initializing Builder definition to NodeFeatureDiscovery object")
 
 	builder := Builder{
@@ -47,15 +48,14 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 	}
 
 	if err != nil {
-		glog.V(LogLevel).Infof(
-			"Error initializing NodeFeatureDiscovery from alm-examples: %s", err.Error())
+		logger.V(logging.LevelTrace).Info("Error initializing NodeFeatureDiscovery from alm-examples", "error", err)
 
 		builder.errorMsg = fmt.Sprintf("Error initializing NodeFeatureDiscovery from alm-examples: %s",
 			err.Error())
 	}
 
 	if builder.Definition == nil {
-		glog.V(LogLevel).Infof("The NodeFeatureDiscovery object definition is nil")
+		logger.V(logging.LevelTrace).Info("The NodeFeatureDiscovery object definition is nil")
 
 		builder.errorMsg = "NodeFeatureDiscovery definition is nil"
 	}
@@ -65,22 +65,25 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 
 // Get returns NodeFeatureDiscovery object if found.
 func (builder *Builder) Get() (*nfdv1.NodeFeatureDiscovery, error) {
+	return builder.GetWithContext(context.TODO())
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (builder *Builder) GetWithContext(ctx context.Context) (*nfdv1.NodeFeatureDiscovery, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	glog.V(LogLevel).Infof("Collecting NodeFeatureDiscovery object %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Collecting NodeFeatureDiscovery object")
 
 	nodeFeatureDiscovery := &nfdv1.NodeFeatureDiscovery{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(ctx, goclient.ObjectKey{
 		Name:      builder.Definition.Name,
 		Namespace: builder.Definition.Namespace,
 	}, nodeFeatureDiscovery)
 
 	if err != nil {
-		glog.V(LogLevel).Infof("NodeFeatureDiscovery object %s doesn't exist in namespace %s",
-			builder.Definition.Name, builder.Definition.Namespace)
+		builder.log().V(logging.LevelTrace).Info("NodeFeatureDiscovery object doesn't exist")
 
 		return nil, err
 	}
@@ -90,7 +93,12 @@ func (builder *Builder) Get() (*nfdv1.NodeFeatureDiscovery, error) {
 
 // Pull loads an existing NodeFeatureDiscovery into Builder struct.
 func Pull(apiClient *clients.Settings, name, namespace string) (*Builder, error) {
-	glog.V(LogLevel).Infof("Pulling existing nodeFeatureDiscovery name: %s in namespace: %s", name, namespace)
+	return PullWithContext(context.TODO(), apiClient, name, namespace)
+}
+
+// PullWithContext is the context-aware variant of Pull.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name, namespace string) (*Builder, error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing nodeFeatureDiscovery", "name", name, "namespace", namespace)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -103,18 +111,18 @@ func Pull(apiClient *clients.Settings, name, namespace string) (*Builder, error)
 	}
 
 	if name == "" {
-		glog.V(LogLevel).Infof("NodeFeatureDiscovery name is empty")
+		logger.V(logging.LevelTrace).Info("NodeFeatureDiscovery name is empty")
 
 		builder.errorMsg = "NodeFeatureDiscovery 'name' cannot be empty"
 	}
 
 	if namespace == "" {
-		glog.V(LogLevel).Infof("NodeFeatureDiscovery namespace is empty")
+		logger.V(logging.LevelTrace).Info("NodeFeatureDiscovery namespace is empty")
 
 		builder.errorMsg = "NodeFeatureDiscovery 'namespace' cannot be empty"
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("NodeFeatureDiscovery object %s doesn't exist in namespace %s", name, namespace)
 	}
 
@@ -125,19 +133,22 @@ func Pull(apiClient *clients.Settings, name, namespace string) (*Builder, error)
 
 // Exists checks whether the given NodeFeatureDiscovery exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(LogLevel).Infof(
-		"Checking if NodeFeatureDiscovery %s exists in namespace %s", builder.Definition.Name,
-		builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Checking if NodeFeatureDiscovery exists")
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	if err != nil {
-		glog.V(LogLevel).Infof("Failed to collect NodeFeatureDiscovery object due to %s", err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to collect NodeFeatureDiscovery object", "error", err)
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -145,18 +156,26 @@ func (builder *Builder) Exists() bool {
 
 // Delete removes a NodeFeatureDiscovery.
 func (builder *Builder) Delete() (*Builder, error) {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *Builder) DeleteWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(LogLevel).Infof("Deleting NodeFeatureDiscovery %s in namespace %s", builder.Definition.Name,
-		builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Deleting NodeFeatureDiscovery")
+
+	if builder.apiClient.IsDryRun() {
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return builder, fmt.Errorf("NodeFeatureDiscovery cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete NodeFeaturediscovery: %w", err)
@@ -169,16 +188,26 @@ func (builder *Builder) Delete() (*Builder, error) {
 
 // Create makes a NodeFeatureDiscovery in the cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(LogLevel).Infof("Creating the NodeFeatureDiscovery %s in namespace %s", builder.Definition.Name,
-		builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Creating the NodeFeatureDiscovery")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunCreate, builder.Definition)
+	}
 
 	var err error
-	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+	if !builder.ExistsWithContext(ctx) {
+		err = builder.apiClient.Create(ctx, builder.Definition)
 
 		if err == nil {
 			builder.Object = builder.Definition
@@ -190,30 +219,40 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Update renovates the existing NodeFeatureDiscovery object with the definition in builder.
 func (builder *Builder) Update(force bool) (*Builder, error) {
+	return builder.UpdateWithContext(context.TODO(), force)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (builder *Builder) UpdateWithContext(ctx context.Context, force bool) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(LogLevel).Infof("Updating the NodeFeatureDiscovery object named: %s in namespace: %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Updating the NodeFeatureDiscovery object")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunUpdate, builder.Definition)
+	}
+
+	err := builder.apiClient.Update(ctx, builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(LogLevel).Infof(
+			logger.V(logging.LevelTrace).Info(
 				msg.FailToUpdateNotification("NodeFeatureDiscovery", builder.Definition.Name, builder.Definition.Namespace))
 
-			builder, err := builder.Delete()
+			builder, err := builder.DeleteWithContext(ctx)
 
 			if err != nil {
-				glog.V(LogLevel).Infof(
+				logger.V(logging.LevelTrace).Info(
 					msg.FailToUpdateError("NodeFeatureDiscovery", builder.Definition.Name, builder.Definition.Namespace))
 
 				return nil, err
 			}
 
-			return builder.Create()
+			return builder.CreateWithContext(ctx)
 		}
 	}
 
@@ -235,24 +274,33 @@ func getNodeFeatureDiscoveryFromAlmExample(almExample string) (*nfdv1.NodeFeatur
 	return &nfd, nil
 }
 
+// log returns the logger with the NodeFeatureDiscovery kind, name and namespace fields.
+func (builder *Builder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", "NodeFeatureDiscovery")
+	}
+
+	return logging.WithResource(logger, "NodeFeatureDiscovery", builder.Definition.Name, builder.Definition.Namespace)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 
 	if builder == nil {
-		glog.V(LogLevel).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(LogLevel).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
 
 		return false, errors.New(msg.UndefinedCrdObjectErrString(resourceCRD))
 	}
 
 	if builder.apiClient == nil {
-		glog.V(LogLevel).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder apiclient is nil", "kind", resourceCRD)
 
 		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
 	}
@@ -1,46 +1,46 @@
 package nfd
 
 import (
+	"context"
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/check"
 	_ "github.com/rh-ecosystem-edge/nvidia-ci/internal/check"
-	"github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
 	_ "github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
 	_ "github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
+	nvidiagpuwait "github.com/rh-ecosystem-edge/nvidia-ci/internal/wait"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/olm"
 	_ "github.com/rh-ecosystem-edge/nvidia-ci/pkg/olm"
 	. "github.com/rh-ecosystem-edge/nvidia-ci/pkg/operatorconfig"
-	"gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/logging"
 )
 
 // EnsureNFDIsInstalled ensures that the Node Feature Discovery (NFD) operator
 // is installed on the cluster. If not, it attempts to deploy the operator and,
 // if necessary, creates a custom CatalogSource to make NFD available.
-func EnsureNFDIsInstalled(apiClient *clients.Settings, Nfd *CustomConfig, ocpVersion string, level glog.Level) {
+func EnsureNFDIsInstalled(apiClient *clients.Settings, Nfd *CustomConfig, ocpVersion string) {
 	By("Check if NFD is installed")
 	nfdInstalled, err := check.NFDDeploymentsReady(apiClient)
 
 	if nfdInstalled && err == nil {
-		glog.V(gpuparams.GpuLogLevel).Infof("The check for ready NFD deployments is: %v", nfdInstalled)
-		glog.V(gpuparams.GpuLogLevel).Infof("NFD operators and operands are already installed on " +
+		logger.V(logging.LevelDebug).Info("The check for ready NFD deployments returned", "ready", nfdInstalled)
+		logger.V(logging.LevelDebug).Info("NFD operators and operands are already installed on " +
 			"this cluster")
 	} else {
-		glog.V(level).Infof("NFD is not currently installed on this cluster")
-		glog.V(level).Infof("Deploying NFD Operator and CR instance on this cluster")
+		logger.V(logging.LevelDebug).Info("NFD is not currently installed on this cluster")
+		logger.V(logging.LevelDebug).Info("Deploying NFD Operator and CR instance on this cluster")
 
 		Nfd.CleanupAfterInstall = true
 
 		if Nfd.CreateCustomCatalogsource {
-			glog.V(level).Infof("Creating custom catalogsource '%s' for NFD "+
-				"Operator with index image '%s'", Nfd.CustomCatalogSource, Nfd.CustomCatalogSourceIndexImage)
+			logger.V(logging.LevelDebug).Info("Creating custom catalogsource for NFD Operator",
+				"name", Nfd.CustomCatalogSource, "indexImage", Nfd.CustomCatalogSourceIndexImage)
 
 			nfdCustomCatalogSourceBuilder := olm.NewCatalogSourceBuilderWithIndexImage(inittools.APIClient,
 				Nfd.CustomCatalogSource, CatalogSourceNamespace, Nfd.CustomCatalogSourceIndexImage,
@@ -56,12 +56,14 @@ func EnsureNFDIsInstalled(apiClient *clients.Settings, Nfd *CustomConfig, ocpVer
 			Expect(createdNFDCustomCatalogSourceBuilder).ToNot(BeNil(), "Failed to "+
 				" create custom NFD catalogsource '%s'", Nfd.CustomCatalogSource)
 
-			By(fmt.Sprintf("Sleep for %s to allow the NFD custom catalogsource to be created", nvidiagpu.SleepDuration.String()))
-			time.Sleep(nvidiagpu.SleepDuration)
+			By(fmt.Sprintf("Wait up to %s for custom NFD catalogsource to be ready", nvidiagpu.WaitDuration))
+			logger.V(logging.LevelDebug).Info("Wait for custom NFD catalogsource to be ready",
+				"timeout", nvidiagpu.WaitDuration, "name", createdNFDCustomCatalogSourceBuilder.Definition.Name)
 
-			glog.V(level).Infof("Wait up to %s for custom NFD catalogsource '%s' to be ready", nvidiagpu.WaitDuration.String(), createdNFDCustomCatalogSourceBuilder.Definition.Name)
-
-			Expect(createdNFDCustomCatalogSourceBuilder.IsReady(nvidiagpu.WaitDuration)).NotTo(BeFalse())
+			err = nvidiagpuwait.WatchCatalogSourceReady(context.TODO(), inittools.APIClient,
+				createdNFDCustomCatalogSourceBuilder.Definition.Name, CatalogSourceNamespace, nvidiagpu.WaitDuration)
+			Expect(err).ToNot(HaveOccurred(), "error waiting for custom NFD catalogsource '%s' to be ready: %v",
+				Nfd.CustomCatalogSource, err)
 
 			nfdPkgManifestBuilderByCustomCatalog, err := olm.PullPackageManifestByCatalogWithTimeout(inittools.APIClient,
 				Package, CatalogSourceNamespace, Nfd.CustomCatalogSource, 30*time.Second, 5*time.Minute)
@@ -71,8 +73,8 @@ func EnsureNFDIsInstalled(apiClient *clients.Settings, Nfd *CustomConfig, ocpVer
 
 			Nfd.CatalogSource = Nfd.CustomCatalogSource
 			nfdChannel := nfdPkgManifestBuilderByCustomCatalog.Object.Status.DefaultChannel
-			glog.V(level).Infof("NFD channel '%s' retrieved from packagemanifest "+
-				"of custom catalogsource '%s'", nfdChannel, Nfd.CustomCatalogSource)
+			logger.V(logging.LevelDebug).Info("NFD channel retrieved from packagemanifest of custom catalogsource",
+				"channel", nfdChannel, "catalogSource", Nfd.CustomCatalogSource)
 
 		} else {
 
@@ -84,27 +86,26 @@ func EnsureNFDIsInstalled(apiClient *clients.Settings, Nfd *CustomConfig, ocpVer
 				"from default catalog '%s':  %v", Package, CatalogSourceDefault, err)
 
 			if nfdPkgManifestBuilderByCatalog == nil {
-				glog.V(level).Infof("NFD packagemanifest was not found in the default '%s'"+
-					" catalog.", CatalogSourceDefault)
+				logger.V(logging.LevelDebug).Info("NFD packagemanifest was not found in the default catalog",
+					"catalogSource", CatalogSourceDefault)
 				Skip("NFD packagemanifest not found in default 'redhat-operators' catalogsource, " +
 					"and no custom catalogsource is defined")
 			}
 
-			glog.V(level).Infof("The nfd packagemanifest '%s' was found in the default"+
-				" catalog '%s'", nfdPkgManifestBuilderByCatalog.Object.Name, CatalogSourceDefault)
+			logger.V(logging.LevelDebug).Info("The nfd packagemanifest was found in the default catalog",
+				"name", nfdPkgManifestBuilderByCatalog.Object.Name, "catalogSource", CatalogSourceDefault)
 
 			Nfd.CatalogSource = CatalogSourceDefault
 			nfdChannel := nfdPkgManifestBuilderByCatalog.Object.Status.DefaultChannel
-			glog.V(level).Infof("The NFD channel retrieved from packagemanifest is:  %v",
-				nfdChannel)
+			logger.V(logging.LevelDebug).Info("The NFD channel retrieved from packagemanifest", "channel", nfdChannel)
 
 		}
 
-		DeployNFDOperatorWithRetries(inittools.APIClient, Nfd, level, ocpVersion)
+		DeployNFDOperatorWithRetries(inittools.APIClient, Nfd, ocpVersion)
 	}
 }
 
-func DeployNFDOperatorWithRetries(apiClient *clients.Settings, nfdInstance *CustomConfig, logLevel glog.Level, ocpVersion string) {
+func DeployNFDOperatorWithRetries(apiClient *clients.Settings, nfdInstance *CustomConfig, ocpVersion string) {
 	By("Deploy NFD Operator in NFD namespace")
 	err := CreateNFDNamespace(apiClient)
 	Expect(err).ToNot(HaveOccurred(), "error creating NFD Namespace: %v", err)
@@ -113,7 +114,7 @@ func DeployNFDOperatorWithRetries(apiClient *clients.Settings, nfdInstance *Cust
 	err = CreateNFDOperatorGroup(apiClient)
 	Expect(err).ToNot(HaveOccurred(), "error creating NFD OperatorGroup: %v", err)
 
-	nfdDeployed := CreateNFDDeployment(apiClient, nfdInstance.CatalogSource, logging.Level(logLevel))
+	nfdDeployed := CreateNFDDeployment(apiClient, nfdInstance.CatalogSource)
 	if !nfdDeployed {
 		By(fmt.Sprintf("Applying workaround for NFD failing to deploy on OCP %s", ocpVersion))
 
@@ -123,12 +124,12 @@ func DeployNFDOperatorWithRetries(apiClient *clients.Settings, nfdInstance *Cust
 		err = DeleteAnyNFDCSV(apiClient)
 		Expect(err).ToNot(HaveOccurred(), "error deleting NFD CSV: %v", err)
 
-		err = olm.DeleteOLMPods(apiClient, logging.Level(logLevel))
+		err = olm.DeleteOLMPods(apiClient)
 		Expect(err).ToNot(HaveOccurred(), "error deleting OLM pods for operator cache workaround: %v", err)
 
-		glog.V(logLevel).Info("Re-trying NFD deployment")
+		logger.V(logging.LevelDebug).Info("Re-trying NFD deployment")
 
-		nfdDeployed = CreateNFDDeployment(apiClient, nfdInstance.CatalogSource, logging.Level(logLevel))
+		nfdDeployed = CreateNFDDeployment(apiClient, nfdInstance.CatalogSource)
 		Expect(nfdDeployed).ToNot(BeFalse(), "failed to deploy NFD operator")
 	}
 
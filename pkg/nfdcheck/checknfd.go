@@ -3,21 +3,23 @@ package nfdcheck
 import (
 	"fmt"
 
-	"github.com/golang/glog"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/check"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 )
 
-func CheckNfdInstallation(apiClient *clients.Settings, label string, allowedLabelValues []string, workerLabelMap map[string]string, logLevel int) {
+var logger = logging.Logger(logging.NFD)
+
+func CheckNfdInstallation(apiClient *clients.Settings, label string, allowedLabelValues []string, workerLabelMap map[string]string) {
 	By(fmt.Sprintf("Check if NFD is installed using label: %s", label))
 	nfdLabelDetected, err := check.AllNodeLabel(apiClient, label, allowedLabelValues, workerLabelMap)
 	Expect(err).ToNot(HaveOccurred(), "error calling check.NodeLabel: %v", err)
 	Expect(nfdLabelDetected).NotTo(BeFalse(), "NFD node label check failed to match label %s and label values %v on all nodes", label, allowedLabelValues)
-	glog.V(glog.Level(logLevel)).Infof("The check for NFD label returned: %v", nfdLabelDetected)
+	logger.V(logging.LevelDebug).Info("The check for NFD label returned", "label", label, "detected", nfdLabelDetected)
 
 	isNfdInstalled, err := check.NFDDeploymentsReady(apiClient)
 	Expect(err).ToNot(HaveOccurred(), "error checking if NFD deployments are ready: %v", err)
-	glog.V(glog.Level(logLevel)).Infof("The check for NFD deployments ready returned: %v", isNfdInstalled)
+	logger.V(logging.LevelDebug).Info("The check for NFD deployments ready returned", "ready", isNfdInstalled)
 }
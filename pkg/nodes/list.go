@@ -18,6 +18,11 @@ const (
 
 // List returns node inventory.
 func List(apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.TODO(), apiClient, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func ListWithContext(ctx context.Context, apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, error) {
 	passedOptions := v1.ListOptions{}
 	logMessage := "Listing all node resources"
 
@@ -34,7 +39,7 @@ func List(apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, e
 
 	glog.V(100).Infof(logMessage)
 
-	nodeList, err := apiClient.CoreV1Interface.Nodes().List(context.TODO(), passedOptions)
+	nodeList, err := apiClient.CoreV1Interface.Nodes().List(ctx, passedOptions)
 	if err != nil {
 		glog.V(100).Infof("Failed to list nodes due to %s", err.Error())
 
@@ -46,9 +51,10 @@ func List(apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, e
 	for _, runningNode := range nodeList.Items {
 		copiedNode := runningNode
 		nodeBuilder := &Builder{
-			apiClient:  apiClient.K8sClient,
-			Object:     &copiedNode,
-			Definition: &copiedNode,
+			apiClient:      apiClient.K8sClient,
+			dryRunRecorder: apiClient.DryRunRecorder,
+			Object:         &copiedNode,
+			Definition:     &copiedNode,
 		}
 
 		nodeObjects = append(nodeObjects, nodeBuilder)
@@ -59,11 +65,17 @@ func List(apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, e
 
 // ListExternalIPv4Networks returns a list of node's external ipv4 addresses.
 func ListExternalIPv4Networks(apiClient *clients.Settings, options ...v1.ListOptions) ([]string, error) {
+	return ListExternalIPv4NetworksWithContext(context.TODO(), apiClient, options...)
+}
+
+// ListExternalIPv4NetworksWithContext is the context-aware variant of ListExternalIPv4Networks.
+func ListExternalIPv4NetworksWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...v1.ListOptions) ([]string, error) {
 	glog.V(100).Infof("Collecting node's external ipv4 addresses")
 
 	var ipV4ExternalAddresses []string
 
-	nodeBuilders, err := List(apiClient, options...)
+	nodeBuilders, err := ListWithContext(ctx, apiClient, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -84,12 +96,19 @@ func ListExternalIPv4Networks(apiClient *clients.Settings, options ...v1.ListOpt
 
 // WaitForAllNodesAreReady waits for all nodes to be Ready for a time duration up to the timeout.
 func WaitForAllNodesAreReady(apiClient *clients.Settings,
+	timeout time.Duration,
+	options ...v1.ListOptions) (bool, error) {
+	return WaitForAllNodesAreReadyWithContext(context.TODO(), apiClient, timeout, options...)
+}
+
+// WaitForAllNodesAreReadyWithContext is the context-aware variant of WaitForAllNodesAreReady.
+func WaitForAllNodesAreReadyWithContext(ctx context.Context, apiClient *clients.Settings,
 	timeout time.Duration,
 	options ...v1.ListOptions) (bool, error) {
 	glog.V(100).Infof("Waiting for all nodes to be in the Ready state for up to a duration of %v",
 		timeout)
 
-	nodesList, err := List(apiClient, options...)
+	nodesList, err := ListWithContext(ctx, apiClient, options...)
 	if err != nil {
 		glog.V(100).Infof("Failed to list all nodes due to %s", err.Error())
 
@@ -97,7 +116,7 @@ func WaitForAllNodesAreReady(apiClient *clients.Settings,
 	}
 
 	err = wait.PollUntilContextTimeout(
-		context.TODO(), backoff, timeout, true, func(ctx context.Context) (done bool, err error) {
+		ctx, backoff, timeout, true, func(ctx context.Context) (done bool, err error) {
 			for _, node := range nodesList {
 				ready, err := node.IsReady()
 				if err != nil {
@@ -132,11 +151,18 @@ func WaitForAllNodesAreReady(apiClient *clients.Settings,
 
 // WaitForAllNodesToReboot waits for all nodes to start and finish reboot up to the timeout.
 func WaitForAllNodesToReboot(apiClient *clients.Settings,
+	globalRebootTimeout time.Duration,
+	options ...v1.ListOptions) (bool, error) {
+	return WaitForAllNodesToRebootWithContext(context.TODO(), apiClient, globalRebootTimeout, options...)
+}
+
+// WaitForAllNodesToRebootWithContext is the context-aware variant of WaitForAllNodesToReboot.
+func WaitForAllNodesToRebootWithContext(ctx context.Context, apiClient *clients.Settings,
 	globalRebootTimeout time.Duration,
 	options ...v1.ListOptions) (bool, error) {
 	glog.V(100).Infof("Waiting for all nodes in the list to reboot and return to the Ready condition")
 
-	nodesList, err := List(apiClient, options...)
+	nodesList, err := ListWithContext(ctx, apiClient, options...)
 	if err != nil {
 		glog.V(100).Infof("Failed to list all nodes due to %s", err.Error())
 
@@ -147,7 +173,7 @@ func WaitForAllNodesToReboot(apiClient *clients.Settings,
 	readyNodes := []string{}
 	rebootedNodes := []string{}
 	err = wait.PollUntilContextTimeout(
-		context.TODO(), backoff, globalRebootTimeout, true, func(ctx context.Context) (done bool, err error) {
+		ctx, backoff, globalRebootTimeout, true, func(ctx context.Context) (done bool, err error) {
 			for _, node := range nodesList {
 				if !slices.Contains(readyNodes, node.Object.Name) {
 					ready, err := node.IsReady()
@@ -32,6 +32,8 @@ type Builder struct {
 	apiClient   kubernetes.Interface
 	errorMsg    string
 	drainHelper *drain.Helper
+	// dryRunRecorder records the mutations of the builder instead of applying them when set.
+	dryRunRecorder *clients.DryRunRecorder
 }
 
 // SetDrainHelper builds drain Helper that contains parameters to control the behaviour of drain.
@@ -42,6 +44,20 @@ func (builder *Builder) SetDrainHelper(
 	gracePeriod int,
 	skipWaitForDeleteTimeoutSeconds int,
 	timeout time.Duration,
+) {
+	builder.SetDrainHelperWithContext(context.TODO(), force, ignoreDaemonsets, deleteLocalData, gracePeriod,
+		skipWaitForDeleteTimeoutSeconds, timeout)
+}
+
+// SetDrainHelperWithContext is the context-aware variant of SetDrainHelper.
+func (builder *Builder) SetDrainHelperWithContext(
+	ctx context.Context,
+	force bool,
+	ignoreDaemonsets bool,
+	deleteLocalData bool,
+	gracePeriod int,
+	skipWaitForDeleteTimeoutSeconds int,
+	timeout time.Duration,
 ) {
 	glog.V(100).Infof("Creating new DrainOptions config")
 
@@ -55,7 +71,7 @@ func (builder *Builder) SetDrainHelper(
 	glog.V(100).Infof(msg)
 
 	builder.drainHelper = &drain.Helper{
-		Ctx:    context.TODO(),
+		Ctx:    ctx,
 		Client: builder.apiClient,
 		// Delete pods that do not declare a controller.
 		Force: force,
@@ -116,10 +132,16 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // Pull gathers existing node from cluster.
 func Pull(apiClient *clients.Settings, nodeName string) (*Builder, error) {
+	return PullWithContext(context.TODO(), apiClient, nodeName)
+}
+
+// PullWithContext is the context-aware variant of Pull.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, nodeName string) (*Builder, error) {
 	glog.V(100).Infof("Pulling existing node object: %s", nodeName)
 
 	builder := Builder{
-		apiClient: apiClient.K8sClient,
+		apiClient:      apiClient.K8sClient,
+		dryRunRecorder: apiClient.DryRunRecorder,
 		Definition: &corev1.Node{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: nodeName,
@@ -127,7 +149,7 @@ func Pull(apiClient *clients.Settings, nodeName string) (*Builder, error) {
 		},
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("node object %s doesn't exist", nodeName)
 	}
 
@@ -138,13 +160,24 @@ func Pull(apiClient *clients.Settings, nodeName string) (*Builder, error) {
 
 // Update renovates the existing node object with the node definition in builder.
 func (builder *Builder) Update() (*Builder, error) {
+	return builder.UpdateWithContext(context.TODO())
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (builder *Builder) UpdateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
 	glog.V(100).Infof("Updating configuration of node %s", builder.Definition.Name)
 
-	if !builder.Exists() {
+	if builder.dryRunRecorder != nil {
+		builder.Object = builder.Definition
+
+		return builder, builder.dryRunRecorder.Record(clients.DryRunUpdate, builder.Definition)
+	}
+
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("node %s object doesn't exist", builder.Definition.Name)
 	}
 
@@ -153,13 +186,18 @@ func (builder *Builder) Update() (*Builder, error) {
 
 	var err error
 	builder.Object, err = builder.apiClient.CoreV1().Nodes().Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		ctx, builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
 
 // Exists checks whether the given node exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -168,25 +206,34 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.CoreV1().Nodes().Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete removes node from the cluster.
 func (builder *Builder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *Builder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	glog.V(100).Infof("Deleting the node %s", builder.Definition.Name)
 
-	if !builder.Exists() {
+	if builder.dryRunRecorder != nil {
+		return builder.dryRunRecorder.Record(clients.DryRunDelete, builder.Definition)
+	}
+
+	if !builder.ExistsWithContext(ctx) {
 		return fmt.Errorf("node cannot be deleted because it does not exist")
 	}
 
 	err := builder.apiClient.CoreV1().Nodes().Delete(
-		context.TODO(),
+		ctx,
 		builder.Definition.Name,
 		metav1.DeleteOptions{})
 
@@ -306,13 +353,18 @@ func (builder *Builder) ExternalIPv4Network() (string, error) {
 
 // IsReady check if the Node is Ready.
 func (builder *Builder) IsReady() (bool, error) {
+	return builder.IsReadyWithContext(context.TODO())
+}
+
+// IsReadyWithContext is the context-aware variant of IsReady.
+func (builder *Builder) IsReadyWithContext(ctx context.Context) (bool, error) {
 	if valid, err := builder.validate(); !valid {
 		return false, err
 	}
 
 	glog.V(100).Infof("Verify %s node availability", builder.Definition.Name)
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return false, fmt.Errorf("%s node object doesn't exist", builder.Definition.Name)
 	}
 
@@ -327,14 +379,21 @@ func (builder *Builder) IsReady() (bool, error) {
 
 // WaitUntilConditionTrue waits for timeout duration or until node gets to a specific status.
 func (builder *Builder) WaitUntilConditionTrue(
+	conditionType corev1.NodeConditionType, timeout time.Duration) error {
+	return builder.WaitUntilConditionTrueWithContext(context.TODO(), conditionType, timeout)
+}
+
+// WaitUntilConditionTrueWithContext is the context-aware variant of WaitUntilConditionTrue.
+func (builder *Builder) WaitUntilConditionTrueWithContext(
+	ctx context.Context,
 	conditionType corev1.NodeConditionType, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			if !builder.Exists() {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.ExistsWithContext(ctx) {
 				return false, fmt.Errorf("node %s object doesn't exist", builder.Definition.Name)
 			}
 
@@ -358,14 +417,21 @@ func (builder *Builder) WaitUntilConditionTrue(
 
 // WaitUntilConditionUnknown waits for timeout duration or until node change specific status.
 func (builder *Builder) WaitUntilConditionUnknown(
+	conditionType corev1.NodeConditionType, timeout time.Duration) error {
+	return builder.WaitUntilConditionUnknownWithContext(context.TODO(), conditionType, timeout)
+}
+
+// WaitUntilConditionUnknownWithContext is the context-aware variant of WaitUntilConditionUnknown.
+func (builder *Builder) WaitUntilConditionUnknownWithContext(
+	ctx context.Context,
 	conditionType corev1.NodeConditionType, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			if !builder.Exists() {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			if !builder.ExistsWithContext(ctx) {
 				return false, fmt.Errorf("node %s object doesn't exist", builder.Definition.Name)
 			}
 
@@ -389,12 +455,22 @@ func (builder *Builder) WaitUntilConditionUnknown(
 
 // WaitUntilReady waits for timeout duration or until node is Ready.
 func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
-	return builder.WaitUntilConditionTrue(corev1.NodeReady, timeout)
+	return builder.WaitUntilReadyWithContext(context.TODO(), timeout)
+}
+
+// WaitUntilReadyWithContext is the context-aware variant of WaitUntilReady.
+func (builder *Builder) WaitUntilReadyWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilConditionTrueWithContext(ctx, corev1.NodeReady, timeout)
 }
 
 // WaitUntilNotReady waits for timeout duration or until node is NotReady.
 func (builder *Builder) WaitUntilNotReady(timeout time.Duration) error {
-	return builder.WaitUntilConditionUnknown(corev1.NodeReady, timeout)
+	return builder.WaitUntilNotReadyWithContext(context.TODO(), timeout)
+}
+
+// WaitUntilNotReadyWithContext is the context-aware variant of WaitUntilNotReady.
+func (builder *Builder) WaitUntilNotReadyWithContext(ctx context.Context, timeout time.Duration) error {
+	return builder.WaitUntilConditionUnknownWithContext(ctx, corev1.NodeReady, timeout)
 }
 
 // validate will check that the builder and builder definition are properly initialized before
@@ -8,8 +8,9 @@ import (
 
 	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
 	jsonpatch "github.com/evanphx/json-patch/v5"
-	"github.com/golang/glog"
+	"github.com/go-logr/logr"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/msg"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/olm"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -34,7 +35,7 @@ type Builder struct {
 
 // NewBuilderFromObjectString creates a Builder object from CSV alm-examples.
 func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string) *Builder {
-	glog.V(100).Infof("Initializing new Builder structure from almExample string")
+	logger.V(logging.LevelTrace).Info("Initializing new Builder structure from almExample string")
 	var clusterPolicy nvidiagpuv1.ClusterPolicy
 	clusterPolicyExample, err := olm.GetALMExampleItem(0, almExample)
 	if err != nil {
@@ -47,7 +48,7 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 
 // NewBuilderFromObjectStringAndPatch creates a Builder object from CSV alm-examples and applies an RFC6902 JSON patch to it.
 func NewBuilderFromObjectStringAndPatch(apiClient *clients.Settings, almExample, patchJSON string) *Builder {
-	glog.V(100).Infof("Initializing new Builder structure from almExample string and a patch JSON")
+	logger.V(logging.LevelTrace).Info("Initializing new Builder structure from almExample string and a patch JSON")
 	var clusterPolicy nvidiagpuv1.ClusterPolicy
 	if strings.TrimSpace(patchJSON) == "" {
 		err := fmt.Errorf("patch JSON cannot be an empty string")
@@ -64,7 +65,7 @@ func NewBuilderFromObjectStringAndPatch(apiClient *clients.Settings, almExample,
 		return newBuilder(apiClient, &clusterPolicy, fmt.Errorf("invalid JSON patch: %w", err))
 	}
 
-	glog.V(100).Infof("Applying patch to the default cluster policy")
+	logger.V(logging.LevelTrace).Info("Applying patch to the default cluster policy")
 	modifiedExample, err := patch.Apply(clusterPolicyExample)
 	if err != nil {
 		return newBuilder(apiClient, &clusterPolicy, err)
@@ -75,8 +76,8 @@ func NewBuilderFromObjectStringAndPatch(apiClient *clients.Settings, almExample,
 }
 
 func newBuilder(apiClient *clients.Settings, clusterPolicy *nvidiagpuv1.ClusterPolicy, err error) *Builder {
-	glog.V(100).Infof(
-		"Initializing new Builder structure with clusterPolicy name: %s", clusterPolicy.Name)
+	logger.V(logging.LevelTrace).Info("Initializing new Builder structure with clusterPolicy",
+		"name", clusterPolicy.Name)
 
 	builder := Builder{
 		apiClient:  apiClient,
@@ -84,14 +85,13 @@ func newBuilder(apiClient *clients.Settings, clusterPolicy *nvidiagpuv1.ClusterP
 	}
 
 	if err != nil {
-		glog.V(100).Infof(
-			"Error initializing ClusterPolicy from alm-examples: %s", err.Error())
+		logger.V(logging.LevelTrace).Info("Error initializing ClusterPolicy from alm-examples", "error", err)
 
 		builder.errorMsg = fmt.Sprintf("Error initializing ClusterPolicy from alm-examples: %s", err.Error())
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The ClusterPolicy object definition is nil")
+		logger.V(logging.LevelTrace).Info("The ClusterPolicy object definition is nil")
 
 		builder.errorMsg = "ClusterPolicy 'Object.Definition' is nil"
 	}
@@ -101,21 +101,24 @@ func newBuilder(apiClient *clients.Settings, clusterPolicy *nvidiagpuv1.ClusterP
 
 // Get returns clusterPolicy object if found.
 func (builder *Builder) Get() (*nvidiagpuv1.ClusterPolicy, error) {
+	return builder.GetWithContext(context.TODO())
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (builder *Builder) GetWithContext(ctx context.Context) (*nvidiagpuv1.ClusterPolicy, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
-		"Collecting ClusterPolicy object %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Collecting ClusterPolicy object")
 
 	clusterPolicy := &nvidiagpuv1.ClusterPolicy{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(ctx, goclient.ObjectKey{
 		Name: builder.Definition.Name,
 	}, clusterPolicy)
 
 	if err != nil {
-		glog.V(100).Infof(
-			"ClusterPolicy object %s doesn't exist", builder.Definition.Name)
+		builder.log().V(logging.LevelTrace).Info("ClusterPolicy object doesn't exist")
 
 		return nil, err
 	}
@@ -125,7 +128,12 @@ func (builder *Builder) Get() (*nvidiagpuv1.ClusterPolicy, error) {
 
 // Pull loads an existing clusterPolicy into Builder struct.
 func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
-	glog.V(100).Infof("Pulling existing clusterPolicy name: %s", name)
+	return PullWithContext(context.TODO(), apiClient, name)
+}
+
+// PullWithContext is the context-aware variant of Pull.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name string) (*Builder, error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing clusterPolicy", "name", name)
 
 	builder := Builder{
 		apiClient: apiClient,
@@ -137,12 +145,12 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 	}
 
 	if name == "" {
-		glog.V(100).Infof("ClusterPolicy name is empty")
+		logger.V(logging.LevelTrace).Info("ClusterPolicy name is empty")
 
 		builder.errorMsg = "ClusterPolicy 'name' cannot be empty"
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("ClusterPolicy object %s doesn't exist", name)
 	}
 
@@ -153,18 +161,22 @@ func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
 
 // Exists checks whether the given ClusterPolicy exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof(
-		"Checking if ClusterPolicy %s exists", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Checking if ClusterPolicy exists")
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect ClusterPolicy object due to %s", err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to collect ClusterPolicy object", "error", err)
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -172,17 +184,26 @@ func (builder *Builder) Exists() bool {
 
 // Delete removes a ClusterPolicy.
 func (builder *Builder) Delete() (*Builder, error) {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *Builder) DeleteWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting ClusterPolicy %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Deleting ClusterPolicy")
+
+	if builder.apiClient.IsDryRun() {
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return builder, fmt.Errorf("clusterpolicy cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete clusterpolicy: %w", err)
@@ -195,15 +216,26 @@ func (builder *Builder) Delete() (*Builder, error) {
 
 // Create makes a ClusterPolicy in the cluster and stores the created object in struct.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the ClusterPolicy %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Creating the ClusterPolicy")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunCreate, builder.Definition)
+	}
 
 	var err error
-	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+	if !builder.ExistsWithContext(ctx) {
+		err = builder.apiClient.Create(ctx, builder.Definition)
 
 		if err == nil {
 			builder.Object = builder.Definition
@@ -215,59 +247,80 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Update renovates the existing ClusterPolicy object with the definition in builder.
 func (builder *Builder) Update(force bool) (*Builder, error) {
+	return builder.UpdateWithContext(context.TODO(), force)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (builder *Builder) UpdateWithContext(ctx context.Context, force bool) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the ClusterPolicy object named:  %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Updating the ClusterPolicy object")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunUpdate, builder.Definition)
+	}
+
+	err := builder.apiClient.Update(ctx, builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(msg.FailToUpdateNotification("clusterpolicy", builder.Definition.Name))
+			logger.V(logging.LevelTrace).Info(msg.FailToUpdateNotification("clusterpolicy", builder.Definition.Name))
 
-			builder, err := builder.Delete()
+			builder, err := builder.DeleteWithContext(ctx)
 
 			if err != nil {
-				glog.V(100).Infof(
+				logger.V(logging.LevelTrace).Info(
 					msg.FailToUpdateError("clusterpolicy", builder.Definition.Name))
 
 				return nil, err
 			}
 
-			return builder.Create()
+			return builder.CreateWithContext(ctx)
 		}
 	}
 
 	return builder, err
 }
 
+// log returns the logger with the ClusterPolicy kind, name and namespace fields.
+func (builder *Builder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", "ClusterPolicy")
+	}
+
+	return logging.WithResource(logger, "ClusterPolicy", builder.Definition.Name, builder.Definition.Namespace)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "ClusterPolicy"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder apiclient is nil", "kind", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelTrace).Info("The builder has error message", "kind", resourceCRD,
+			"error", builder.errorMsg)
 
 		return false, errors.New(builder.errorMsg)
 	}
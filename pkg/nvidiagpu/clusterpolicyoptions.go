@@ -0,0 +1,335 @@
+package nvidiagpu
+
+import (
+	"fmt"
+	"slices"
+
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	upgradev1alpha1 "github.com/NVIDIA/k8s-operator-libs/api/upgrade/v1alpha1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+)
+
+// sandboxWorkloadTypes are the workload types the GPU operator can configure the nodes for.
+var sandboxWorkloadTypes = []string{"container", "vm-passthrough", "vm-vgpu"}
+
+// WithDriverRepository sets the repository of the driver image.
+func (builder *Builder) WithDriverRepository(repository string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy driver repository", "repository", repository)
+
+	if repository == "" {
+		builder.errorMsg = "ClusterPolicy driver 'repository' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Driver.Repository = repository
+
+	return builder
+}
+
+// WithDriverImage sets the name of the driver image.
+func (builder *Builder) WithDriverImage(image string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy driver image", "image", image)
+
+	if image == "" {
+		builder.errorMsg = "ClusterPolicy driver 'image' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Driver.Image = image
+
+	return builder
+}
+
+// WithDriverVersion sets the driver version, which is the tag of the driver image.
+func (builder *Builder) WithDriverVersion(version string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy driver version", "version", version)
+
+	if version == "" {
+		builder.errorMsg = "ClusterPolicy driver 'version' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Driver.Version = version
+
+	return builder
+}
+
+// WithPrecompiledDriver sets whether precompiled driver images are used instead of building the driver on the nodes.
+func (builder *Builder) WithPrecompiledDriver(precompiled bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy precompiled driver", "precompiled", precompiled)
+
+	builder.Definition.Spec.Driver.UsePrecompiled = &precompiled
+
+	return builder
+}
+
+// WithNvidiaDriverCRD sets whether the driver is managed by NVIDIADriver objects instead of the ClusterPolicy.
+func (builder *Builder) WithNvidiaDriverCRD(enabled bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy driver useNvidiaDriverCRD", "enabled", enabled)
+
+	builder.Definition.Spec.Driver.UseNvidiaDriverCRD = &enabled
+
+	return builder
+}
+
+// WithDriverUpgradePolicy sets the driver upgrade policy.
+func (builder *Builder) WithDriverUpgradePolicy(upgradePolicy *upgradev1alpha1.DriverUpgradePolicySpec) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy driver upgrade policy",
+		"upgradePolicy", upgradePolicy)
+
+	if upgradePolicy == nil {
+		builder.errorMsg = "ClusterPolicy driver 'upgradePolicy' cannot be nil"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Driver.UpgradePolicy = upgradePolicy
+
+	return builder
+}
+
+// WithDriverAutoUpgrade sets whether the driver is upgraded automatically, keeping the rest of the upgrade policy.
+func (builder *Builder) WithDriverAutoUpgrade(autoUpgrade bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy driver auto upgrade", "autoUpgrade", autoUpgrade)
+
+	if builder.Definition.Spec.Driver.UpgradePolicy == nil {
+		builder.Definition.Spec.Driver.UpgradePolicy = &upgradev1alpha1.DriverUpgradePolicySpec{}
+	}
+
+	builder.Definition.Spec.Driver.UpgradePolicy.AutoUpgrade = autoUpgrade
+
+	return builder
+}
+
+// WithDaemonsetsMaxUnavailable sets the maximum number or percentage of nodes the operand DaemonSets pods are
+// updated on at once.
+func (builder *Builder) WithDaemonsetsMaxUnavailable(maxUnavailable string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy daemonsets rollingUpdate maxUnavailable",
+		"maxUnavailable", maxUnavailable)
+
+	if maxUnavailable == "" {
+		builder.errorMsg = "ClusterPolicy daemonsets 'maxUnavailable' cannot be empty"
+
+		return builder
+	}
+
+	if builder.Definition.Spec.Daemonsets.RollingUpdate == nil {
+		builder.Definition.Spec.Daemonsets.RollingUpdate = &nvidiagpuv1.RollingUpdateSpec{}
+	}
+
+	builder.Definition.Spec.Daemonsets.RollingUpdate.MaxUnavailable = maxUnavailable
+
+	return builder
+}
+
+// WithToolkit sets whether the container toolkit is deployed.
+func (builder *Builder) WithToolkit(enabled bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy toolkit", "enabled", enabled)
+
+	builder.Definition.Spec.Toolkit.Enabled = &enabled
+
+	return builder
+}
+
+// WithDCGMExporterConfig sets the name of the ConfigMap holding the dcgm-metrics.csv file of the metrics
+// collected by the DCGM exporter.
+func (builder *Builder) WithDCGMExporterConfig(configMapName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy DCGM exporter config", "configMap", configMapName)
+
+	if configMapName == "" {
+		builder.errorMsg = "ClusterPolicy DCGM exporter config 'configMapName' cannot be empty"
+
+		return builder
+	}
+
+	if builder.Definition.Spec.DCGMExporter.MetricsConfig == nil {
+		builder.Definition.Spec.DCGMExporter.MetricsConfig = &nvidiagpuv1.DCGMExporterMetricsConfig{}
+	}
+
+	builder.Definition.Spec.DCGMExporter.MetricsConfig.Name = configMapName
+
+	return builder
+}
+
+// WithMIGStrategy sets the MIG strategy applied by the device plugin and GPU feature discovery.
+func (builder *Builder) WithMIGStrategy(strategy nvidiagpuv1.MIGStrategy) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy MIG strategy", "strategy", strategy)
+
+	switch strategy {
+	case nvidiagpuv1.MIGStrategyNone, nvidiagpuv1.MIGStrategySingle, nvidiagpuv1.MIGStrategyMixed:
+		builder.Definition.Spec.MIG.Strategy = strategy
+	default:
+		builder.errorMsg = fmt.Sprintf("invalid ClusterPolicy MIG 'strategy' %s", strategy)
+	}
+
+	return builder
+}
+
+// WithDevicePlugin sets whether the device plugin is deployed.
+func (builder *Builder) WithDevicePlugin(enabled bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy device plugin", "enabled", enabled)
+
+	builder.Definition.Spec.DevicePlugin.Enabled = &enabled
+
+	return builder
+}
+
+// WithDevicePluginConfig sets the ConfigMap holding the device plugin configurations and the name of its default
+// configuration.
+func (builder *Builder) WithDevicePluginConfig(configMapName, defaultConfig string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy device plugin config",
+		"configMap", configMapName, "default", defaultConfig)
+
+	if configMapName == "" {
+		builder.errorMsg = "ClusterPolicy device plugin config 'configMapName' cannot be empty"
+
+		return builder
+	}
+
+	if builder.Definition.Spec.DevicePlugin.Config == nil {
+		builder.Definition.Spec.DevicePlugin.Config = &nvidiagpuv1.DevicePluginConfig{}
+	}
+
+	builder.Definition.Spec.DevicePlugin.Config.Name = configMapName
+	builder.Definition.Spec.DevicePlugin.Config.Default = defaultConfig
+
+	return builder
+}
+
+// WithDevicePluginMPSRoot sets the root directory of the MPS control daemon of the device plugin.
+func (builder *Builder) WithDevicePluginMPSRoot(root string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy device plugin MPS root", "root", root)
+
+	if root == "" {
+		builder.errorMsg = "ClusterPolicy device plugin MPS 'root' cannot be empty"
+
+		return builder
+	}
+
+	if builder.Definition.Spec.DevicePlugin.MPS == nil {
+		builder.Definition.Spec.DevicePlugin.MPS = &nvidiagpuv1.MPSConfig{}
+	}
+
+	builder.Definition.Spec.DevicePlugin.MPS.Root = root
+
+	return builder
+}
+
+// WithCDI sets whether the Container Device Interface is used, and whether it is the default runtime mode.
+func (builder *Builder) WithCDI(enabled, defaultMode bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy CDI", "enabled", enabled, "default", defaultMode)
+
+	if defaultMode && !enabled {
+		builder.errorMsg = "ClusterPolicy CDI cannot be the default mode when disabled"
+
+		return builder
+	}
+
+	builder.Definition.Spec.CDI.Enabled = &enabled
+	builder.Definition.Spec.CDI.Default = &defaultMode
+
+	return builder
+}
+
+// WithGDS sets whether the GPUDirect Storage driver is deployed.
+func (builder *Builder) WithGDS(enabled bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy GPUDirect Storage", "enabled", enabled)
+
+	if builder.Definition.Spec.GPUDirectStorage == nil {
+		builder.Definition.Spec.GPUDirectStorage = &nvidiagpuv1.GPUDirectStorageSpec{}
+	}
+
+	builder.Definition.Spec.GPUDirectStorage.Enabled = &enabled
+
+	return builder
+}
+
+// WithSandboxWorkloads sets whether the operands of sandbox workloads are managed, and the workload type the
+// nodes are configured for by default: container, vm-passthrough or vm-vgpu.
+func (builder *Builder) WithSandboxWorkloads(enabled bool, defaultWorkload string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting ClusterPolicy sandbox workloads", "enabled", enabled,
+		"defaultWorkload", defaultWorkload)
+
+	if !slices.Contains(sandboxWorkloadTypes, defaultWorkload) {
+		builder.errorMsg = fmt.Sprintf("invalid ClusterPolicy sandbox 'defaultWorkload' %s, expected one of %v",
+			defaultWorkload, sandboxWorkloadTypes)
+
+		return builder
+	}
+
+	builder.Definition.Spec.SandboxWorkloads.Enabled = &enabled
+	builder.Definition.Spec.SandboxWorkloads.DefaultWorkload = defaultWorkload
+
+	return builder
+}
@@ -0,0 +1,89 @@
+package nvidiagpu
+
+import (
+	"testing"
+
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+)
+
+func TestClusterPolicyOptions(t *testing.T) {
+	builder := NewBuilderFromObjectString(clients.NewFakeSettings(), testALMExamples).
+		WithDriverRepository("registry.example.com/nvidia").
+		WithDriverImage("driver").
+		WithDriverVersion("570.124.06").
+		WithPrecompiledDriver(false).
+		WithNvidiaDriverCRD(false).
+		WithDriverAutoUpgrade(true).
+		WithDaemonsetsMaxUnavailable("25%").
+		WithToolkit(true).
+		WithDCGMExporterConfig("dcgm-metrics").
+		WithMIGStrategy(nvidiagpuv1.MIGStrategyMixed).
+		WithDevicePlugin(true).
+		WithDevicePluginConfig("plugin-config", "default").
+		WithDevicePluginMPSRoot("/run/nvidia/mps").
+		WithCDI(true, true).
+		WithGDS(true).
+		WithSandboxWorkloads(true, "vm-passthrough")
+
+	if valid, err := builder.validate(); !valid {
+		t.Fatalf("unexpected invalid builder: %v", err)
+	}
+
+	spec := builder.Definition.Spec
+	if spec.Driver.Repository != "registry.example.com/nvidia" || spec.Driver.Image != "driver" ||
+		spec.Driver.Version != "570.124.06" || spec.Driver.UsePrecompiledDrivers() ||
+		!spec.Driver.UpgradePolicy.AutoUpgrade {
+		t.Errorf("unexpected driver spec %+v", spec.Driver)
+	}
+
+	if spec.Daemonsets.RollingUpdate.MaxUnavailable != "25%" ||
+		spec.DCGMExporter.MetricsConfig.Name != "dcgm-metrics" || spec.DevicePlugin.Config.Name != "plugin-config" ||
+		spec.DevicePlugin.MPS.Root != "/run/nvidia/mps" || !spec.DevicePlugin.IsEnabled() {
+		t.Errorf("unexpected ClusterPolicy spec %+v", spec)
+	}
+
+	if spec.MIG.Strategy != nvidiagpuv1.MIGStrategyMixed || !spec.CDI.IsDefault() ||
+		!spec.GPUDirectStorage.IsEnabled() || !spec.SandboxWorkloads.IsEnabled() || !spec.Toolkit.IsEnabled() {
+		t.Errorf("unexpected ClusterPolicy spec %+v", spec)
+	}
+}
+
+func TestClusterPolicyOptionsValidation(t *testing.T) {
+	testCases := []struct {
+		name   string
+		option func(builder *Builder) *Builder
+	}{
+		{name: "empty driver version", option: func(builder *Builder) *Builder {
+			return builder.WithDriverVersion("")
+		}},
+		{name: "nil upgrade policy", option: func(builder *Builder) *Builder {
+			return builder.WithDriverUpgradePolicy(nil)
+		}},
+		{name: "invalid MIG strategy", option: func(builder *Builder) *Builder {
+			return builder.WithMIGStrategy("all")
+		}},
+		{name: "default disabled CDI", option: func(builder *Builder) *Builder {
+			return builder.WithCDI(false, true)
+		}},
+		{name: "invalid sandbox workload", option: func(builder *Builder) *Builder {
+			return builder.WithSandboxWorkloads(true, "vm")
+		}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			builder := testCase.option(NewBuilderFromObjectString(clients.NewFakeSettings(), testALMExamples)).
+				WithToolkit(true)
+
+			if _, err := builder.Create(); err == nil {
+				t.Error("expected error creating ClusterPolicy with invalid option")
+			}
+		})
+	}
+
+	var nilBuilder *Builder
+	if nilBuilder.WithGDS(true) != nil {
+		t.Error("expected nil builder to stay nil")
+	}
+}
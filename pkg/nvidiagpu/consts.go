@@ -5,40 +5,35 @@ import "time"
 const (
 	NvidiaGPUNamespace = "nvidia-gpu-operator"
 
-	NvidiaGPULabel                   = "feature.node.kubernetes.io/pci-10de.present"
-	OperatorGroupName                = "gpu-og"
-	OperatorDeployment               = "gpu-operator"
-	SubscriptionName                 = "gpu-subscription"
-	SubscriptionNamespace            = "nvidia-gpu-operator"
-	CatalogSourceDefault             = "certified-operators"
-	CatalogSourceNamespace           = "openshift-marketplace"
-	Package                          = "gpu-operator-certified"
-	ClusterPolicyName                = "gpu-cluster-policy"
-	OperatorDefaultMasterBundleImage = "ghcr.io/nvidia/gpu-operator/gpu-operator-bundle:main-latest"
+	NvidiaGPULabel         = "feature.node.kubernetes.io/pci-10de.present"
+	OperatorGroupName      = "gpu-og"
+	OperatorDeployment     = "gpu-operator"
+	SubscriptionName       = "gpu-subscription"
+	SubscriptionNamespace  = "nvidia-gpu-operator"
+	CatalogSourceDefault   = "certified-operators"
+	CatalogSourceNamespace = "openshift-marketplace"
+	Package                = "gpu-operator-certified"
+	ClusterPolicyName      = "gpu-cluster-policy"
 
 	CustomCatalogSourcePublisherName = "Red Hat"
 
 	CustomCatalogSourceDisplayName = "Certified Operators Custom"
 
-	SleepDuration = 30 * time.Second
-
 	WaitDuration = 4 * time.Minute
 
 	DeletionPollInterval     = 30 * time.Second
 	DeletionTimeoutDuration  = 5 * time.Minute
 	MachineReadyWaitDuration = 15 * time.Minute
 
-	NodeLabelingDelay = 2 * time.Minute
+	NodeLabelingTimeout = 10 * time.Minute
 
-	CatalogSourceCreationDelay   = 30 * time.Second
 	CatalogSourceReadyTimeout    = 4 * time.Minute
 	PackageManifestCheckInterval = 30 * time.Second
 	PackageManifestTimeout       = 5 * time.Minute
 	GpuBundleDeploymentTimeout   = 5 * time.Minute
 
-	OperatorDeploymentCreationDelay = 2 * time.Minute
 	DeploymentCreationCheckInterval = 30 * time.Second
-	DeploymentCreationTimeout       = 4 * time.Minute
+	DeploymentCreationTimeout       = 6 * time.Minute
 
 	OperatorDeploymentReadyTimeout = 4 * time.Minute
 
@@ -48,6 +43,8 @@ const (
 	ClusterPolicyReadyCheckInterval = 60 * time.Second
 	ClusterPolicyReadyTimeout       = 12 * time.Minute
 
+	NVIDIADriverReadyTimeout = 15 * time.Minute
+
 	BurnPodCreationTimeout = 5 * time.Minute
 
 	BurnPodRunningTimeout = 3 * time.Minute
@@ -55,7 +52,12 @@ const (
 
 	BurnLogCollectionPeriod = 500 * time.Second
 
-	CsvDeploymentSleepInterval = 2 * time.Minute
+	DCGMMetricsCheckInterval = 15 * time.Second
+	DCGMMetricsTimeout       = 3 * time.Minute
+
+	CUDASamplesSuccessTimeout = 10 * time.Minute
+
+	CsvUpgradeTimeout = 10 * time.Minute
 
 	BurnPodPostUpgradeCreationTimeout = 5 * time.Minute
 
@@ -0,0 +1,183 @@
+package nvidiagpu
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/daemonset"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DriverContainerName is the name of the driver container in the driver pods.
+	DriverContainerName = "nvidia-driver-ctr"
+	// GFDDriverVersionLabel is the GPU feature discovery node label holding the full driver version.
+	GFDDriverVersionLabel = "nvidia.com/cuda.driver-version.full"
+
+	gfdDriverMajorLabel    = "nvidia.com/cuda.driver.major"
+	gfdDriverMinorLabel    = "nvidia.com/cuda.driver.minor"
+	gfdDriverRevisionLabel = "nvidia.com/cuda.driver.rev"
+)
+
+// CheckDisjointNodeSelectors returns an error if the node selectors of two NVIDIADrivers can select the same node.
+// Two node selectors are disjoint only when they require different values for the same label.
+func CheckDisjointNodeSelectors(builders ...*NVIDIADriverBuilder) error {
+	for i, builder := range builders {
+		if valid, err := builder.validate(); !valid {
+			return err
+		}
+
+		for _, other := range builders[:i] {
+			if !nodeSelectorsDisjoint(builder.Definition.Spec.NodeSelector, other.Definition.Spec.NodeSelector) {
+				return fmt.Errorf("NVIDIADriver %s node selector %v overlaps NVIDIADriver %s node selector %v",
+					builder.Definition.Name, builder.Definition.Spec.NodeSelector,
+					other.Definition.Name, other.Definition.Spec.NodeSelector)
+			}
+		}
+	}
+
+	return nil
+}
+
+// DriverPods returns the driver pods of the DaemonSets owned by the NVIDIADriver in the operand namespace.
+func (builder *NVIDIADriverBuilder) DriverPods(namespace string) ([]*pod.Builder, error) {
+	return builder.DriverPodsWithContext(context.TODO(), namespace)
+}
+
+// DriverPodsWithContext is the context-aware variant of DriverPods.
+func (builder *NVIDIADriverBuilder) DriverPodsWithContext(
+	ctx context.Context, namespace string) ([]*pod.Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.log().V(logging.LevelDebug).Info("Listing NVIDIADriver pods", "namespace", namespace)
+
+	if !builder.ExistsWithContext(ctx) {
+		return nil, fmt.Errorf("NVIDIADriver object %s doesn't exist", builder.Definition.Name)
+	}
+
+	daemonSetBuilders, err := daemonset.ListWithContext(ctx, builder.apiClient, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DaemonSets in namespace '%s': %w", namespace, err)
+	}
+
+	var driverPods []*pod.Builder
+
+	for _, daemonSetBuilder := range daemonSetBuilders {
+		if !metav1.IsControlledBy(daemonSetBuilder.Object, builder.Object) {
+			continue
+		}
+
+		podBuilders, err := daemonSetBuilder.ListPodsWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		driverPods = append(driverPods, podBuilders...)
+	}
+
+	return driverPods, nil
+}
+
+// NodeDriverVersions returns the driver version of the NVIDIADriver pods, read from their driver image tag and
+// keyed by node name. It returns an error if a node runs more than one driver pod of the NVIDIADriver.
+func (builder *NVIDIADriverBuilder) NodeDriverVersions(namespace string) (map[string]string, error) {
+	return builder.NodeDriverVersionsWithContext(context.TODO(), namespace)
+}
+
+// NodeDriverVersionsWithContext is the context-aware variant of NodeDriverVersions.
+func (builder *NVIDIADriverBuilder) NodeDriverVersionsWithContext(
+	ctx context.Context, namespace string) (map[string]string, error) {
+	driverPods, err := builder.DriverPodsWithContext(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string]string)
+
+	for _, driverPod := range driverPods {
+		nodeName := driverPod.Object.Spec.NodeName
+
+		if _, found := versions[nodeName]; found {
+			return nil, fmt.Errorf("node %s runs more than one driver pod of NVIDIADriver %s",
+				nodeName, builder.Definition.Name)
+		}
+
+		versions[nodeName], err = DriverImageVersion(driverPod.Object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return versions, nil
+}
+
+// DriverImageVersion returns the driver version of the driver container image of the pod. The driver image tags
+// are made of the driver version, or branch for precompiled images, followed by the OS, such as
+// 550.127.08-rhcos4.16.
+func DriverImageVersion(driverPod *corev1.Pod) (string, error) {
+	var image string
+
+	for _, container := range driverPod.Spec.Containers {
+		if container.Name == DriverContainerName {
+			image = container.Image
+
+			break
+		}
+	}
+
+	if image == "" {
+		return "", fmt.Errorf("pod %s has no %s container", driverPod.Name, DriverContainerName)
+	}
+
+	tagIndex := strings.LastIndex(image, ":")
+	if tagIndex < 0 || strings.Contains(image[tagIndex:], "/") || strings.Contains(image, "@") {
+		return "", fmt.Errorf("driver image %s of pod %s has no version tag", image, driverPod.Name)
+	}
+
+	version, _, _ := strings.Cut(image[tagIndex+1:], "-")
+
+	return version, nil
+}
+
+// GFDDriverVersion returns the driver version the GPU feature discovery labeled the node with, or an empty string
+// if the node has no driver version label.
+func GFDDriverVersion(node *corev1.Node) string {
+	if version, found := node.Labels[GFDDriverVersionLabel]; found {
+		return version
+	}
+
+	major, minor, revision := node.Labels[gfdDriverMajorLabel], node.Labels[gfdDriverMinorLabel],
+		node.Labels[gfdDriverRevisionLabel]
+	if major == "" || minor == "" {
+		return ""
+	}
+
+	if revision == "" {
+		return major + "." + minor
+	}
+
+	return major + "." + minor + "." + revision
+}
+
+// DriverVersionMatches returns true if the actual driver version is the expected version or belongs to the
+// expected driver branch, such as 550.127.08 for 550.
+func DriverVersionMatches(expected, actual string) bool {
+	return expected != "" && (actual == expected || strings.HasPrefix(actual, expected+"."))
+}
+
+// nodeSelectorsDisjoint returns true if no node can match both node selectors.
+func nodeSelectorsDisjoint(first, second map[string]string) bool {
+	for key, value := range first {
+		if otherValue, found := second[key]; found && otherValue != value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,102 @@
+package nvidiagpu
+
+import (
+	"testing"
+
+	nvidiagpuv1alpha1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1alpha1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestCheckDisjointNodeSelectors(t *testing.T) {
+	apiClient := clients.NewFakeSettings()
+	poolBuilder := func(name string, nodeSelector map[string]string) *NVIDIADriverBuilder {
+		return NewNVIDIADriverBuilderFromObjectString(apiClient, testALMExamples).
+			WithName(name).
+			WithNodeSelector(nodeSelector)
+	}
+
+	datacenter := poolBuilder("datacenter", map[string]string{"pool": "datacenter", "gpu": "true"})
+	workstation := poolBuilder("workstation", map[string]string{"pool": "workstation"})
+	allGPUs := poolBuilder("all", map[string]string{"gpu": "true"})
+
+	if err := CheckDisjointNodeSelectors(datacenter, workstation); err != nil {
+		t.Errorf("unexpected error for disjoint node selectors: %v", err)
+	}
+
+	if err := CheckDisjointNodeSelectors(datacenter, workstation, allGPUs); err == nil {
+		t.Error("expected error for overlapping node selectors")
+	}
+}
+
+func TestDriverImageVersion(t *testing.T) {
+	testCases := []struct {
+		image    string
+		expected string
+		valid    bool
+	}{
+		{image: "nvcr.io/nvidia/driver:550.127.08-rhcos4.16", expected: "550.127.08", valid: true},
+		{image: "registry.example.com:5000/nvidia/driver:570-5.14.0-427.el9-rhcos4.16", expected: "570", valid: true},
+		{image: "registry.example.com:5000/nvidia/driver"},
+		{image: "nvcr.io/nvidia/driver@sha256:0123456789abcdef"},
+	}
+
+	for _, testCase := range testCases {
+		driverPod := &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "k8s-driver-manager", Image: "nvcr.io/nvidia/cloud-native/k8s-driver-manager:v0.7.0"},
+			{Name: DriverContainerName, Image: testCase.image},
+		}}}
+
+		version, err := DriverImageVersion(driverPod)
+		if testCase.valid != (err == nil) || version != testCase.expected {
+			t.Errorf("image %s: expected version %q, got %q, %v", testCase.image, testCase.expected, version, err)
+		}
+	}
+}
+
+func TestGFDDriverVersion(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+		gfdDriverMajorLabel: "550", gfdDriverMinorLabel: "127", gfdDriverRevisionLabel: "08"}}}
+
+	if version := GFDDriverVersion(node); version != "550.127.08" || !DriverVersionMatches("550", version) {
+		t.Errorf("unexpected GFD driver version %s", version)
+	}
+
+	node.Labels[GFDDriverVersionLabel] = "570.86.15"
+	if version := GFDDriverVersion(node); version != "570.86.15" || DriverVersionMatches("570.86", "570.861.1") {
+		t.Errorf("unexpected GFD driver version %s", version)
+	}
+}
+
+func TestNodeDriverVersions(t *testing.T) {
+	nvidiaDriver := &nvidiagpuv1alpha1.NVIDIADriver{
+		ObjectMeta: metav1.ObjectMeta{Name: "datacenter", UID: "driver-uid"}}
+	driverDaemonSet := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{
+		Name: "nvidia-gpu-driver-rhcos4.16-7c4f8d", Namespace: NvidiaGPUNamespace, UID: "daemonset-uid",
+		OwnerReferences: []metav1.OwnerReference{{APIVersion: "nvidia.com/v1alpha1", Kind: NVIDIADriverKind,
+			Name: nvidiaDriver.Name, UID: nvidiaDriver.UID, Controller: ptr.To(true)}}}}
+
+	driverPod := testOperandPod("nvidia-gpu-driver-rhcos4.16-7c4f8d-abcde", "worker-0", driverDaemonSet, true)
+	driverPod.Spec.Containers = []corev1.Container{
+		{Name: DriverContainerName, Image: "nvcr.io/nvidia/driver:550.127.08-rhcos4.16"}}
+	otherPod := testOperandPod("nvidia-driver-daemonset-abcde", "worker-1",
+		testOperandDaemonSet("nvidia-driver-daemonset", "other-uid", 1), true)
+
+	builder, err := PullNVIDIADriver(clients.NewFakeSettings(nvidiaDriver, driverDaemonSet, driverPod, otherPod),
+		nvidiaDriver.Name)
+	if err != nil {
+		t.Fatalf("unexpected error pulling NVIDIADriver: %v", err)
+	}
+
+	versions, err := builder.NodeDriverVersions(NvidiaGPUNamespace)
+	if err != nil {
+		t.Fatalf("unexpected error getting node driver versions: %v", err)
+	}
+
+	if len(versions) != 1 || versions["worker-0"] != "550.127.08" {
+		t.Errorf("unexpected node driver versions %v", versions)
+	}
+}
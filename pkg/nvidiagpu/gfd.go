@@ -0,0 +1,331 @@
+package nvidiagpu
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiasmi"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// GPUMemoryLabel is the node label GPU feature discovery sets to the memory of the GPUs in MiB.
+	GPUMemoryLabel = "nvidia.com/gpu.memory"
+	// GPUFamilyLabel is the node label GPU feature discovery sets to the architecture family of the GPUs.
+	GPUFamilyLabel = "nvidia.com/gpu.family"
+	// GPUComputeMajorLabel is the node label GPU feature discovery sets to the major compute capability of the GPUs.
+	GPUComputeMajorLabel = "nvidia.com/gpu.compute.major"
+	// GPUComputeMinorLabel is the node label GPU feature discovery sets to the minor compute capability of the GPUs.
+	GPUComputeMinorLabel = "nvidia.com/gpu.compute.minor"
+	// CUDARuntimeMajorLabel is the node label GPU feature discovery sets to the major CUDA version of the driver.
+	CUDARuntimeMajorLabel = "nvidia.com/cuda.runtime-version.major"
+	// CUDARuntimeMinorLabel is the node label GPU feature discovery sets to the minor CUDA version of the driver.
+	CUDARuntimeMinorLabel = "nvidia.com/cuda.runtime-version.minor"
+
+	gfdLabelPrefix          = "nvidia.com/"
+	gfdReplicasLabel        = "nvidia.com/gpu.replicas"
+	gfdSharedProductSuffix  = "-SHARED"
+	gfdLegacyCUDAMajorLabel = "nvidia.com/cuda.runtime.major"
+	gfdLegacyCUDAMinorLabel = "nvidia.com/cuda.runtime.minor"
+	sharedGPUResourceName   = "nvidia.com/gpu.shared"
+)
+
+// GPUArchFamily returns the architecture family GPU feature discovery labels the GPUs of the given compute
+// capability with.
+func GPUArchFamily(computeMajor, computeMinor int) string {
+	switch computeMajor {
+	case 1:
+		return "tesla"
+	case 2:
+		return "fermi"
+	case 3:
+		return "kepler"
+	case 5:
+		return "maxwell"
+	case 6:
+		return "pascal"
+	case 7:
+		if computeMinor < 5 {
+			return "volta"
+		}
+
+		return "turing"
+	case 8:
+		if computeMinor < 9 {
+			return "ampere"
+		}
+
+		return "ada-lovelace"
+	case 9:
+		return "hopper"
+	case 10, 12:
+		return "blackwell"
+	default:
+		return "undefined"
+	}
+}
+
+// GFDLabelMismatch is a GPU feature discovery label inconsistent with the node or the nvidia-smi output.
+type GFDLabelMismatch struct {
+	Node     string
+	Label    string
+	Value    string
+	Expected string
+	// Source is what the label was checked against, such as allocatable or nvidia-smi.
+	Source string
+}
+
+// String returns the mismatch on one line.
+func (mismatch GFDLabelMismatch) String() string {
+	return fmt.Sprintf("node %s label %s is '%s', expected '%s' from %s", mismatch.Node, mismatch.Label,
+		mismatch.Value, mismatch.Expected, mismatch.Source)
+}
+
+// GFDLabelReport is the result of the GPU feature discovery label checks of the GPU nodes.
+type GFDLabelReport struct {
+	// Labels maps the GPU node names to their nvidia.com/ labels.
+	Labels     map[string]map[string]string
+	Mismatches []GFDLabelMismatch
+	// Errors lists the nodes whose nvidia-smi output could not be collected.
+	Errors []string
+}
+
+// Problems returns the label mismatches and the collection errors.
+func (report *GFDLabelReport) Problems() []string {
+	problems := append([]string{}, report.Errors...)
+
+	for _, mismatch := range report.Mismatches {
+		problems = append(problems, mismatch.String())
+	}
+
+	return problems
+}
+
+// String returns the GPU feature discovery labels of every node followed by the problems.
+func (report *GFDLabelReport) String() string {
+	var summary strings.Builder
+
+	nodeNames := make([]string, 0, len(report.Labels))
+	for nodeName := range report.Labels {
+		nodeNames = append(nodeNames, nodeName)
+	}
+
+	sort.Strings(nodeNames)
+
+	fmt.Fprintf(&summary, "GPU feature discovery labels of %d GPU nodes:", len(nodeNames))
+
+	for _, nodeName := range nodeNames {
+		fmt.Fprintf(&summary, "\n  %s: %s", nodeName, labels.Set(report.Labels[nodeName]).String())
+	}
+
+	for _, problem := range report.Problems() {
+		fmt.Fprintf(&summary, "\n  problem: %s", problem)
+	}
+
+	return summary.String()
+}
+
+// GFDLabels returns the nvidia.com/ labels of the node.
+func GFDLabels(node *corev1.Node) map[string]string {
+	gfdLabels := make(map[string]string)
+
+	for key, value := range node.Labels {
+		if strings.HasPrefix(key, gfdLabelPrefix) {
+			gfdLabels[key] = value
+		}
+	}
+
+	return gfdLabels
+}
+
+// CheckGFDLabels returns the GPU feature discovery labels of the node inconsistent with each other or with the
+// node allocatable resources and, when smiLog is not nil, with the nvidia-smi query of the node.
+func CheckGFDLabels(node *corev1.Node, smiLog *nvidiasmi.Log) []GFDLabelMismatch {
+	var mismatches []GFDLabelMismatch
+
+	mismatch := func(label, expected, source string) {
+		if value := node.Labels[label]; value != expected {
+			mismatches = append(mismatches, GFDLabelMismatch{Node: node.Name, Label: label, Value: value,
+				Expected: expected, Source: source})
+		}
+	}
+
+	integer := func(label string) (int64, bool) {
+		value, err := strconv.ParseInt(node.Labels[label], 10, 64)
+		if err != nil {
+			mismatches = append(mismatches, GFDLabelMismatch{Node: node.Name, Label: label,
+				Value: node.Labels[label], Expected: "an integer", Source: "label format"})
+
+			return 0, false
+		}
+
+		return value, true
+	}
+
+	count, countValid := integer(GPUCountLabel)
+	_, _ = integer(GPUMemoryLabel)
+	computeMajor, majorValid := integer(GPUComputeMajorLabel)
+	computeMinor, minorValid := integer(GPUComputeMinorLabel)
+
+	if majorValid && minorValid {
+		mismatch(GPUFamilyLabel, GPUArchFamily(int(computeMajor), int(computeMinor)), "compute capability")
+	}
+
+	driverVersion := GFDDriverVersion(node)
+	if _, found := node.Labels[GFDDriverVersionLabel]; found && node.Labels[gfdDriverMajorLabel] != "" {
+		mismatch(GFDDriverVersionLabel, strings.Join([]string{node.Labels[gfdDriverMajorLabel],
+			node.Labels[gfdDriverMinorLabel], node.Labels[gfdDriverRevisionLabel]}, "."), "driver version labels")
+	}
+
+	cudaMajorLabel, cudaMinorLabel := CUDARuntimeMajorLabel, CUDARuntimeMinorLabel
+	if _, found := node.Labels[cudaMajorLabel]; !found {
+		cudaMajorLabel, cudaMinorLabel = gfdLegacyCUDAMajorLabel, gfdLegacyCUDAMinorLabel
+	}
+
+	mismatch(MIGCapableLabel, strconv.FormatBool(node.Labels[MIGCapableLabel] == "true"), "label format")
+
+	replicas := int64(1)
+	if _, found := node.Labels[gfdReplicasLabel]; found {
+		replicas, _ = integer(gfdReplicasLabel)
+	}
+
+	if countValid && node.Labels[MIGStrategyLabel] != "mixed" {
+		allocatable := node.Status.Allocatable[GPUResourceName]
+		shared := node.Status.Allocatable[sharedGPUResourceName]
+
+		if advertised := allocatable.Value() + shared.Value(); advertised != count*replicas {
+			mismatches = append(mismatches, GFDLabelMismatch{Node: node.Name, Label: GPUCountLabel,
+				Value: node.Labels[GPUCountLabel], Source: "allocatable",
+				Expected: fmt.Sprintf("%d GPUs of %d replicas", advertised, replicas)})
+		}
+	}
+
+	if smiLog == nil || len(smiLog.GPUs) == 0 {
+		return mismatches
+	}
+
+	gpus := smiLog.GPUs
+
+	product := strings.TrimSuffix(node.Labels[GPUProductLabel], gfdSharedProductSuffix)
+	if !strings.Contains(product, "-MIG-") {
+		mismatch(GPUCountLabel, strconv.Itoa(len(gpus)), "nvidia-smi")
+		mismatch(GPUMemoryLabel, strconv.FormatInt(int64(gpus[0].FBMemoryUsage.Total), 10), "nvidia-smi")
+
+		if expected := strings.ReplaceAll(gpus[0].ProductName, " ", "-"); product != expected {
+			mismatches = append(mismatches, GFDLabelMismatch{Node: node.Name, Label: GPUProductLabel,
+				Value: node.Labels[GPUProductLabel], Expected: expected, Source: "nvidia-smi"})
+		}
+	}
+
+	// The nvidia-smi query has no compute capability, the family is checked against the product architecture.
+	architecture := gpus[0].ProductArchitecture
+	if architecture != "" && architecture != nvidiasmi.NotAvailable {
+		mismatch(GPUFamilyLabel, strings.ReplaceAll(strings.ToLower(architecture), " ", "-"), "nvidia-smi")
+	}
+
+	if driverVersion != smiLog.DriverVersion {
+		mismatches = append(mismatches, GFDLabelMismatch{Node: node.Name, Label: GFDDriverVersionLabel,
+			Value: driverVersion, Expected: smiLog.DriverVersion, Source: "nvidia-smi"})
+	}
+
+	if cuda := fmt.Sprintf("%s.%s", node.Labels[cudaMajorLabel], node.Labels[cudaMinorLabel]); smiLog.CUDAVersion !=
+		"" && cuda != smiLog.CUDAVersion {
+		mismatches = append(mismatches, GFDLabelMismatch{Node: node.Name, Label: cudaMajorLabel,
+			Value: cuda, Expected: smiLog.CUDAVersion, Source: "nvidia-smi"})
+	}
+
+	migCapable := false
+
+	for _, gpu := range gpus {
+		if gpu.MIGMode.Current != "" && gpu.MIGMode.Current != nvidiasmi.NotAvailable {
+			migCapable = true
+		}
+	}
+
+	mismatch(MIGCapableLabel, strconv.FormatBool(migCapable), "nvidia-smi")
+
+	return mismatches
+}
+
+// CollectGFDLabelReport returns the GPU feature discovery label checks of the nodes matching the node selector,
+// against the nvidia-smi output collected from the driver pods of the operand namespace.
+func CollectGFDLabelReport(apiClient *clients.Settings, namespace string,
+	nodeSelector map[string]string) (*GFDLabelReport, error) {
+	return CollectGFDLabelReportWithContext(context.TODO(), apiClient, namespace, nodeSelector)
+}
+
+// CollectGFDLabelReportWithContext is the context-aware variant of CollectGFDLabelReport.
+func CollectGFDLabelReportWithContext(ctx context.Context, apiClient *clients.Settings, namespace string,
+	nodeSelector map[string]string) (*GFDLabelReport, error) {
+	logger.V(logging.LevelDebug).Info("Building GPU feature discovery label report", "namespace", namespace,
+		"nodeSelector", nodeSelector)
+
+	nodes, err := apiClient.CoreV1Interface.Nodes().List(ctx,
+		metav1.ListOptions{LabelSelector: labels.Set(nodeSelector).String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	pods, err := apiClient.Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods in namespace '%s': %w", namespace, err)
+	}
+
+	report := &GFDLabelReport{Labels: make(map[string]map[string]string)}
+
+	for _, node := range nodes.Items {
+		report.Labels[node.Name] = GFDLabels(&node)
+
+		var smiLog *nvidiasmi.Log
+
+		driverPod, err := nodeDriverPod(ctx, apiClient, pods.Items, node.Name)
+		if err == nil {
+			smiLog, err = nvidiasmi.QueryWithContext(ctx, driverPod, DriverContainerName)
+		}
+
+		if err != nil {
+			logger.V(logging.LevelDebug).Info("Failed to collect nvidia-smi output", "node", node.Name,
+				"error", err)
+
+			report.Errors = append(report.Errors, fmt.Sprintf("node %s: %v", node.Name, err))
+		}
+
+		report.Mismatches = append(report.Mismatches, CheckGFDLabels(&node, smiLog)...)
+	}
+
+	return report, nil
+}
+
+// nodeDriverPod returns the running driver pod of the node.
+func nodeDriverPod(ctx context.Context, apiClient *clients.Settings, pods []corev1.Pod,
+	nodeName string) (*pod.Builder, error) {
+	for _, candidate := range pods {
+		if candidate.Spec.NodeName != nodeName || candidate.Status.Phase != corev1.PodRunning ||
+			!hasContainer(&candidate, DriverContainerName) {
+			continue
+		}
+
+		return pod.PullWithContext(ctx, apiClient, candidate.Name, candidate.Namespace)
+	}
+
+	return nil, fmt.Errorf("no running driver pod found on node %s", nodeName)
+}
+
+// hasContainer returns true if the pod has a container of the given name.
+func hasContainer(pod *corev1.Pod, containerName string) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == containerName {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,117 @@
+package nvidiagpu
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiasmi"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testSmiLog() *nvidiasmi.Log {
+	gpu := nvidiasmi.GPU{ProductName: "NVIDIA A100-SXM4-80GB", ProductArchitecture: "Ampere",
+		MIGMode: nvidiasmi.MIGMode{Current: "Disabled"}, FBMemoryUsage: nvidiasmi.MemoryUsage{Total: 81920}}
+
+	return &nvidiasmi.Log{DriverVersion: "550.127.08", CUDAVersion: "12.4", AttachedGPUs: 2,
+		GPUs: []nvidiasmi.GPU{gpu, gpu}}
+}
+
+func testGFDNode() *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Labels: map[string]string{
+			"node-role.kubernetes.io/worker": "",
+			GPUProductLabel:                  "NVIDIA-A100-SXM4-80GB",
+			GPUCountLabel:                    "2",
+			GPUMemoryLabel:                   "81920",
+			GPUFamilyLabel:                   "ampere",
+			GPUComputeMajorLabel:             "8",
+			GPUComputeMinorLabel:             "0",
+			GFDDriverVersionLabel:            "550.127.08",
+			gfdDriverMajorLabel:              "550",
+			gfdDriverMinorLabel:              "127",
+			gfdDriverRevisionLabel:           "08",
+			CUDARuntimeMajorLabel:            "12",
+			CUDARuntimeMinorLabel:            "4",
+			MIGCapableLabel:                  "true",
+			MIGStrategyLabel:                 "single",
+		}},
+		Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{GPUResourceName: resource.MustParse("2")}},
+	}
+}
+
+func TestCheckGFDLabels(t *testing.T) {
+	if mismatches := CheckGFDLabels(testGFDNode(), testSmiLog()); len(mismatches) != 0 {
+		t.Errorf("unexpected mismatches %v", mismatches)
+	}
+
+	smiLog := testSmiLog()
+	smiLog.GPUs[0].ProductArchitecture = "Ada Lovelace"
+
+	if mismatches := CheckGFDLabels(testGFDNode(), smiLog); len(mismatches) != 1 ||
+		mismatches[0].Label != GPUFamilyLabel || mismatches[0].Expected != "ada-lovelace" {
+		t.Errorf("expected a family mismatch with the product architecture, got %v", mismatches)
+	}
+
+	testCases := []struct {
+		name   string
+		mutate func(node *corev1.Node)
+		label  string
+	}{
+		{name: "family", label: GPUFamilyLabel, mutate: func(node *corev1.Node) {
+			node.Labels[GPUFamilyLabel] = "hopper"
+		}},
+		{name: "allocatable", label: GPUCountLabel, mutate: func(node *corev1.Node) {
+			node.Status.Allocatable[GPUResourceName] = resource.MustParse("1")
+		}},
+		{name: "product", label: GPUProductLabel, mutate: func(node *corev1.Node) {
+			node.Labels[GPUProductLabel] = "NVIDIA-A100-SXM4-40GB"
+		}},
+		{name: "driver version", label: GFDDriverVersionLabel, mutate: func(node *corev1.Node) {
+			node.Labels[GFDDriverVersionLabel] = "550.127.05"
+		}},
+		{name: "CUDA version", label: CUDARuntimeMajorLabel, mutate: func(node *corev1.Node) {
+			node.Labels[CUDARuntimeMinorLabel] = "2"
+		}},
+		{name: "memory", label: GPUMemoryLabel, mutate: func(node *corev1.Node) {
+			node.Labels[GPUMemoryLabel] = "40960"
+		}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			node := testGFDNode()
+			testCase.mutate(node)
+
+			mismatches := CheckGFDLabels(node, testSmiLog())
+			if len(mismatches) == 0 || mismatches[0].Label != testCase.label {
+				t.Errorf("expected a %s mismatch, got %v", testCase.label, mismatches)
+			}
+		})
+	}
+}
+
+func TestCheckGFDLabelsSharing(t *testing.T) {
+	node := testGFDNode()
+	node.Labels[GPUProductLabel] = "NVIDIA-A100-SXM4-80GB-SHARED"
+	node.Labels[gfdReplicasLabel] = "4"
+	node.Status.Allocatable = corev1.ResourceList{sharedGPUResourceName: resource.MustParse("8")}
+
+	if mismatches := CheckGFDLabels(node, nil); len(mismatches) != 0 {
+		t.Errorf("unexpected mismatches %v", mismatches)
+	}
+
+	node.Labels[GPUCountLabel] = "two"
+	if mismatches := CheckGFDLabels(node, nil); len(mismatches) != 1 {
+		t.Errorf("expected a count format mismatch, got %v", mismatches)
+	}
+}
+
+func TestGPUArchFamily(t *testing.T) {
+	for compute, family := range map[[2]int]string{{7, 0}: "volta", {7, 5}: "turing", {8, 6}: "ampere",
+		{8, 9}: "ada-lovelace", {9, 0}: "hopper", {4, 0}: "undefined"} {
+		if actual := GPUArchFamily(compute[0], compute[1]); actual != family {
+			t.Errorf("compute %d.%d: expected family %s, got %s", compute[0], compute[1], family, actual)
+		}
+	}
+}
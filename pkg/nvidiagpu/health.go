@@ -0,0 +1,411 @@
+package nvidiagpu
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/daemonset"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// OperandDeployLabelPrefix is the prefix of the node labels the GPU operator uses to schedule its operands.
+	OperandDeployLabelPrefix = "nvidia.com/gpu.deploy."
+	// ValidatorOperand is the name of the operand running the operator validations.
+	ValidatorOperand = "operator-validator"
+)
+
+// Operand describes a ClusterPolicy operand deployed by the GPU operator as a DaemonSet.
+type Operand struct {
+	// Name is the operand name used in the gpu.deploy node labels, such as driver or device-plugin.
+	Name string
+	// DaemonSet is the name of the operand DaemonSet, or its prefix when DaemonSetPrefix is true.
+	DaemonSet string
+	// DaemonSetPrefix is true when the DaemonSet name is suffixed, such as the driver one with the OS version.
+	DaemonSetPrefix bool
+}
+
+// DeployLabel returns the node label the GPU operator sets to "true" on the nodes expected to run the operand.
+func (operand Operand) DeployLabel() string {
+	return OperandDeployLabelPrefix + operand.Name
+}
+
+// matches returns true if the DaemonSet name belongs to the operand.
+func (operand Operand) matches(daemonSetName string) bool {
+	if operand.DaemonSetPrefix {
+		return strings.HasPrefix(daemonSetName, operand.DaemonSet)
+	}
+
+	return daemonSetName == operand.DaemonSet
+}
+
+var (
+	driverOperand              = Operand{Name: "driver", DaemonSet: "nvidia-driver-daemonset", DaemonSetPrefix: true}
+	toolkitOperand             = Operand{Name: "container-toolkit", DaemonSet: "nvidia-container-toolkit-daemonset"}
+	devicePluginOperand        = Operand{Name: "device-plugin", DaemonSet: "nvidia-device-plugin-daemonset"}
+	dcgmOperand                = Operand{Name: "dcgm", DaemonSet: "nvidia-dcgm"}
+	dcgmExporterOperand        = Operand{Name: "dcgm-exporter", DaemonSet: "nvidia-dcgm-exporter"}
+	gpuFeatureDiscoveryOperand = Operand{Name: "gpu-feature-discovery", DaemonSet: "gpu-feature-discovery"}
+	migManagerOperand          = Operand{Name: "mig-manager", DaemonSet: "nvidia-mig-manager"}
+	nodeStatusExporterOperand  = Operand{Name: "node-status-exporter", DaemonSet: "nvidia-node-status-exporter"}
+	validatorOperand           = Operand{Name: ValidatorOperand, DaemonSet: "nvidia-operator-validator"}
+
+	// allOperands lists every operand this package knows about, enabled or not.
+	allOperands = []Operand{driverOperand, toolkitOperand, devicePluginOperand, dcgmOperand, dcgmExporterOperand,
+		gpuFeatureDiscoveryOperand, migManagerOperand, nodeStatusExporterOperand, validatorOperand}
+)
+
+// ExpectedOperands returns the operands the GPU operator deploys for the given ClusterPolicy spec, following the
+// defaults of the ClusterPolicy API for the unset enabled fields. The driver is not expected when it is managed
+// through NVIDIADriver objects.
+func ExpectedOperands(spec *nvidiagpuv1.ClusterPolicySpec) []Operand {
+	var operands []Operand
+
+	if spec.Driver.IsEnabled() && !spec.Driver.UseNvdiaDriverCRDType() {
+		operands = append(operands, driverOperand)
+	}
+
+	enabled := []struct {
+		operand Operand
+		enabled bool
+	}{
+		{toolkitOperand, spec.Toolkit.IsEnabled()},
+		{devicePluginOperand, spec.DevicePlugin.IsEnabled()},
+		{dcgmOperand, spec.DCGM.IsEnabled()},
+		{dcgmExporterOperand, spec.DCGMExporter.IsEnabled()},
+		{gpuFeatureDiscoveryOperand, spec.GPUFeatureDiscovery.IsEnabled()},
+		{migManagerOperand, spec.MIGManager.IsEnabled()},
+		{nodeStatusExporterOperand, spec.NodeStatusExporter.IsEnabled()},
+		{validatorOperand, true},
+	}
+
+	for _, candidate := range enabled {
+		if candidate.enabled {
+			operands = append(operands, candidate.operand)
+		}
+	}
+
+	return operands
+}
+
+// OperandHealth is the health of a single operand.
+type OperandHealth struct {
+	Operand
+	// DaemonSets lists the names of the operand DaemonSets found in the operator namespace.
+	DaemonSets []string
+	Desired    int32
+	Updated    int32
+	Available  int32
+	// ExpectedNodes lists the nodes carrying the operand deploy label.
+	ExpectedNodes []string
+	// MissingNodes lists the expected nodes without an available operand pod.
+	MissingNodes []string
+	// Images maps the container names of the operand DaemonSets to their images.
+	Images map[string]string
+	// Problems lists why the operand is not healthy.
+	Problems []string
+}
+
+// Healthy returns true if no problem was found for the operand.
+func (operandHealth *OperandHealth) Healthy() bool {
+	return len(operandHealth.Problems) == 0
+}
+
+// ValidationResult is the result of a single validation of an operator validator pod.
+type ValidationResult struct {
+	Node       string
+	Pod        string
+	Validation string
+	Passed     bool
+	Message    string
+}
+
+// HealthReport is the per-operand health of a ClusterPolicy.
+type HealthReport struct {
+	ClusterPolicy string
+	State         string
+	Namespace     string
+	Operands      []OperandHealth
+	Validations   []ValidationResult
+}
+
+// Healthy returns true if every expected operand is healthy and every validation passed.
+func (report *HealthReport) Healthy() bool {
+	return len(report.Problems()) == 0
+}
+
+// Problems returns the problems of every operand and the failed validations.
+func (report *HealthReport) Problems() []string {
+	var problems []string
+
+	for _, operandHealth := range report.Operands {
+		for _, problem := range operandHealth.Problems {
+			problems = append(problems, fmt.Sprintf("%s: %s", operandHealth.Name, problem))
+		}
+	}
+
+	for _, validation := range report.Validations {
+		if !validation.Passed {
+			problems = append(problems, fmt.Sprintf("%s: %s on node %s did not pass: %s", ValidatorOperand,
+				validation.Validation, validation.Node, validation.Message))
+		}
+	}
+
+	return problems
+}
+
+// String returns a one line summary per operand followed by the problems.
+func (report *HealthReport) String() string {
+	var summary strings.Builder
+
+	fmt.Fprintf(&summary, "ClusterPolicy %s in state '%s', operands in namespace '%s':", report.ClusterPolicy,
+		report.State, report.Namespace)
+
+	for _, operandHealth := range report.Operands {
+		fmt.Fprintf(&summary, "\n  %s: %d/%d available, %d updated, %d/%d nodes covered", operandHealth.Name,
+			operandHealth.Available, operandHealth.Desired, operandHealth.Updated,
+			len(operandHealth.ExpectedNodes)-len(operandHealth.MissingNodes), len(operandHealth.ExpectedNodes))
+	}
+
+	for _, problem := range report.Problems() {
+		fmt.Fprintf(&summary, "\n  problem: %s", problem)
+	}
+
+	return summary.String()
+}
+
+// HealthReport returns the health of the operands expected from the ClusterPolicy spec: the readiness of their
+// DaemonSets, their coverage of the nodes labeled for them, their images and the operator validator results.
+func (builder *Builder) HealthReport() (*HealthReport, error) {
+	return builder.HealthReportWithContext(context.TODO())
+}
+
+// HealthReportWithContext is the context-aware variant of HealthReport.
+func (builder *Builder) HealthReportWithContext(ctx context.Context) (*HealthReport, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if !builder.ExistsWithContext(ctx) {
+		return nil, fmt.Errorf("ClusterPolicy object %s doesn't exist", builder.Definition.Name)
+	}
+
+	report := &HealthReport{
+		ClusterPolicy: builder.Object.Name,
+		State:         string(builder.Object.Status.State),
+		Namespace:     builder.Object.Status.Namespace,
+	}
+
+	if report.Namespace == "" {
+		report.Namespace = NvidiaGPUNamespace
+	}
+
+	builder.log().V(logging.LevelDebug).Info("Building ClusterPolicy health report", "namespace", report.Namespace)
+
+	daemonSetBuilders, err := daemonset.ListWithContext(ctx, builder.apiClient, report.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DaemonSets in namespace '%s': %w", report.Namespace, err)
+	}
+
+	pods, err := builder.apiClient.Pods(report.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods in namespace '%s': %w", report.Namespace, err)
+	}
+
+	nodes, err := builder.apiClient.CoreV1Interface.Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	for _, operand := range ExpectedOperands(&builder.Object.Spec) {
+		var operandDaemonSets []*appsv1.DaemonSet
+
+		for _, daemonSetBuilder := range daemonSetBuilders {
+			if operand.matches(daemonSetBuilder.Object.Name) {
+				operandDaemonSets = append(operandDaemonSets, daemonSetBuilder.Object)
+			}
+		}
+
+		report.Operands = append(report.Operands, operandHealth(operand, operandDaemonSets, pods.Items, nodes.Items))
+
+		if operand.Name == ValidatorOperand {
+			report.Validations = validationResults(ownedPods(operandDaemonSets, pods.Items))
+		}
+	}
+
+	return report, nil
+}
+
+// RemainingOperands returns the operand DaemonSets and the pods which are not part of the operator Deployment
+// still present in the operator namespace, for instance after the ClusterPolicy was deleted.
+func RemainingOperands(ctx context.Context, apiClient *clients.Settings, namespace string) ([]string, error) {
+	daemonSetBuilders, err := daemonset.ListWithContext(ctx, apiClient, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DaemonSets in namespace '%s': %w", namespace, err)
+	}
+
+	var remaining []string
+
+	for _, daemonSetBuilder := range daemonSetBuilders {
+		for _, operand := range allOperands {
+			if operand.matches(daemonSetBuilder.Object.Name) {
+				remaining = append(remaining, "daemonset/"+daemonSetBuilder.Object.Name)
+
+				break
+			}
+		}
+	}
+
+	pods, err := apiClient.Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods in namespace '%s': %w", namespace, err)
+	}
+
+	for _, pod := range pods.Items {
+		if controller := metav1.GetControllerOf(&pod); controller == nil || controller.Kind != "ReplicaSet" {
+			remaining = append(remaining, "pod/"+pod.Name)
+		}
+	}
+
+	return remaining, nil
+}
+
+func operandHealth(operand Operand, daemonSets []*appsv1.DaemonSet, pods []corev1.Pod,
+	nodes []corev1.Node) OperandHealth {
+	health := OperandHealth{Operand: operand, Images: map[string]string{}}
+
+	if len(daemonSets) == 0 {
+		health.Problems = append(health.Problems, fmt.Sprintf("no DaemonSet %s found", operand.DaemonSet))
+	}
+
+	for _, daemonSet := range daemonSets {
+		status := daemonSet.Status
+		health.DaemonSets = append(health.DaemonSets, daemonSet.Name)
+		health.Desired += status.DesiredNumberScheduled
+		health.Updated += status.UpdatedNumberScheduled
+		health.Available += status.NumberAvailable
+
+		if status.ObservedGeneration < daemonSet.Generation || status.UpdatedNumberScheduled <
+			status.DesiredNumberScheduled || status.NumberAvailable < status.DesiredNumberScheduled {
+			health.Problems = append(health.Problems, fmt.Sprintf(
+				"DaemonSet %s not rolled out: generation %d observed %d, %d desired, %d updated, %d available",
+				daemonSet.Name, daemonSet.Generation, status.ObservedGeneration, status.DesiredNumberScheduled,
+				status.UpdatedNumberScheduled, status.NumberAvailable))
+		}
+
+		for _, container := range daemonSet.Spec.Template.Spec.Containers {
+			health.Images[container.Name] = container.Image
+		}
+	}
+
+	coveredNodes := map[string]bool{}
+
+	for _, pod := range ownedPods(daemonSets, pods) {
+		if isPodAvailable(&pod) {
+			coveredNodes[pod.Spec.NodeName] = true
+		}
+	}
+
+	for _, node := range nodes {
+		if node.Labels[operand.DeployLabel()] != "true" {
+			continue
+		}
+
+		health.ExpectedNodes = append(health.ExpectedNodes, node.Name)
+
+		if !coveredNodes[node.Name] {
+			health.MissingNodes = append(health.MissingNodes, node.Name)
+		}
+	}
+
+	sort.Strings(health.ExpectedNodes)
+	sort.Strings(health.MissingNodes)
+
+	if len(health.MissingNodes) > 0 {
+		health.Problems = append(health.Problems, fmt.Sprintf("no available pod on nodes %v", health.MissingNodes))
+	}
+
+	return health
+}
+
+// validationResults returns the result of every init container of the validator pods, followed by the readiness
+// of their main container.
+func validationResults(validatorPods []corev1.Pod) []ValidationResult {
+	var results []ValidationResult
+
+	sort.Slice(validatorPods, func(i, j int) bool {
+		return validatorPods[i].Spec.NodeName < validatorPods[j].Spec.NodeName
+	})
+
+	for _, pod := range validatorPods {
+		for _, containerStatus := range pod.Status.InitContainerStatuses {
+			result := ValidationResult{Node: pod.Spec.NodeName, Pod: pod.Name, Validation: containerStatus.Name}
+
+			switch terminated := containerStatus.State.Terminated; {
+			case terminated != nil && terminated.ExitCode == 0:
+				result.Passed = true
+			case terminated != nil:
+				result.Message = fmt.Sprintf("terminated with reason %s and exit code %d", terminated.Reason,
+					terminated.ExitCode)
+			case containerStatus.State.Waiting != nil:
+				result.Message = fmt.Sprintf("waiting: %s", containerStatus.State.Waiting.Reason)
+			default:
+				result.Message = "still running"
+			}
+
+			results = append(results, result)
+		}
+
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			result := ValidationResult{Node: pod.Spec.NodeName, Pod: pod.Name, Validation: containerStatus.Name,
+				Passed: containerStatus.Ready}
+
+			if !containerStatus.Ready {
+				result.Message = "container is not ready"
+			}
+
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
+
+// ownedPods returns the pods controlled by one of the given DaemonSets.
+func ownedPods(daemonSets []*appsv1.DaemonSet, pods []corev1.Pod) []corev1.Pod {
+	var owned []corev1.Pod
+
+	for _, pod := range pods {
+		for _, daemonSet := range daemonSets {
+			if metav1.IsControlledBy(&pod, daemonSet) {
+				owned = append(owned, pod)
+
+				break
+			}
+		}
+	}
+
+	return owned
+}
+
+// isPodAvailable returns true if the pod is running and its Ready condition is true.
+func isPodAvailable(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning {
+		return false
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,184 @@
+package nvidiagpu
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func operandNames(operands []Operand) []string {
+	var names []string
+	for _, operand := range operands {
+		names = append(names, operand.Name)
+	}
+
+	return names
+}
+
+func TestExpectedOperands(t *testing.T) {
+	defaults := operandNames(ExpectedOperands(&nvidiagpuv1.ClusterPolicySpec{}))
+	expectedDefaults := "driver,container-toolkit,device-plugin,dcgm,dcgm-exporter,gpu-feature-discovery," +
+		"mig-manager,operator-validator"
+
+	if strings.Join(defaults, ",") != expectedDefaults {
+		t.Errorf("expected default operands %s, got %v", expectedDefaults, defaults)
+	}
+
+	spec := &nvidiagpuv1.ClusterPolicySpec{}
+	spec.Driver.UseNvidiaDriverCRD = ptr.To(true)
+	spec.DCGM.Enabled = ptr.To(false)
+	spec.MIGManager.Enabled = ptr.To(false)
+	spec.NodeStatusExporter.Enabled = ptr.To(true)
+
+	toggled := strings.Join(operandNames(ExpectedOperands(spec)), ",")
+	expectedToggled := "container-toolkit,device-plugin,dcgm-exporter,gpu-feature-discovery,node-status-exporter," +
+		"operator-validator"
+
+	if toggled != expectedToggled {
+		t.Errorf("expected operands %s, got %s", expectedToggled, toggled)
+	}
+}
+
+func testOperandDaemonSet(name string, uid types.UID, available int32) *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: NvidiaGPUNamespace, UID: uid, Generation: 1},
+		Spec: appsv1.DaemonSetSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: name, Image: "nvcr.io/nvidia/" + name + ":v1"}},
+		}}},
+		Status: appsv1.DaemonSetStatus{ObservedGeneration: 1, DesiredNumberScheduled: 2,
+			UpdatedNumberScheduled: 2, NumberAvailable: available},
+	}
+}
+
+func testOperandPod(name, nodeName string, owner *appsv1.DaemonSet, ready bool) *corev1.Pod {
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: NvidiaGPUNamespace,
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: owner.Name,
+				UID: owner.UID, Controller: ptr.To(true)}}},
+		Spec: corev1.PodSpec{NodeName: nodeName},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: readyStatus}},
+		},
+	}
+}
+
+func testGPUNode(name string) *corev1.Node {
+	return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{
+		driverOperand.DeployLabel():       "true",
+		devicePluginOperand.DeployLabel(): "true",
+		validatorOperand.DeployLabel():    "true",
+	}}}
+}
+
+func TestHealthReport(t *testing.T) {
+	driver := testOperandDaemonSet("nvidia-driver-daemonset-418.94.202501221327-0", "driver-uid", 2)
+	devicePlugin := testOperandDaemonSet("nvidia-device-plugin-daemonset", "device-plugin-uid", 1)
+	validator := testOperandDaemonSet("nvidia-operator-validator", "validator-uid", 2)
+
+	validatorPod := testOperandPod("nvidia-operator-validator-abcde", "worker-0", validator, true)
+	validatorPod.Status.InitContainerStatuses = []corev1.ContainerStatus{
+		{Name: "driver-validation", State: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}},
+		{Name: "cuda-validation", State: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}}},
+	}
+
+	objects := []runtime.Object{
+		&nvidiagpuv1.ClusterPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterPolicyName},
+			Spec: nvidiagpuv1.ClusterPolicySpec{
+				Toolkit:             nvidiagpuv1.ToolkitSpec{Enabled: ptr.To(false)},
+				DCGM:                nvidiagpuv1.DCGMSpec{Enabled: ptr.To(false)},
+				DCGMExporter:        nvidiagpuv1.DCGMExporterSpec{Enabled: ptr.To(false)},
+				GPUFeatureDiscovery: nvidiagpuv1.GPUFeatureDiscoverySpec{Enabled: ptr.To(false)},
+				MIGManager:          nvidiagpuv1.MIGManagerSpec{Enabled: ptr.To(false)},
+			},
+			Status: nvidiagpuv1.ClusterPolicyStatus{State: "notReady", Namespace: NvidiaGPUNamespace},
+		},
+		driver, devicePlugin, validator,
+		testOperandPod("nvidia-driver-daemonset-a", "worker-0", driver, true),
+		testOperandPod("nvidia-driver-daemonset-b", "worker-1", driver, true),
+		testOperandPod("nvidia-device-plugin-daemonset-a", "worker-0", devicePlugin, true),
+		testOperandPod("nvidia-device-plugin-daemonset-b", "worker-1", devicePlugin, false),
+		validatorPod,
+		testOperandPod("nvidia-operator-validator-fghij", "worker-1", validator, true),
+		testGPUNode("worker-0"), testGPUNode("worker-1"),
+	}
+
+	builder, err := Pull(clients.NewFakeSettings(objects...), ClusterPolicyName)
+	if err != nil {
+		t.Fatalf("unexpected error pulling ClusterPolicy: %v", err)
+	}
+
+	report, err := builder.HealthReportWithContext(context.TODO())
+	if err != nil {
+		t.Fatalf("unexpected error building health report: %v", err)
+	}
+
+	if len(report.Operands) != 3 {
+		t.Fatalf("expected 3 operands, got %v", report.Operands)
+	}
+
+	if !report.Operands[0].Healthy() {
+		t.Errorf("expected driver to be healthy, got problems %v", report.Operands[0].Problems)
+	}
+
+	if image := report.Operands[0].Images[driver.Name]; image != "nvcr.io/nvidia/"+driver.Name+":v1" {
+		t.Errorf("unexpected driver image %s", image)
+	}
+
+	devicePluginHealth := report.Operands[1]
+	if devicePluginHealth.Healthy() || len(devicePluginHealth.MissingNodes) != 1 ||
+		devicePluginHealth.MissingNodes[0] != "worker-1" {
+		t.Errorf("expected device-plugin to miss worker-1, got %v", devicePluginHealth.Problems)
+	}
+
+	problems := strings.Join(report.Problems(), "\n")
+	if !strings.Contains(problems, "cuda-validation on node worker-0 did not pass") {
+		t.Errorf("expected failed cuda-validation in problems, got %s", problems)
+	}
+
+	if report.Healthy() {
+		t.Error("expected report to be unhealthy")
+	}
+}
+
+func TestRemainingOperands(t *testing.T) {
+	driver := testOperandDaemonSet("nvidia-driver-daemonset-418.94.202501221327-0", "driver-uid", 2)
+	operatorPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "gpu-operator-abcde", Namespace: NvidiaGPUNamespace,
+		OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "gpu-operator-1",
+			UID: "replicaset-uid", Controller: ptr.To(true)}}}}
+
+	apiClient := clients.NewFakeSettings(driver, operatorPod,
+		testOperandPod("nvidia-driver-daemonset-a", "worker-0", driver, true))
+
+	remaining, err := RemainingOperands(context.TODO(), apiClient, NvidiaGPUNamespace)
+	if err != nil {
+		t.Fatalf("unexpected error listing remaining operands: %v", err)
+	}
+
+	expected := "daemonset/" + driver.Name + ",pod/nvidia-driver-daemonset-a"
+	if strings.Join(remaining, ",") != expected {
+		t.Errorf("expected remaining operands %s, got %v", expected, remaining)
+	}
+
+	remaining, err = RemainingOperands(context.TODO(), clients.NewFakeSettings(operatorPod), NvidiaGPUNamespace)
+	if err != nil || len(remaining) != 0 {
+		t.Errorf("expected no remaining operand, got %v, %v", remaining, err)
+	}
+}
@@ -0,0 +1,148 @@
+package nvidiagpu
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiasmi"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// MIGCapableLabel is the node label GPU feature discovery sets to "true" on the nodes with MIG capable GPUs.
+	MIGCapableLabel = "nvidia.com/mig.capable"
+	// MIGConfigLabel is the node label selecting the mig-parted configuration the mig-manager applies.
+	MIGConfigLabel = "nvidia.com/mig.config"
+	// MIGConfigStateLabel is the node label the mig-manager sets to the state of the MIG configuration.
+	MIGConfigStateLabel = "nvidia.com/mig.config.state"
+	// MIGStrategyLabel is the node label GPU feature discovery sets to the MIG strategy of the ClusterPolicy.
+	MIGStrategyLabel = "nvidia.com/mig.strategy"
+	// GPUResourceName is the extended resource of the full GPUs, and of the MIG devices with the single strategy.
+	GPUResourceName corev1.ResourceName = "nvidia.com/gpu"
+	// GPUCountLabel is the node label GPU feature discovery sets to the number of GPUs, or of MIG devices with the
+	// single strategy.
+	GPUCountLabel = "nvidia.com/gpu.count"
+	// GPUProductLabel is the node label GPU feature discovery sets to the GPU product name.
+	GPUProductLabel = "nvidia.com/gpu.product"
+
+	// MIGConfigStateSuccess is the state of a MIG configuration the mig-manager applied.
+	MIGConfigStateSuccess = "success"
+	// MIGConfigStateFailed is the state of a MIG configuration the mig-manager failed to apply.
+	MIGConfigStateFailed = "failed"
+	// MIGConfigAllDisabled is the mig-parted configuration disabling MIG on all GPUs.
+	MIGConfigAllDisabled = "all-disabled"
+
+	migResourcePrefix  = "nvidia.com/mig-"
+	allMIGConfigPrefix = "all-"
+)
+
+// migSliceRegex matches the MIG device profiles, such as 1g.10gb or 1g.10gb+me, capturing their memory in GB.
+var migSliceRegex = regexp.MustCompile(`^[1-7]g\.(\d+)gb(\+me)?$`)
+
+// MIGSlice returns the MIG device profile of a mig-parted configuration partitioning all GPUs alike, such as
+// 1g.10gb for all-1g.10gb.
+func MIGSlice(migConfig string) (string, error) {
+	slice, found := strings.CutPrefix(migConfig, allMIGConfigPrefix)
+	if !found || !migSliceRegex.MatchString(slice) {
+		return "", fmt.Errorf("MIG config '%s' does not partition all GPUs with a single MIG profile, "+
+			"such as all-1g.10gb", migConfig)
+	}
+
+	return slice, nil
+}
+
+// MIGResourceName returns the extended resource the device plugin advertises the MIG devices of the given
+// profile as, following the MIG strategy.
+func MIGResourceName(strategy nvidiagpuv1.MIGStrategy, slice string) (corev1.ResourceName, error) {
+	switch strategy {
+	case nvidiagpuv1.MIGStrategySingle:
+		return GPUResourceName, nil
+	case nvidiagpuv1.MIGStrategyMixed:
+		return corev1.ResourceName(migResourcePrefix + slice), nil
+	default:
+		return "", fmt.Errorf("MIG strategy '%s' does not advertise MIG devices", strategy)
+	}
+}
+
+// CheckNodeMIGConfig returns an error if the node does not advertise the MIG devices of the mig-parted configuration
+// applied with the given MIG strategy. The number of MIG devices advertised by the device plugin must match the
+// count GPU feature discovery labeled the node with.
+func CheckNodeMIGConfig(node *corev1.Node, strategy nvidiagpuv1.MIGStrategy, migConfig string) error {
+	slice, err := MIGSlice(migConfig)
+	if err != nil {
+		return err
+	}
+
+	resourceName, err := MIGResourceName(strategy, slice)
+	if err != nil {
+		return err
+	}
+
+	if config := node.Labels[MIGConfigLabel]; config != migConfig {
+		return fmt.Errorf("node %s label %s is '%s' instead of '%s'", node.Name, MIGConfigLabel, config, migConfig)
+	}
+
+	if state := node.Labels[MIGConfigStateLabel]; state != MIGConfigStateSuccess {
+		return fmt.Errorf("node %s MIG config state is '%s'", node.Name, state)
+	}
+
+	if nodeStrategy := node.Labels[MIGStrategyLabel]; nodeStrategy != string(strategy) {
+		return fmt.Errorf("node %s MIG strategy is '%s' instead of '%s'", node.Name, nodeStrategy, strategy)
+	}
+
+	if strategy == nvidiagpuv1.MIGStrategySingle && !strings.HasSuffix(node.Labels[GPUProductLabel], "-MIG-"+slice) {
+		return fmt.Errorf("node %s GPU product '%s' is not a %s MIG device", node.Name, node.Labels[GPUProductLabel],
+			slice)
+	}
+
+	allocatable := node.Status.Allocatable[resourceName]
+	if allocatable.Value() == 0 {
+		return fmt.Errorf("node %s does not advertise %s resources", node.Name, resourceName)
+	}
+
+	countLabel := GPUCountLabel
+	if strategy == nvidiagpuv1.MIGStrategyMixed {
+		countLabel = migResourcePrefix + slice + ".count"
+	}
+
+	count, err := strconv.ParseInt(node.Labels[countLabel], 10, 64)
+	if err != nil {
+		return fmt.Errorf("node %s has no valid %s label: %w", node.Name, countLabel, err)
+	}
+
+	if allocatable.Value() != count {
+		return fmt.Errorf("node %s advertises %d %s resources instead of %d", node.Name, allocatable.Value(),
+			resourceName, count)
+	}
+
+	return nil
+}
+
+// CheckMIGDevice returns an error unless the nvidia-smi query of a workload lists exactly one MIG device, with at
+// most the memory of the given profile, such as 10 GB for 1g.10gb.
+func CheckMIGDevice(smiLog *nvidiasmi.Log, slice string) error {
+	match := migSliceRegex.FindStringSubmatch(slice)
+	if match == nil {
+		return fmt.Errorf("invalid MIG profile '%s'", slice)
+	}
+
+	if count := smiLog.MIGDeviceCount(); count != 1 {
+		return fmt.Errorf("nvidia-smi lists %d MIG devices instead of 1", count)
+	}
+
+	memoryGB, _ := strconv.ParseInt(match[1], 10, 64)
+
+	for _, gpu := range smiLog.GPUs {
+		for _, migDevice := range gpu.MIGDevices {
+			if total := int64(migDevice.FBMemoryUsage.Total); total > memoryGB*1024 {
+				return fmt.Errorf("MIG device %d has %d MiB of memory, more than a %s MIG device", migDevice.Index,
+					total, slice)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package nvidiagpu
+
+import (
+	"testing"
+
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiasmi"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testMIGNode(strategy nvidiagpuv1.MIGStrategy, labels map[string]string,
+	allocatable corev1.ResourceList) *corev1.Node {
+	nodeLabels := map[string]string{
+		MIGCapableLabel:     "true",
+		MIGConfigLabel:      "all-1g.10gb",
+		MIGConfigStateLabel: MIGConfigStateSuccess,
+		MIGStrategyLabel:    string(strategy),
+	}
+
+	for key, value := range labels {
+		nodeLabels[key] = value
+	}
+
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Labels: nodeLabels},
+		Status:     corev1.NodeStatus{Allocatable: allocatable},
+	}
+}
+
+func TestMIGSlice(t *testing.T) {
+	testCases := []struct {
+		migConfig string
+		expected  string
+		valid     bool
+	}{
+		{migConfig: "all-1g.10gb", expected: "1g.10gb", valid: true},
+		{migConfig: "all-1g.10gb+me", expected: "1g.10gb+me", valid: true},
+		{migConfig: "all-7g.80gb", expected: "7g.80gb", valid: true},
+		{migConfig: "all-disabled"},
+		{migConfig: "all-balanced"},
+		{migConfig: "1g.10gb"},
+	}
+
+	for _, testCase := range testCases {
+		slice, err := MIGSlice(testCase.migConfig)
+		if testCase.valid != (err == nil) || slice != testCase.expected {
+			t.Errorf("MIG config %s: expected slice %q, got %q, %v", testCase.migConfig, testCase.expected, slice, err)
+		}
+	}
+}
+
+func TestMIGResourceName(t *testing.T) {
+	if name, err := MIGResourceName(nvidiagpuv1.MIGStrategySingle, "1g.10gb"); err != nil || name != GPUResourceName {
+		t.Errorf("unexpected single strategy resource name %s, %v", name, err)
+	}
+
+	if name, err := MIGResourceName(nvidiagpuv1.MIGStrategyMixed, "1g.10gb"); err != nil ||
+		name != "nvidia.com/mig-1g.10gb" {
+		t.Errorf("unexpected mixed strategy resource name %s, %v", name, err)
+	}
+
+	if _, err := MIGResourceName(nvidiagpuv1.MIGStrategyNone, "1g.10gb"); err == nil {
+		t.Error("expected error for MIG strategy none")
+	}
+}
+
+func TestCheckNodeMIGConfig(t *testing.T) {
+	testCases := []struct {
+		name     string
+		strategy nvidiagpuv1.MIGStrategy
+		node     *corev1.Node
+		valid    bool
+	}{
+		{
+			name:     "single strategy",
+			strategy: nvidiagpuv1.MIGStrategySingle,
+			node: testMIGNode(nvidiagpuv1.MIGStrategySingle,
+				map[string]string{GPUProductLabel: "NVIDIA-A100-SXM4-80GB-MIG-1g.10gb", GPUCountLabel: "7"},
+				corev1.ResourceList{GPUResourceName: resource.MustParse("7")}),
+			valid: true,
+		},
+		{
+			name:     "mixed strategy",
+			strategy: nvidiagpuv1.MIGStrategyMixed,
+			node: testMIGNode(nvidiagpuv1.MIGStrategyMixed,
+				map[string]string{GPUProductLabel: "NVIDIA-A100-SXM4-80GB", "nvidia.com/mig-1g.10gb.count": "14"},
+				corev1.ResourceList{"nvidia.com/mig-1g.10gb": resource.MustParse("14")}),
+			valid: true,
+		},
+		{
+			name:     "pending config",
+			strategy: nvidiagpuv1.MIGStrategySingle,
+			node: testMIGNode(nvidiagpuv1.MIGStrategySingle,
+				map[string]string{MIGConfigStateLabel: "pending", GPUCountLabel: "7",
+					GPUProductLabel: "NVIDIA-A100-SXM4-80GB-MIG-1g.10gb"},
+				corev1.ResourceList{GPUResourceName: resource.MustParse("7")}),
+		},
+		{
+			name:     "strategy mismatch",
+			strategy: nvidiagpuv1.MIGStrategyMixed,
+			node: testMIGNode(nvidiagpuv1.MIGStrategySingle,
+				map[string]string{"nvidia.com/mig-1g.10gb.count": "7"},
+				corev1.ResourceList{"nvidia.com/mig-1g.10gb": resource.MustParse("7")}),
+		},
+		{
+			name:     "full GPU product",
+			strategy: nvidiagpuv1.MIGStrategySingle,
+			node: testMIGNode(nvidiagpuv1.MIGStrategySingle,
+				map[string]string{GPUProductLabel: "NVIDIA-A100-SXM4-80GB", GPUCountLabel: "1"},
+				corev1.ResourceList{GPUResourceName: resource.MustParse("1")}),
+		},
+		{
+			name:     "count mismatch",
+			strategy: nvidiagpuv1.MIGStrategyMixed,
+			node: testMIGNode(nvidiagpuv1.MIGStrategyMixed,
+				map[string]string{"nvidia.com/mig-1g.10gb.count": "14"},
+				corev1.ResourceList{"nvidia.com/mig-1g.10gb": resource.MustParse("7")}),
+		},
+		{
+			name:     "no resources",
+			strategy: nvidiagpuv1.MIGStrategyMixed,
+			node: testMIGNode(nvidiagpuv1.MIGStrategyMixed,
+				map[string]string{"nvidia.com/mig-1g.10gb.count": "14"}, nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := CheckNodeMIGConfig(testCase.node, testCase.strategy, "all-1g.10gb")
+			if testCase.valid != (err == nil) {
+				t.Errorf("expected valid %t, got error %v", testCase.valid, err)
+			}
+		})
+	}
+}
+
+func TestCheckMIGDevice(t *testing.T) {
+	migDevice := func(index int, memory nvidiasmi.MiB) nvidiasmi.MIGDevice {
+		return nvidiasmi.MIGDevice{Index: index, FBMemoryUsage: nvidiasmi.MemoryUsage{Total: memory}}
+	}
+
+	testCases := []struct {
+		name       string
+		migDevices []nvidiasmi.MIGDevice
+		slice      string
+		valid      bool
+	}{
+		{name: "one device", migDevices: []nvidiasmi.MIGDevice{migDevice(0, 9984)}, slice: "1g.10gb", valid: true},
+		{name: "no device", slice: "1g.10gb"},
+		{name: "two devices", migDevices: []nvidiasmi.MIGDevice{migDevice(0, 9984), migDevice(1, 9984)},
+			slice: "1g.10gb"},
+		{name: "larger device", migDevices: []nvidiasmi.MIGDevice{migDevice(0, 19968)}, slice: "1g.10gb"},
+		{name: "invalid profile", migDevices: []nvidiasmi.MIGDevice{migDevice(0, 9984)}, slice: "1g"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			smiLog := &nvidiasmi.Log{GPUs: []nvidiasmi.GPU{{MIGDevices: testCase.migDevices}}}
+
+			err := CheckMIGDevice(smiLog, testCase.slice)
+			if testCase.valid != (err == nil) {
+				t.Errorf("expected valid %t, got error %v", testCase.valid, err)
+			}
+		})
+	}
+}
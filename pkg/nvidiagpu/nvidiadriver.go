@@ -0,0 +1,396 @@
+package nvidiagpu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	nvidiagpuv1alpha1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/msg"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/olm"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sjson "k8s.io/apimachinery/pkg/util/json"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NVIDIADriverKind is the kind of the NVIDIADriver custom resource in the GPU operator alm-examples.
+const NVIDIADriverKind = "NVIDIADriver"
+
+// NVIDIADriverBuilder provides a struct for NVIDIADriver object
+// from the cluster and a NVIDIADriver definition.
+type NVIDIADriverBuilder struct {
+	// NVIDIADriverBuilder definition. Used to create
+	// NVIDIADriverBuilder object with minimum set of required elements.
+	Definition *nvidiagpuv1alpha1.NVIDIADriver
+	// Created NVIDIADriverBuilder object on the cluster.
+	Object *nvidiagpuv1alpha1.NVIDIADriver
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// errorMsg is processed before NVIDIADriverBuilder object is created.
+	errorMsg string
+}
+
+// NewNVIDIADriverBuilderFromObjectString creates a NVIDIADriverBuilder object from the NVIDIADriver item of the
+// CSV alm-examples.
+func NewNVIDIADriverBuilderFromObjectString(apiClient *clients.Settings, almExample string) *NVIDIADriverBuilder {
+	logger.V(logging.LevelTrace).Info("Initializing new NVIDIADriverBuilder structure from almExample string")
+
+	var nvidiaDriver nvidiagpuv1alpha1.NVIDIADriver
+
+	nvidiaDriverExample, err := olm.GetALMExampleByKind(almExample, NVIDIADriverKind)
+	if err == nil {
+		err = k8sjson.Unmarshal(nvidiaDriverExample, &nvidiaDriver)
+	}
+
+	builder := NVIDIADriverBuilder{
+		apiClient:  apiClient,
+		Definition: &nvidiaDriver,
+	}
+
+	if err != nil {
+		logger.V(logging.LevelTrace).Info("Error initializing NVIDIADriver from alm-examples", "error", err)
+
+		builder.errorMsg = fmt.Sprintf("Error initializing NVIDIADriver from alm-examples: %s", err.Error())
+	}
+
+	return &builder
+}
+
+// WithName sets the name of the NVIDIADriver. Several NVIDIADriver objects with distinct names and node selectors
+// can manage the driver of distinct node pools.
+func (builder *NVIDIADriverBuilder) WithName(name string) *NVIDIADriverBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting NVIDIADriver name", "newName", name)
+
+	if name == "" {
+		builder.errorMsg = "NVIDIADriver 'name' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Name = name
+
+	return builder
+}
+
+// WithNodeSelector sets the node selector of the nodes whose driver is managed by the NVIDIADriver.
+func (builder *NVIDIADriverBuilder) WithNodeSelector(nodeSelector map[string]string) *NVIDIADriverBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting NVIDIADriver node selector", "nodeSelector", nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		builder.errorMsg = "NVIDIADriver 'nodeSelector' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithDriverType sets the driver type: gpu, vgpu or vgpu-host-manager.
+func (builder *NVIDIADriverBuilder) WithDriverType(driverType nvidiagpuv1alpha1.DriverType) *NVIDIADriverBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting NVIDIADriver driver type", "driverType", driverType)
+
+	switch driverType {
+	case nvidiagpuv1alpha1.GPU, nvidiagpuv1alpha1.VGPU, nvidiagpuv1alpha1.VGPUHostManager:
+		builder.Definition.Spec.DriverType = driverType
+	default:
+		builder.errorMsg = fmt.Sprintf("invalid NVIDIADriver 'driverType' %s", driverType)
+	}
+
+	return builder
+}
+
+// WithVersion sets the driver version, which is the tag of the driver image.
+func (builder *NVIDIADriverBuilder) WithVersion(version string) *NVIDIADriverBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting NVIDIADriver version", "version", version)
+
+	if version == "" {
+		builder.errorMsg = "NVIDIADriver 'version' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Version = version
+
+	return builder
+}
+
+// WithRepository sets the repository of the driver image.
+func (builder *NVIDIADriverBuilder) WithRepository(repository string) *NVIDIADriverBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting NVIDIADriver repository", "repository", repository)
+
+	if repository == "" {
+		builder.errorMsg = "NVIDIADriver 'repository' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Repository = repository
+
+	return builder
+}
+
+// WithPrecompiled sets whether precompiled driver images are used instead of building the driver on the nodes.
+func (builder *NVIDIADriverBuilder) WithPrecompiled(precompiled bool) *NVIDIADriverBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Setting NVIDIADriver precompiled flag", "precompiled", precompiled)
+
+	builder.Definition.Spec.UsePrecompiled = &precompiled
+
+	return builder
+}
+
+// Get returns NVIDIADriver object if found.
+func (builder *NVIDIADriverBuilder) Get() (*nvidiagpuv1alpha1.NVIDIADriver, error) {
+	return builder.GetWithContext(context.TODO())
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (builder *NVIDIADriverBuilder) GetWithContext(ctx context.Context) (*nvidiagpuv1alpha1.NVIDIADriver, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Collecting NVIDIADriver object")
+
+	nvidiaDriver := &nvidiagpuv1alpha1.NVIDIADriver{}
+	err := builder.apiClient.Get(ctx, goclient.ObjectKey{
+		Name: builder.Definition.Name,
+	}, nvidiaDriver)
+
+	if err != nil {
+		builder.log().V(logging.LevelTrace).Info("NVIDIADriver object doesn't exist")
+
+		return nil, err
+	}
+
+	return nvidiaDriver, err
+}
+
+// PullNVIDIADriver loads an existing NVIDIADriver into NVIDIADriverBuilder struct.
+func PullNVIDIADriver(apiClient *clients.Settings, name string) (*NVIDIADriverBuilder, error) {
+	return PullNVIDIADriverWithContext(context.TODO(), apiClient, name)
+}
+
+// PullNVIDIADriverWithContext is the context-aware variant of PullNVIDIADriver.
+func PullNVIDIADriverWithContext(
+	ctx context.Context, apiClient *clients.Settings, name string) (*NVIDIADriverBuilder, error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing NVIDIADriver", "name", name)
+
+	builder := NVIDIADriverBuilder{
+		apiClient: apiClient,
+		Definition: &nvidiagpuv1alpha1.NVIDIADriver{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		},
+	}
+
+	if name == "" {
+		logger.V(logging.LevelTrace).Info("NVIDIADriver name is empty")
+
+		builder.errorMsg = "NVIDIADriver 'name' cannot be empty"
+	}
+
+	if !builder.ExistsWithContext(ctx) {
+		return nil, fmt.Errorf("NVIDIADriver object %s doesn't exist", name)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// Exists checks whether the given NVIDIADriver exists.
+func (builder *NVIDIADriverBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *NVIDIADriverBuilder) ExistsWithContext(ctx context.Context) bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Checking if NVIDIADriver exists")
+
+	var err error
+	builder.Object, err = builder.GetWithContext(ctx)
+
+	if err != nil {
+		logger.V(logging.LevelTrace).Info("Failed to collect NVIDIADriver object", "error", err)
+	}
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes a NVIDIADriver in the cluster and stores the created object in struct.
+func (builder *NVIDIADriverBuilder) Create() (*NVIDIADriverBuilder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *NVIDIADriverBuilder) CreateWithContext(ctx context.Context) (*NVIDIADriverBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Creating the NVIDIADriver")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunCreate, builder.Definition)
+	}
+
+	var err error
+	if !builder.ExistsWithContext(ctx) {
+		err = builder.apiClient.Create(ctx, builder.Definition)
+
+		if err == nil {
+			builder.Object = builder.Definition
+		}
+	}
+
+	return builder, err
+}
+
+// Update renovates the existing NVIDIADriver object with the definition in builder.
+func (builder *NVIDIADriverBuilder) Update(force bool) (*NVIDIADriverBuilder, error) {
+	return builder.UpdateWithContext(context.TODO(), force)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (builder *NVIDIADriverBuilder) UpdateWithContext(ctx context.Context, force bool) (*NVIDIADriverBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Updating the NVIDIADriver object")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunUpdate, builder.Definition)
+	}
+
+	err := builder.apiClient.Update(ctx, builder.Definition)
+
+	if err != nil && force {
+		logger.V(logging.LevelTrace).Info(msg.FailToUpdateNotification("nvidiadriver", builder.Definition.Name))
+
+		builder, err := builder.DeleteWithContext(ctx)
+
+		if err != nil {
+			logger.V(logging.LevelTrace).Info(msg.FailToUpdateError("nvidiadriver", builder.Definition.Name))
+
+			return nil, err
+		}
+
+		return builder.CreateWithContext(ctx)
+	}
+
+	return builder, err
+}
+
+// Delete removes a NVIDIADriver.
+func (builder *NVIDIADriverBuilder) Delete() (*NVIDIADriverBuilder, error) {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *NVIDIADriverBuilder) DeleteWithContext(ctx context.Context) (*NVIDIADriverBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	builder.log().V(logging.LevelTrace).Info("Deleting NVIDIADriver")
+
+	if builder.apiClient.IsDryRun() {
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
+
+	if !builder.ExistsWithContext(ctx) {
+		return builder, fmt.Errorf("nvidiadriver cannot be deleted because it does not exist")
+	}
+
+	err := builder.apiClient.Delete(ctx, builder.Definition)
+
+	if err != nil {
+		return builder, fmt.Errorf("cannot delete nvidiadriver: %w", err)
+	}
+
+	builder.Object = nil
+
+	return builder, nil
+}
+
+// log returns the logger with the NVIDIADriver kind and name fields.
+func (builder *NVIDIADriverBuilder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", NVIDIADriverKind)
+	}
+
+	return logging.WithResource(logger, NVIDIADriverKind, builder.Definition.Name, builder.Definition.Namespace)
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *NVIDIADriverBuilder) validate() (bool, error) {
+	resourceCRD := NVIDIADriverKind
+
+	if builder == nil {
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
+
+		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
+	}
+
+	if builder.apiClient == nil {
+		logger.V(logging.LevelTrace).Info("The builder apiclient is nil", "kind", resourceCRD)
+
+		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		logger.V(logging.LevelTrace).Info("The builder has error message", "kind", resourceCRD,
+			"error", builder.errorMsg)
+
+		return false, errors.New(builder.errorMsg)
+	}
+
+	return true, nil
+}
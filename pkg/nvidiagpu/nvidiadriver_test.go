@@ -0,0 +1,78 @@
+package nvidiagpu
+
+import (
+	"context"
+	"testing"
+
+	nvidiagpuv1alpha1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1alpha1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+)
+
+const testALMExamples = `[
+  {"apiVersion": "nvidia.com/v1", "kind": "ClusterPolicy", "metadata": {"name": "gpu-cluster-policy"}, "spec": {}},
+  {"apiVersion": "nvidia.com/v1alpha1", "kind": "NVIDIADriver", "metadata": {"name": "default"},
+   "spec": {"driverType": "gpu", "repository": "nvcr.io/nvidia", "image": "driver", "version": "550.127.08",
+            "nodeSelector": {"nvidia.com/gpu.deploy.driver": "true"}, "usePrecompiled": false}}
+]`
+
+func TestNewNVIDIADriverBuilderFromObjectString(t *testing.T) {
+	builder := NewNVIDIADriverBuilderFromObjectString(clients.NewFakeSettings(), testALMExamples).
+		WithName("a100-pool").
+		WithNodeSelector(map[string]string{"nvidia.com/gpu.product": "NVIDIA-A100-SXM4-40GB"}).
+		WithDriverType(nvidiagpuv1alpha1.GPU).
+		WithVersion("570").
+		WithRepository("registry.example.com/nvidia").
+		WithPrecompiled(true)
+
+	if valid, err := builder.validate(); !valid {
+		t.Fatalf("unexpected invalid builder: %v", err)
+	}
+
+	spec := builder.Definition.Spec
+	if builder.Definition.Name != "a100-pool" || spec.Version != "570" ||
+		spec.Repository != "registry.example.com/nvidia" || spec.Image != "driver" || !spec.UsePrecompiledDrivers() {
+		t.Errorf("unexpected NVIDIADriver definition %+v", builder.Definition)
+	}
+
+	if len(spec.NodeSelector) != 1 || spec.NodeSelector["nvidia.com/gpu.product"] != "NVIDIA-A100-SXM4-40GB" {
+		t.Errorf("unexpected node selector %v", spec.NodeSelector)
+	}
+
+	invalid := NewNVIDIADriverBuilderFromObjectString(clients.NewFakeSettings(), testALMExamples).
+		WithDriverType("cpu").
+		WithVersion("570")
+	if _, err := invalid.Create(); err == nil {
+		t.Error("expected error creating NVIDIADriver with invalid driver type")
+	}
+
+	missing := NewNVIDIADriverBuilderFromObjectString(clients.NewFakeSettings(), `[{"kind": "ClusterPolicy"}]`)
+	if valid, _ := missing.validate(); valid {
+		t.Error("expected error building NVIDIADriver from alm-examples without NVIDIADriver")
+	}
+}
+
+func TestNVIDIADriverCreatePullDelete(t *testing.T) {
+	apiClient := clients.NewFakeSettings()
+
+	created, err := NewNVIDIADriverBuilderFromObjectString(apiClient, testALMExamples).CreateWithContext(context.TODO())
+	if err != nil {
+		t.Fatalf("unexpected error creating NVIDIADriver: %v", err)
+	}
+
+	pulled, err := PullNVIDIADriver(apiClient, created.Definition.Name)
+	if err != nil {
+		t.Fatalf("unexpected error pulling NVIDIADriver: %v", err)
+	}
+
+	if pulled.Object.Spec.Version != "550.127.08" {
+		t.Errorf("unexpected pulled NVIDIADriver version %s", pulled.Object.Spec.Version)
+	}
+
+	if _, err := pulled.Delete(); err != nil {
+		t.Fatalf("unexpected error deleting NVIDIADriver: %v", err)
+	}
+
+	if _, err := PullNVIDIADriver(apiClient, created.Definition.Name); err == nil {
+		t.Error("expected error pulling deleted NVIDIADriver")
+	}
+}
@@ -0,0 +1,6 @@
+package nvidianetwork
+
+const (
+	// NvidiaNetworkNamespace is the namespace where the Network Operator deploys the NicClusterPolicy operands.
+	NvidiaNetworkNamespace = "nvidia-network-operator"
+)
@@ -7,8 +7,9 @@ import (
 
 	nvidianetworkv1alpha1 "github.com/Mellanox/network-operator/api/v1alpha1"
 
-	"github.com/golang/glog"
+	"github.com/go-logr/logr"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,14 +33,13 @@ type IPoIBNetworkBuilder struct {
 
 // NewIPoIBNetworkBuilderFromObjectString creates a IPoIBNetworkBuilder  object from CSV alm-examples.
 func NewIPoIBNetworkBuilderFromObjectString(apiClient *clients.Settings, almExample string) *IPoIBNetworkBuilder {
-	glog.V(100).Infof(
+	logger.V(logging.LevelTrace).Info(
 		"Initializing new IPoIBNetworkBuilder  structure from almExample string")
 
 	IPoIBNetwork, err := getIPoIBNetworkFromAlmExample(almExample)
 
 	if err != nil {
-		glog.V(100).Infof(
-			"Error initializing IPoIBNetwork from alm-examples: %s", err.Error())
+		logger.V(logging.LevelTrace).Info("Error initializing IPoIBNetwork from alm-examples", "error", err)
 
 		builder := IPoIBNetworkBuilder{
 			apiClient: apiClient,
@@ -57,9 +57,8 @@ func NewIPoIBNetworkBuilderFromObjectString(apiClient *clients.Settings, almExam
 		return &builder
 	}
 
-	glog.V(100).Infof(
-		"Initializing new IPoIBNetworkBuilder  structure from almExample string with IPoIBNetwork name: %s",
-		IPoIBNetwork.Name)
+	logger.V(logging.LevelTrace).Info("Initializing new IPoIBNetworkBuilder structure from almExample string",
+		"name", IPoIBNetwork.Name)
 
 	builder := IPoIBNetworkBuilder{
 		apiClient:  apiClient,
@@ -67,7 +66,7 @@ func NewIPoIBNetworkBuilderFromObjectString(apiClient *clients.Settings, almExam
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The IPoIBNetwork object definition is nil")
+		logger.V(logging.LevelTrace).Info("The IPoIBNetwork object definition is nil")
 
 		builder.errorMsg = "IPoIBNetwork 'Object.Definition' is nil"
 	}
@@ -77,21 +76,24 @@ func NewIPoIBNetworkBuilderFromObjectString(apiClient *clients.Settings, almExam
 
 // Get returns IPoIBNetwork object if found.
 func (builder *IPoIBNetworkBuilder) Get() (*nvidianetworkv1alpha1.IPoIBNetwork, error) {
+	return builder.GetWithContext(context.TODO())
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (builder *IPoIBNetworkBuilder) GetWithContext(ctx context.Context) (*nvidianetworkv1alpha1.IPoIBNetwork, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
-		"Collecting IPoIBNetwork object %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Collecting IPoIBNetwork object")
 
 	IPoIBNetwork := &nvidianetworkv1alpha1.IPoIBNetwork{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(ctx, goclient.ObjectKey{
 		Name: builder.Definition.Name,
 	}, IPoIBNetwork)
 
 	if err != nil {
-		glog.V(100).Infof(
-			"IPoIBNetwork object %s doesn't exist", builder.Definition.Name)
+		builder.log().V(logging.LevelTrace).Info("IPoIBNetwork object doesn't exist")
 
 		return nil, err
 	}
@@ -101,7 +103,13 @@ func (builder *IPoIBNetworkBuilder) Get() (*nvidianetworkv1alpha1.IPoIBNetwork,
 
 // PullIPoIBNetwork loads an existing IPoIBNetwork into IPoIBNetworkBuilder  struct.
 func PullIPoIBNetwork(apiClient *clients.Settings, name string) (*IPoIBNetworkBuilder, error) {
-	glog.V(100).Infof("Pulling existing IPoIBNetwork name: %s", name)
+	return PullIPoIBNetworkWithContext(context.TODO(), apiClient, name)
+}
+
+// PullIPoIBNetworkWithContext is the context-aware variant of PullIPoIBNetwork.
+func PullIPoIBNetworkWithContext(
+	ctx context.Context, apiClient *clients.Settings, name string) (*IPoIBNetworkBuilder, error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing IPoIBNetwork", "name", name)
 
 	builder := IPoIBNetworkBuilder{
 		apiClient: apiClient,
@@ -113,13 +121,13 @@ func PullIPoIBNetwork(apiClient *clients.Settings, name string) (*IPoIBNetworkBu
 	}
 
 	if name == "" {
-		glog.V(100).Infof("IPoIBNetwork name is empty")
+		logger.V(logging.LevelTrace).Info("IPoIBNetwork name is empty")
 
 		builder.errorMsg = "IPoIBNetwork 'name' cannot be empty"
 		return nil, errors.New(builder.errorMsg)
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("IPoIBNetwork object %s doesn't exist", name)
 	}
 
@@ -130,18 +138,22 @@ func PullIPoIBNetwork(apiClient *clients.Settings, name string) (*IPoIBNetworkBu
 
 // Exists checks whether the given IPoIBNetwork exists.
 func (builder *IPoIBNetworkBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *IPoIBNetworkBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof(
-		"Checking if IPoIBNetwork %s exists", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Checking if IPoIBNetwork exists")
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect IPoIBNetwork object due to %s", err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to collect IPoIBNetwork object", "error", err)
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -149,17 +161,26 @@ func (builder *IPoIBNetworkBuilder) Exists() bool {
 
 // Delete removes a IPoIBNetwork.
 func (builder *IPoIBNetworkBuilder) Delete() (*IPoIBNetworkBuilder, error) {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *IPoIBNetworkBuilder) DeleteWithContext(ctx context.Context) (*IPoIBNetworkBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting IPoIBNetwork %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Deleting IPoIBNetwork")
+
+	if builder.apiClient.IsDryRun() {
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return builder, fmt.Errorf("IPoIBNetwork cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete IPoIBNetwork: %w", err)
@@ -172,21 +193,31 @@ func (builder *IPoIBNetworkBuilder) Delete() (*IPoIBNetworkBuilder, error) {
 
 // Create makes a IPoIBNetwork in the cluster and stores the created object in struct.
 func (builder *IPoIBNetworkBuilder) Create() (*IPoIBNetworkBuilder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *IPoIBNetworkBuilder) CreateWithContext(ctx context.Context) (*IPoIBNetworkBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the IPoIBNetwork %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Creating the IPoIBNetwork")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunCreate, builder.Definition)
+	}
 
 	var err error
-	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+	if !builder.ExistsWithContext(ctx) {
+		err = builder.apiClient.Create(ctx, builder.Definition)
 
 		if err == nil {
 			builder.Object = builder.Definition
 		} else {
-			glog.V(100).Infof("Error creating the IPoIBNetwork '%s' : '%s'",
-				builder.Definition.Name, err.Error())
+			builder.log().V(logging.LevelTrace).Info("Error creating the IPoIBNetwork", "error", err)
 		}
 	}
 
@@ -195,28 +226,39 @@ func (builder *IPoIBNetworkBuilder) Create() (*IPoIBNetworkBuilder, error) {
 
 // Update renovates the existing IPoIBNetwork object with the definition in builder.
 func (builder *IPoIBNetworkBuilder) Update(force bool) (*IPoIBNetworkBuilder, error) {
+	return builder.UpdateWithContext(context.TODO(), force)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (builder *IPoIBNetworkBuilder) UpdateWithContext(ctx context.Context, force bool) (*IPoIBNetworkBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the IPoIBNetwork object named:  %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Updating the IPoIBNetwork object")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunUpdate, builder.Definition)
+	}
+
+	err := builder.apiClient.Update(ctx, builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(msg.FailToUpdateNotification("IPoIBNetwork", builder.Definition.Name))
+			logger.V(logging.LevelTrace).Info(msg.FailToUpdateNotification("IPoIBNetwork", builder.Definition.Name))
 
-			builder, err := builder.Delete()
+			builder, err := builder.DeleteWithContext(ctx)
 
 			if err != nil {
-				glog.V(100).Infof(
+				logger.V(logging.LevelTrace).Info(
 					msg.FailToUpdateError("IPoIBNetwork", builder.Definition.Name))
 
 				return nil, err
 			}
 
-			return builder.Create()
+			return builder.CreateWithContext(ctx)
 		}
 	}
 
@@ -234,7 +276,7 @@ func getIPoIBNetworkFromAlmExample(almExample string) (*nvidianetworkv1alpha1.IP
 	err := json.Unmarshal([]byte(almExample), &IPoIBNetworkList.Items)
 
 	if err != nil {
-		glog.V(100).Infof("Error unmarshalling IPoIBNetwork from almExamples: '%s'", err.Error())
+		logger.V(logging.LevelTrace).Info("Error unmarshalling IPoIBNetwork from almExamples", "error", err)
 
 		return nil, err
 	}
@@ -252,30 +294,40 @@ func getIPoIBNetworkFromAlmExample(almExample string) (*nvidianetworkv1alpha1.IP
 	return nil, fmt.Errorf("IPoIBNetwork not found in alm examples")
 }
 
+// log returns the logger with the IPoIBNetwork kind, name and namespace fields.
+func (builder *IPoIBNetworkBuilder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", "IPoIBNetwork")
+	}
+
+	return logging.WithResource(logger, "IPoIBNetwork", builder.Definition.Name, builder.Definition.Namespace)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *IPoIBNetworkBuilder) validate() (bool, error) {
 	resourceCRD := nvidianetworkv1alpha1.IPoIBNetworkCRDName
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder apiclient is nil", "kind", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelTrace).Info("The builder has error message", "kind", resourceCRD,
+			"error", builder.errorMsg)
 
 		return false, errors.New(builder.errorMsg)
 	}
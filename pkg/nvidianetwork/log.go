@@ -0,0 +1,5 @@
+package nvidianetwork
+
+import "github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+
+var logger = logging.Logger(logging.Network)
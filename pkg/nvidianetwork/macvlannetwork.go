@@ -7,8 +7,9 @@ import (
 
 	nvidianetworkv1alpha1 "github.com/Mellanox/network-operator/api/v1alpha1"
 
-	"github.com/golang/glog"
+	"github.com/go-logr/logr"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,14 +33,13 @@ type MacvlanNetworkBuilder struct {
 
 // NewMacvlanNetworkBuilderFromObjectString creates a MacvlanNetworkBuilder  object from CSV alm-examples.
 func NewMacvlanNetworkBuilderFromObjectString(apiClient *clients.Settings, almExample string) *MacvlanNetworkBuilder {
-	glog.V(100).Infof(
+	logger.V(logging.LevelTrace).Info(
 		"Initializing new MacvlanNetworkBuilder  structure from almExample string")
 
 	macvlanNetwork, err := getMacvlanNetworkFromAlmExample(almExample)
 
 	if err != nil {
-		glog.V(100).Infof(
-			"Error initializing MacvlanNetwork from alm-examples: %s", err.Error())
+		logger.V(logging.LevelTrace).Info("Error initializing MacvlanNetwork from alm-examples", "error", err)
 
 		builder := MacvlanNetworkBuilder{
 			apiClient: apiClient,
@@ -57,9 +57,8 @@ func NewMacvlanNetworkBuilderFromObjectString(apiClient *clients.Settings, almEx
 		return &builder
 	}
 
-	glog.V(100).Infof(
-		"Initializing new MacvlanNetworkBuilder  structure from almExample string with MacvlanNetwork name: %s",
-		macvlanNetwork.Name)
+	logger.V(logging.LevelTrace).Info("Initializing new MacvlanNetworkBuilder structure from almExample string",
+		"name", macvlanNetwork.Name)
 
 	builder := MacvlanNetworkBuilder{
 		apiClient:  apiClient,
@@ -67,7 +66,7 @@ func NewMacvlanNetworkBuilderFromObjectString(apiClient *clients.Settings, almEx
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The MacvlanNetwork object definition is nil")
+		logger.V(logging.LevelTrace).Info("The MacvlanNetwork object definition is nil")
 
 		builder.errorMsg = "MacvlanNetwork 'Object.Definition' is nil"
 	}
@@ -77,21 +76,25 @@ func NewMacvlanNetworkBuilderFromObjectString(apiClient *clients.Settings, almEx
 
 // Get returns MacvlanNetwork object if found.
 func (builder *MacvlanNetworkBuilder) Get() (*nvidianetworkv1alpha1.MacvlanNetwork, error) {
+	return builder.GetWithContext(context.TODO())
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (builder *MacvlanNetworkBuilder) GetWithContext(
+	ctx context.Context) (*nvidianetworkv1alpha1.MacvlanNetwork, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
-		"Collecting MacvlanNetwork object %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Collecting MacvlanNetwork object")
 
 	MacvlanNetwork := &nvidianetworkv1alpha1.MacvlanNetwork{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(ctx, goclient.ObjectKey{
 		Name: builder.Definition.Name,
 	}, MacvlanNetwork)
 
 	if err != nil {
-		glog.V(100).Infof(
-			"MacvlanNetwork object %s doesn't exist", builder.Definition.Name)
+		builder.log().V(logging.LevelTrace).Info("MacvlanNetwork object doesn't exist")
 
 		return nil, err
 	}
@@ -101,7 +104,13 @@ func (builder *MacvlanNetworkBuilder) Get() (*nvidianetworkv1alpha1.MacvlanNetwo
 
 // PullMacvlanNetwork loads an existing MacvlanNetwork into MacvlanNetworkBuilder  struct.
 func PullMacvlanNetwork(apiClient *clients.Settings, name string) (*MacvlanNetworkBuilder, error) {
-	glog.V(100).Infof("Pulling existing MacvlanNetwork name: %s", name)
+	return PullMacvlanNetworkWithContext(context.TODO(), apiClient, name)
+}
+
+// PullMacvlanNetworkWithContext is the context-aware variant of PullMacvlanNetwork.
+func PullMacvlanNetworkWithContext(
+	ctx context.Context, apiClient *clients.Settings, name string) (*MacvlanNetworkBuilder, error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing MacvlanNetwork", "name", name)
 
 	builder := MacvlanNetworkBuilder{
 		apiClient: apiClient,
@@ -113,13 +122,13 @@ func PullMacvlanNetwork(apiClient *clients.Settings, name string) (*MacvlanNetwo
 	}
 
 	if name == "" {
-		glog.V(100).Infof("MacvlanNetwork name is empty")
+		logger.V(logging.LevelTrace).Info("MacvlanNetwork name is empty")
 
 		builder.errorMsg = "MacvlanNetwork 'name' cannot be empty"
 		return nil, errors.New(builder.errorMsg)
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("MacvlanNetwork object %s doesn't exist", name)
 	}
 
@@ -130,18 +139,22 @@ func PullMacvlanNetwork(apiClient *clients.Settings, name string) (*MacvlanNetwo
 
 // Exists checks whether the given MacvlanNetwork exists.
 func (builder *MacvlanNetworkBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *MacvlanNetworkBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof(
-		"Checking if MacvlanNetwork %s exists", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Checking if MacvlanNetwork exists")
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect MacvlanNetwork object due to %s", err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to collect MacvlanNetwork object", "error", err)
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -149,17 +162,26 @@ func (builder *MacvlanNetworkBuilder) Exists() bool {
 
 // Delete removes a MacvlanNetwork.
 func (builder *MacvlanNetworkBuilder) Delete() (*MacvlanNetworkBuilder, error) {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *MacvlanNetworkBuilder) DeleteWithContext(ctx context.Context) (*MacvlanNetworkBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting MacvlanNetwork %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Deleting MacvlanNetwork")
+
+	if builder.apiClient.IsDryRun() {
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return builder, errors.New("MacvlanNetwork cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete MacvlanNetwork: %w", err)
@@ -172,21 +194,31 @@ func (builder *MacvlanNetworkBuilder) Delete() (*MacvlanNetworkBuilder, error) {
 
 // Create makes a MacvlanNetwork in the cluster and stores the created object in struct.
 func (builder *MacvlanNetworkBuilder) Create() (*MacvlanNetworkBuilder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *MacvlanNetworkBuilder) CreateWithContext(ctx context.Context) (*MacvlanNetworkBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the MacvlanNetwork %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Creating the MacvlanNetwork")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunCreate, builder.Definition)
+	}
 
 	var err error
-	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+	if !builder.ExistsWithContext(ctx) {
+		err = builder.apiClient.Create(ctx, builder.Definition)
 
 		if err == nil {
 			builder.Object = builder.Definition
 		} else {
-			glog.V(100).Infof("Error creating the MacvlanNetwork '%s' : '%s'",
-				builder.Definition.Name, err.Error())
+			builder.log().V(logging.LevelTrace).Info("Error creating the MacvlanNetwork", "error", err)
 		}
 	}
 
@@ -195,28 +227,40 @@ func (builder *MacvlanNetworkBuilder) Create() (*MacvlanNetworkBuilder, error) {
 
 // Update renovates the existing MacvlanNetwork object with the definition in builder.
 func (builder *MacvlanNetworkBuilder) Update(force bool) (*MacvlanNetworkBuilder, error) {
+	return builder.UpdateWithContext(context.TODO(), force)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (builder *MacvlanNetworkBuilder) UpdateWithContext(
+	ctx context.Context, force bool) (*MacvlanNetworkBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the MacvlanNetwork object named:  %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Updating the MacvlanNetwork object")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunUpdate, builder.Definition)
+	}
+
+	err := builder.apiClient.Update(ctx, builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(msg.FailToUpdateNotification("MacvlanNetwork", builder.Definition.Name))
+			logger.V(logging.LevelTrace).Info(msg.FailToUpdateNotification("MacvlanNetwork", builder.Definition.Name))
 
-			builder, err := builder.Delete()
+			builder, err := builder.DeleteWithContext(ctx)
 
 			if err != nil {
-				glog.V(100).Infof(
+				logger.V(logging.LevelTrace).Info(
 					msg.FailToUpdateError("MacvlanNetwork", builder.Definition.Name))
 
 				return nil, err
 			}
 
-			return builder.Create()
+			return builder.CreateWithContext(ctx)
 		}
 	}
 
@@ -234,7 +278,7 @@ func getMacvlanNetworkFromAlmExample(almExample string) (*nvidianetworkv1alpha1.
 	err := json.Unmarshal([]byte(almExample), &MacvlanNetworkList.Items)
 
 	if err != nil {
-		glog.V(100).Infof("Error unmarshalling MacvlanNetwork from almExamples: '%s'", err.Error())
+		logger.V(logging.LevelTrace).Info("Error unmarshalling MacvlanNetwork from almExamples", "error", err)
 
 		return nil, err
 	}
@@ -252,30 +296,40 @@ func getMacvlanNetworkFromAlmExample(almExample string) (*nvidianetworkv1alpha1.
 	return nil, errors.New("MacvlanNetwork not found in alm examples")
 }
 
+// log returns the logger with the MacvlanNetwork kind, name and namespace fields.
+func (builder *MacvlanNetworkBuilder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", "MacvlanNetwork")
+	}
+
+	return logging.WithResource(logger, "MacvlanNetwork", builder.Definition.Name, builder.Definition.Namespace)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *MacvlanNetworkBuilder) validate() (bool, error) {
 	resourceCRD := nvidianetworkv1alpha1.MacvlanNetworkCRDName
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder apiclient is nil", "kind", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelTrace).Info("The builder has error message", "kind", resourceCRD,
+			"error", builder.errorMsg)
 
 		return false, errors.New(builder.errorMsg)
 	}
@@ -7,8 +7,9 @@ import (
 
 	nvidianetworkv1alpha1 "github.com/Mellanox/network-operator/api/v1alpha1"
 
-	"github.com/golang/glog"
+	"github.com/go-logr/logr"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,14 +33,13 @@ type NicClusterPolicyBuilder struct {
 
 // NewNicClusterPolicyBuilderFromObjectString creates a NicClusterPolicyBuilder object from CSV alm-examples.
 func NewNicClusterPolicyBuilderFromObjectString(apiClient *clients.Settings, almExample string) *NicClusterPolicyBuilder {
-	glog.V(100).Infof(
+	logger.V(logging.LevelTrace).Info(
 		"Initializing new NicClusterPolicyBuilder structure from almExample string")
 
 	nicClusterPolicy, err := getNicClusterPolicyFromAlmExample(almExample)
 
 	if err != nil {
-		glog.V(100).Infof(
-			"Error initializing NicClusterPolicy from alm-examples: %s", err.Error())
+		logger.V(logging.LevelTrace).Info("Error initializing NicClusterPolicy from alm-examples", "error", err)
 
 		builder := NicClusterPolicyBuilder{
 			apiClient: apiClient,
@@ -57,9 +57,8 @@ func NewNicClusterPolicyBuilderFromObjectString(apiClient *clients.Settings, alm
 		return &builder
 	}
 
-	glog.V(100).Infof(
-		"Initializing new NicClusterPolicyBuilder structure from almExample string with NicClusterPolicy name: %s",
-		nicClusterPolicy.Name)
+	logger.V(logging.LevelTrace).Info("Initializing new NicClusterPolicyBuilder structure from almExample string",
+		"name", nicClusterPolicy.Name)
 
 	builder := NicClusterPolicyBuilder{
 		apiClient:  apiClient,
@@ -67,7 +66,7 @@ func NewNicClusterPolicyBuilderFromObjectString(apiClient *clients.Settings, alm
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The NicClusterPolicy object definition is nil")
+		logger.V(logging.LevelTrace).Info("The NicClusterPolicy object definition is nil")
 
 		builder.errorMsg = "NicClusterPolicy 'Object.Definition' is nil"
 	}
@@ -77,21 +76,25 @@ func NewNicClusterPolicyBuilderFromObjectString(apiClient *clients.Settings, alm
 
 // Get returns nicclusterPolicy object if found.
 func (builder *NicClusterPolicyBuilder) Get() (*nvidianetworkv1alpha1.NicClusterPolicy, error) {
+	return builder.GetWithContext(context.TODO())
+}
+
+// GetWithContext is the context-aware variant of Get.
+func (builder *NicClusterPolicyBuilder) GetWithContext(
+	ctx context.Context) (*nvidianetworkv1alpha1.NicClusterPolicy, error) {
 	if valid, err := builder.validate(); !valid {
 		return nil, err
 	}
 
-	glog.V(100).Infof(
-		"Collecting NicClusterPolicy object %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Collecting NicClusterPolicy object")
 
 	nicClusterPolicy := &nvidianetworkv1alpha1.NicClusterPolicy{}
-	err := builder.apiClient.Get(context.TODO(), goclient.ObjectKey{
+	err := builder.apiClient.Get(ctx, goclient.ObjectKey{
 		Name: builder.Definition.Name,
 	}, nicClusterPolicy)
 
 	if err != nil {
-		glog.V(100).Infof(
-			"NicClusterPolicy object %s doesn't exist", builder.Definition.Name)
+		builder.log().V(logging.LevelTrace).Info("NicClusterPolicy object doesn't exist")
 
 		return nil, err
 	}
@@ -101,7 +104,13 @@ func (builder *NicClusterPolicyBuilder) Get() (*nvidianetworkv1alpha1.NicCluster
 
 // PullNicClusterPolicy loads an existing NicClusterPolicy into NicClusterPolicyBuilder struct.
 func PullNicClusterPolicy(apiClient *clients.Settings, name string) (*NicClusterPolicyBuilder, error) {
-	glog.V(100).Infof("Pulling existing nicClusterPolicy name: %s", name)
+	return PullNicClusterPolicyWithContext(context.TODO(), apiClient, name)
+}
+
+// PullNicClusterPolicyWithContext is the context-aware variant of PullNicClusterPolicy.
+func PullNicClusterPolicyWithContext(
+	ctx context.Context, apiClient *clients.Settings, name string) (*NicClusterPolicyBuilder, error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing nicClusterPolicy", "name", name)
 
 	builder := NicClusterPolicyBuilder{
 		apiClient: apiClient,
@@ -113,13 +122,13 @@ func PullNicClusterPolicy(apiClient *clients.Settings, name string) (*NicCluster
 	}
 
 	if name == "" {
-		glog.V(100).Infof("NicClusterPolicy name is empty")
+		logger.V(logging.LevelTrace).Info("NicClusterPolicy name is empty")
 
 		builder.errorMsg = "NicClusterPolicy 'name' cannot be empty"
 		return nil, errors.New(builder.errorMsg)
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("NicClusterPolicy object %s doesn't exist", name)
 	}
 
@@ -130,18 +139,22 @@ func PullNicClusterPolicy(apiClient *clients.Settings, name string) (*NicCluster
 
 // Exists checks whether the given NicClusterPolicy exists.
 func (builder *NicClusterPolicyBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *NicClusterPolicyBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof(
-		"Checking if NicClusterPolicy %s exists", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Checking if NicClusterPolicy exists")
 
 	var err error
-	builder.Object, err = builder.Get()
+	builder.Object, err = builder.GetWithContext(ctx)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to collect NicClusterPolicy object due to %s", err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to collect NicClusterPolicy object", "error", err)
 	}
 
 	return err == nil || !k8serrors.IsNotFound(err)
@@ -149,17 +162,26 @@ func (builder *NicClusterPolicyBuilder) Exists() bool {
 
 // Delete removes a NicClusterPolicy.
 func (builder *NicClusterPolicyBuilder) Delete() (*NicClusterPolicyBuilder, error) {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *NicClusterPolicyBuilder) DeleteWithContext(ctx context.Context) (*NicClusterPolicyBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Deleting NicClusterPolicy %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Deleting NicClusterPolicy")
+
+	if builder.apiClient.IsDryRun() {
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return builder, fmt.Errorf("nicclusterpolicy cannot be deleted because it does not exist")
 	}
 
-	err := builder.apiClient.Delete(context.TODO(), builder.Definition)
+	err := builder.apiClient.Delete(ctx, builder.Definition)
 
 	if err != nil {
 		return builder, fmt.Errorf("cannot delete nicclusterpolicy: %w", err)
@@ -172,21 +194,31 @@ func (builder *NicClusterPolicyBuilder) Delete() (*NicClusterPolicyBuilder, erro
 
 // Create makes a NicClusterPolicy in the cluster and stores the created object in struct.
 func (builder *NicClusterPolicyBuilder) Create() (*NicClusterPolicyBuilder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *NicClusterPolicyBuilder) CreateWithContext(ctx context.Context) (*NicClusterPolicyBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the NicClusterPolicy %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Creating the NicClusterPolicy")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunCreate, builder.Definition)
+	}
 
 	var err error
-	if !builder.Exists() {
-		err = builder.apiClient.Create(context.TODO(), builder.Definition)
+	if !builder.ExistsWithContext(ctx) {
+		err = builder.apiClient.Create(ctx, builder.Definition)
 
 		if err == nil {
 			builder.Object = builder.Definition
 		} else {
-			glog.V(100).Infof("Error creating the NicClusterPolicy '%s' : '%s'",
-				builder.Definition.Name, err.Error())
+			builder.log().V(logging.LevelTrace).Info("Error creating the NicClusterPolicy", "error", err)
 		}
 	}
 
@@ -195,28 +227,40 @@ func (builder *NicClusterPolicyBuilder) Create() (*NicClusterPolicyBuilder, erro
 
 // Update renovates the existing NicClusterPolicy object with the definition in builder.
 func (builder *NicClusterPolicyBuilder) Update(force bool) (*NicClusterPolicyBuilder, error) {
+	return builder.UpdateWithContext(context.TODO(), force)
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (builder *NicClusterPolicyBuilder) UpdateWithContext(
+	ctx context.Context, force bool) (*NicClusterPolicyBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating the NicClusterPolicy object named:  %s", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Updating the NicClusterPolicy object")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
 
-	err := builder.apiClient.Update(context.TODO(), builder.Definition)
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunUpdate, builder.Definition)
+	}
+
+	err := builder.apiClient.Update(ctx, builder.Definition)
 
 	if err != nil {
 		if force {
-			glog.V(100).Infof(msg.FailToUpdateNotification("nicclusterpolicy", builder.Definition.Name))
+			logger.V(logging.LevelTrace).Info(msg.FailToUpdateNotification("nicclusterpolicy", builder.Definition.Name))
 
-			builder, err := builder.Delete()
+			builder, err := builder.DeleteWithContext(ctx)
 
 			if err != nil {
-				glog.V(100).Infof(
+				logger.V(logging.LevelTrace).Info(
 					msg.FailToUpdateError("nicclusterpolicy", builder.Definition.Name))
 
 				return nil, err
 			}
 
-			return builder.Create()
+			return builder.CreateWithContext(ctx)
 		}
 	}
 
@@ -234,7 +278,7 @@ func getNicClusterPolicyFromAlmExample(almExample string) (*nvidianetworkv1alpha
 	err := json.Unmarshal([]byte(almExample), &nicClusterPolicyList.Items)
 
 	if err != nil {
-		glog.V(100).Infof("Error unmarshalling NicClusterPolicy from almExamples: '%s'", err.Error())
+		logger.V(logging.LevelTrace).Info("Error unmarshalling NicClusterPolicy from almExamples", "error", err)
 
 		return nil, err
 	}
@@ -252,30 +296,40 @@ func getNicClusterPolicyFromAlmExample(almExample string) (*nvidianetworkv1alpha
 	return nil, fmt.Errorf("NicClusterPolicy not found in alm examples")
 }
 
+// log returns the logger with the NicClusterPolicy kind, name and namespace fields.
+func (builder *NicClusterPolicyBuilder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", "NicClusterPolicy")
+	}
+
+	return logging.WithResource(logger, "NicClusterPolicy", builder.Definition.Name, builder.Definition.Namespace)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *NicClusterPolicyBuilder) validate() (bool, error) {
 	resourceCRD := nvidianetworkv1alpha1.NicClusterPolicyCRDName
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder apiclient is nil", "kind", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelTrace).Info("The builder has error message", "kind", resourceCRD,
+			"error", builder.errorMsg)
 
 		return false, errors.New(builder.errorMsg)
 	}
@@ -0,0 +1,308 @@
+package nvidiasmi
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/pod"
+)
+
+const (
+	// NotAvailable is the value nvidia-smi reports for the properties not supported by a GPU.
+	NotAvailable = "N/A"
+	// Enabled is the value nvidia-smi reports for the enabled modes, such as the MIG and ECC modes.
+	Enabled = "Enabled"
+	// Active is the value nvidia-smi reports for the active clocks event reasons.
+	Active = "Active"
+
+	// ComputeProcess is the type of the processes using the GPU for compute.
+	ComputeProcess = "C"
+	// GraphicsProcess is the type of the processes using the GPU for graphics.
+	GraphicsProcess = "G"
+	// MPSComputeProcess is the type of the compute processes running as MPS clients.
+	MPSComputeProcess = "M+C"
+)
+
+var (
+	logger = logging.Logger(logging.GPU)
+
+	// QueryCommand prints the nvidia-smi query of every GPU in XML.
+	QueryCommand = []string{"nvidia-smi", "-q", "-x"}
+)
+
+// Log is the nvidia-smi XML query output.
+type Log struct {
+	XMLName       xml.Name `xml:"nvidia_smi_log"`
+	DriverVersion string   `xml:"driver_version"`
+	CUDAVersion   string   `xml:"cuda_version"`
+	AttachedGPUs  int      `xml:"attached_gpus"`
+	GPUs          []GPU    `xml:"gpu"`
+}
+
+// GPU is a GPU of the nvidia-smi XML query output.
+type GPU struct {
+	ID                  string        `xml:"id,attr"`
+	ProductName         string        `xml:"product_name"`
+	ProductArchitecture string        `xml:"product_architecture"`
+	UUID                string        `xml:"uuid"`
+	MinorNumber         string        `xml:"minor_number"`
+	MIGMode             MIGMode       `xml:"mig_mode"`
+	MIGDevices          []MIGDevice   `xml:"mig_devices>mig_device"`
+	ComputeMode         string        `xml:"compute_mode"`
+	FBMemoryUsage       MemoryUsage   `xml:"fb_memory_usage"`
+	ECCMode             ECCMode       `xml:"ecc_mode"`
+	ECCErrors           ECCErrors     `xml:"ecc_errors"`
+	ClocksEventReasons  ClocksReasons `xml:"clocks_event_reasons"`
+	ThrottleReasons     ClocksReasons `xml:"clocks_throttle_reasons"`
+	Clocks              Clocks        `xml:"clocks"`
+	MaxClocks           Clocks        `xml:"max_clocks"`
+	Processes           []ProcessInfo `xml:"processes>process_info"`
+}
+
+// MIGMode is the current and pending MIG mode of a GPU.
+type MIGMode struct {
+	Current string `xml:"current_mig"`
+	Pending string `xml:"pending_mig"`
+}
+
+// MIGDevice is a MIG device of a GPU.
+type MIGDevice struct {
+	Index               int         `xml:"index"`
+	GPUInstanceID       int         `xml:"gpu_instance_id"`
+	ComputeInstanceID   int         `xml:"compute_instance_id"`
+	MultiprocessorCount int         `xml:"device_attributes>shared>multiprocessor_count"`
+	FBMemoryUsage       MemoryUsage `xml:"fb_memory_usage"`
+}
+
+// MemoryUsage is the memory usage of a GPU or a MIG device.
+type MemoryUsage struct {
+	Total    MiB `xml:"total"`
+	Reserved MiB `xml:"reserved"`
+	Used     MiB `xml:"used"`
+	Free     MiB `xml:"free"`
+}
+
+// ECCMode is the current and pending ECC mode of a GPU.
+type ECCMode struct {
+	Current string `xml:"current_ecc"`
+	Pending string `xml:"pending_ecc"`
+}
+
+// ECCErrors are the volatile and aggregate ECC error counters of a GPU.
+type ECCErrors struct {
+	Volatile  Counters `xml:"volatile"`
+	Aggregate Counters `xml:"aggregate"`
+}
+
+// Counters are named counters whose element names depend on the driver version.
+type Counters struct {
+	Values []Value `xml:",any"`
+}
+
+// ClocksReasons are the reasons reducing the clocks of a GPU, reported as clocks_event_reasons since driver 535
+// and as clocks_throttle_reasons before.
+type ClocksReasons struct {
+	Values []Value `xml:",any"`
+}
+
+// Value is a named value of the nvidia-smi XML query output.
+type Value struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+// Clocks are the clocks of a GPU.
+type Clocks struct {
+	Graphics MHz `xml:"graphics_clock"`
+	SM       MHz `xml:"sm_clock"`
+	Memory   MHz `xml:"mem_clock"`
+	Video    MHz `xml:"video_clock"`
+}
+
+// ProcessInfo is a process running on a GPU.
+type ProcessInfo struct {
+	GPUInstanceID     string `xml:"gpu_instance_id"`
+	ComputeInstanceID string `xml:"compute_instance_id"`
+	PID               int    `xml:"pid"`
+	Type              string `xml:"type"`
+	ProcessName       string `xml:"process_name"`
+	UsedMemory        MiB    `xml:"used_memory"`
+}
+
+// MiB is an amount of memory in MiB. The values not available are zero.
+type MiB int64
+
+// UnmarshalText parses an nvidia-smi memory value such as "23028 MiB".
+func (value *MiB) UnmarshalText(text []byte) error {
+	parsed, err := parseUnit(string(text), "MiB")
+	*value = MiB(parsed)
+
+	return err
+}
+
+// MHz is a clock frequency in MHz. The values not available are zero.
+type MHz int64
+
+// UnmarshalText parses an nvidia-smi clock value such as "1710 MHz".
+func (value *MHz) UnmarshalText(text []byte) error {
+	parsed, err := parseUnit(string(text), "MHz")
+	*value = MHz(parsed)
+
+	return err
+}
+
+// Parse decodes the output of the nvidia-smi XML query.
+func Parse(output string) (*Log, error) {
+	smiLog := &Log{}
+
+	if err := xml.Unmarshal([]byte(strings.TrimSpace(output)), smiLog); err != nil {
+		return nil, fmt.Errorf("failed to decode nvidia-smi XML output: %w", err)
+	}
+
+	return smiLog, nil
+}
+
+// Query runs the nvidia-smi XML query in the given container of the pod and decodes its output.
+// The first container of the pod is used when no container name is given.
+func Query(podBuilder *pod.Builder, containerName ...string) (*Log, error) {
+	return QueryWithContext(context.TODO(), podBuilder, containerName...)
+}
+
+// QueryWithContext is the context-aware variant of Query.
+func QueryWithContext(ctx context.Context, podBuilder *pod.Builder, containerName ...string) (*Log, error) {
+	if podBuilder == nil || podBuilder.Object == nil {
+		return nil, fmt.Errorf("cannot run nvidia-smi in a pod which does not exist")
+	}
+
+	logger.V(logging.LevelDebug).Info("Querying the GPUs with nvidia-smi", "pod", podBuilder.Object.Name,
+		"namespace", podBuilder.Object.Namespace)
+
+	output, err := podBuilder.ExecCommandWithContext(ctx, QueryCommand, containerName...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run nvidia-smi in pod %s: %w", podBuilder.Object.Name, err)
+	}
+
+	return Parse(output.String())
+}
+
+// GPU returns the GPU of the given UUID, or nil if there is none.
+func (smiLog *Log) GPU(uuid string) *GPU {
+	for index := range smiLog.GPUs {
+		if smiLog.GPUs[index].UUID == uuid {
+			return &smiLog.GPUs[index]
+		}
+	}
+
+	return nil
+}
+
+// UUIDs returns the UUIDs of the GPUs.
+func (smiLog *Log) UUIDs() []string {
+	uuids := make([]string, 0, len(smiLog.GPUs))
+
+	for _, gpu := range smiLog.GPUs {
+		uuids = append(uuids, gpu.UUID)
+	}
+
+	return uuids
+}
+
+// MIGDeviceCount returns the number of MIG devices of all the GPUs.
+func (smiLog *Log) MIGDeviceCount() int {
+	count := 0
+
+	for _, gpu := range smiLog.GPUs {
+		count += len(gpu.MIGDevices)
+	}
+
+	return count
+}
+
+// Processes returns the processes running on all the GPUs.
+func (smiLog *Log) Processes() []ProcessInfo {
+	var processes []ProcessInfo
+
+	for _, gpu := range smiLog.GPUs {
+		processes = append(processes, gpu.Processes...)
+	}
+
+	return processes
+}
+
+// MIGEnabled returns true if the MIG mode of the GPU is currently enabled.
+func (gpu *GPU) MIGEnabled() bool {
+	return gpu.MIGMode.Current == Enabled
+}
+
+// ECCEnabled returns true if the ECC mode of the GPU is currently enabled.
+func (gpu *GPU) ECCEnabled() bool {
+	return gpu.ECCMode.Current == Enabled
+}
+
+// ActiveClocksReasons returns the active reasons reducing the clocks of the GPU, without their
+// clocks_event_reason_ or clocks_throttle_reason_ prefix, for instance gpu_idle or hw_thermal_slowdown.
+func (gpu *GPU) ActiveClocksReasons() []string {
+	var reasons []string
+
+	for _, value := range append(gpu.ClocksEventReasons.Values, gpu.ThrottleReasons.Values...) {
+		if strings.TrimSpace(value.Value) != Active {
+			continue
+		}
+
+		reason := strings.TrimPrefix(value.XMLName.Local, "clocks_event_reason_")
+		reasons = append(reasons, strings.TrimPrefix(reason, "clocks_throttle_reason_"))
+	}
+
+	return reasons
+}
+
+// Uncorrectable returns the sum of the uncorrectable error counters.
+func (counters Counters) Uncorrectable() int64 {
+	return counters.sum("uncorrectable")
+}
+
+// Correctable returns the sum of the correctable error counters.
+func (counters Counters) Correctable() int64 {
+	return counters.sum("_correctable")
+}
+
+// sum returns the sum of the numeric counters whose name contains the given string.
+func (counters Counters) sum(name string) int64 {
+	var total int64
+
+	for _, value := range counters.Values {
+		if !strings.Contains(value.XMLName.Local, name) {
+			continue
+		}
+
+		if count, err := strconv.ParseInt(strings.TrimSpace(value.Value), 10, 64); err == nil {
+			total += count
+		}
+	}
+
+	return total
+}
+
+// IsMPS returns true if the process runs as an MPS client or server.
+func (process ProcessInfo) IsMPS() bool {
+	return strings.HasPrefix(process.Type, "M")
+}
+
+// parseUnit parses a value such as "23028 MiB" in the given unit. The values not available are zero.
+func parseUnit(text, unit string) (int64, error) {
+	text = strings.TrimSpace(text)
+	if text == "" || text == NotAvailable {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseInt(strings.TrimSpace(strings.TrimSuffix(text, unit)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid nvidia-smi %s value '%s': %w", unit, text, err)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,124 @@
+package nvidiasmi
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func parseFixture(t *testing.T, name string) *Log {
+	t.Helper()
+
+	fixture, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %v", name, err)
+	}
+
+	// The pod exec runs with a TTY, which turns the line feeds into carriage return line feeds.
+	smiLog, err := Parse(strings.ReplaceAll(string(fixture), "\n", "\r\n"))
+	if err != nil {
+		t.Fatalf("unexpected error parsing fixture %s: %v", name, err)
+	}
+
+	return smiLog
+}
+
+func TestParseMPS(t *testing.T) {
+	smiLog := parseFixture(t, "a10g-mps.xml")
+
+	if smiLog.DriverVersion != "550.127.08" || smiLog.CUDAVersion != "12.4" || smiLog.AttachedGPUs != 1 ||
+		len(smiLog.GPUs) != 1 {
+		t.Fatalf("unexpected log %+v", smiLog)
+	}
+
+	gpu := smiLog.GPU("GPU-5c89852c-d268-c3f3-1b07-005d5ae1dc3f")
+	if gpu == nil {
+		t.Fatalf("GPU not found by UUID in %v", smiLog.UUIDs())
+	}
+
+	if gpu.ID != "00000000:00:1E.0" || gpu.ProductName != "NVIDIA A10G" || gpu.ProductArchitecture != "Ampere" ||
+		gpu.ComputeMode != "Exclusive_Process" || gpu.MIGEnabled() || len(gpu.MIGDevices) != 0 {
+		t.Errorf("unexpected GPU properties %+v", gpu)
+	}
+
+	if gpu.FBMemoryUsage != (MemoryUsage{Total: 23028, Reserved: 307, Used: 1243, Free: 21478}) {
+		t.Errorf("unexpected memory usage %+v", gpu.FBMemoryUsage)
+	}
+
+	if gpu.Clocks != (Clocks{Graphics: 1710, SM: 1710, Memory: 6250, Video: 1500}) || gpu.MaxClocks.Memory != 6251 {
+		t.Errorf("unexpected clocks %+v, max clocks %+v", gpu.Clocks, gpu.MaxClocks)
+	}
+
+	if !gpu.ECCEnabled() || gpu.ECCErrors.Volatile.Correctable() != 3 || gpu.ECCErrors.Volatile.Uncorrectable() != 0 ||
+		gpu.ECCErrors.Aggregate.Correctable() != 5 || gpu.ECCErrors.Aggregate.Uncorrectable() != 1 {
+		t.Errorf("unexpected ECC mode %+v or errors %+v", gpu.ECCMode, gpu.ECCErrors)
+	}
+
+	if reasons := gpu.ActiveClocksReasons(); !reflect.DeepEqual(reasons, []string{"sw_power_cap"}) {
+		t.Errorf("unexpected active clocks reasons %v", reasons)
+	}
+
+	processes := smiLog.Processes()
+	if len(processes) != 3 {
+		t.Fatalf("expected 3 processes, got %+v", processes)
+	}
+
+	if processes[0].IsMPS() || processes[0].Type != ComputeProcess {
+		t.Errorf("expected the MPS server to be a compute process, got %+v", processes[0])
+	}
+
+	for _, process := range processes[1:] {
+		if process.ProcessName != "python" || process.Type != MPSComputeProcess || !process.IsMPS() ||
+			process.UsedMemory != 606 || process.GPUInstanceID != NotAvailable {
+			t.Errorf("unexpected MPS client process %+v", process)
+		}
+	}
+}
+
+func TestParseMIG(t *testing.T) {
+	smiLog := parseFixture(t, "a100-mig.xml")
+
+	if smiLog.DriverVersion != "525.105.17" || len(smiLog.GPUs) != 2 || smiLog.MIGDeviceCount() != 2 {
+		t.Fatalf("unexpected log %+v", smiLog)
+	}
+
+	migGPU := smiLog.GPUs[0]
+	if !migGPU.MIGEnabled() || migGPU.MIGMode.Pending != Enabled {
+		t.Errorf("expected MIG mode to be enabled, got %+v", migGPU.MIGMode)
+	}
+
+	expectedDevice := MIGDevice{Index: 1, GPUInstanceID: 2, ComputeInstanceID: 0, MultiprocessorCount: 42,
+		FBMemoryUsage: MemoryUsage{Total: 19968, Used: 4131, Free: 15836}}
+	if migGPU.MIGDevices[1] != expectedDevice {
+		t.Errorf("unexpected MIG device %+v", migGPU.MIGDevices[1])
+	}
+
+	if migGPU.ECCErrors.Volatile.Uncorrectable() != 0 {
+		t.Errorf("expected the N/A volatile ECC counters to be ignored, got %+v", migGPU.ECCErrors.Volatile)
+	}
+
+	if process := migGPU.Processes[0]; process.GPUInstanceID != "2" || process.PID != 918273 ||
+		process.UsedMemory != 4094 {
+		t.Errorf("unexpected MIG device process %+v", process)
+	}
+
+	pendingGPU := smiLog.GPUs[1]
+	if pendingGPU.MIGEnabled() || pendingGPU.MIGMode.Pending != Enabled || len(pendingGPU.Processes) != 0 {
+		t.Errorf("expected MIG mode to be pending, got %+v", pendingGPU.MIGMode)
+	}
+
+	reasons := pendingGPU.ActiveClocksReasons()
+	if !reflect.DeepEqual(reasons, []string{"gpu_idle", "hw_thermal_slowdown"}) {
+		t.Errorf("unexpected active throttle reasons %v", reasons)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, output := range []string{"", "No devices were found", "<nvidia_smi_log><gpu>",
+		"<nvidia_smi_log><gpu><fb_memory_usage><total>lots</total></fb_memory_usage></gpu></nvidia_smi_log>"} {
+		if _, err := Parse(output); err == nil {
+			t.Errorf("expected error parsing '%s'", output)
+		}
+	}
+}
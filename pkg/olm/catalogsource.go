@@ -6,9 +6,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/go-logr/logr"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"k8s.io/apimachinery/pkg/util/wait"
 
-	"github.com/golang/glog"
 	oplmV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/msg"
@@ -32,7 +33,7 @@ type CatalogSourceBuilder struct {
 
 // NewCatalogSourceBuilder creates new instance of CatalogSourceBuilder.
 func NewCatalogSourceBuilder(apiClient *clients.Settings, name, nsname string) *CatalogSourceBuilder {
-	glog.V(100).Infof("Initializing new %s catalogsource structure", name)
+	logger.V(logging.LevelTrace).Info("Initializing new catalogsource structure", "name", name)
 
 	builder := CatalogSourceBuilder{
 		apiClient: apiClient,
@@ -45,13 +46,13 @@ func NewCatalogSourceBuilder(apiClient *clients.Settings, name, nsname string) *
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the catalogsource is empty")
+		logger.V(logging.LevelTrace).Info("The name of the catalogsource is empty")
 
 		builder.errorMsg = "catalogsource 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The nsname of the catalogsource is empty")
+		logger.V(logging.LevelTrace).Info("The nsname of the catalogsource is empty")
 
 		builder.errorMsg = "catalogsource 'nsname' cannot be empty"
 	}
@@ -62,9 +63,8 @@ func NewCatalogSourceBuilder(apiClient *clients.Settings, name, nsname string) *
 // NewCatalogSourceBuilderWithIndexImage creates new instance of CatalogSourceBuilder.
 func NewCatalogSourceBuilderWithIndexImage(apiClient *clients.Settings,
 	name, nsname, indexImage, displayName, publisher string) *CatalogSourceBuilder {
-	glog.V(100).Infof("Initializing new catalogsource structure with "+
-		"name '%s', namespace '%s', index image '%s', display name '%s', and publisher '%s'",
-		name, nsname, indexImage, displayName, publisher)
+	logger.V(logging.LevelTrace).Info("Initializing new catalogsource structure", "name", name, "namespace", nsname,
+		"indexImage", indexImage, "displayName", displayName, "publisher", publisher)
 
 	builder := CatalogSourceBuilder{
 		apiClient: apiClient,
@@ -83,31 +83,31 @@ func NewCatalogSourceBuilderWithIndexImage(apiClient *clients.Settings,
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the catalogsource is empty")
+		logger.V(logging.LevelTrace).Info("The name of the catalogsource is empty")
 
 		builder.errorMsg = "catalogsource 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The nsname of the catalogsource is empty")
+		logger.V(logging.LevelTrace).Info("The nsname of the catalogsource is empty")
 
 		builder.errorMsg = "catalogsource 'nsname' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The nsname of the catalogsource is empty")
+		logger.V(logging.LevelTrace).Info("The nsname of the catalogsource is empty")
 
 		builder.errorMsg = "catalogsource 'nsname' cannot be empty"
 	}
 
 	if displayName == "" {
-		glog.V(100).Infof("The display name of the catalogsource is empty")
+		logger.V(logging.LevelTrace).Info("The display name of the catalogsource is empty")
 
 		builder.errorMsg = "catalogsource 'display' cannot be empty"
 	}
 
 	if publisher == "" {
-		glog.V(100).Infof("The publisher of the catalogsource is empty")
+		logger.V(logging.LevelTrace).Info("The publisher of the catalogsource is empty")
 
 		builder.errorMsg = "catalogsource 'publisher' cannot be empty"
 	}
@@ -118,7 +118,14 @@ func NewCatalogSourceBuilderWithIndexImage(apiClient *clients.Settings,
 // PullCatalogSource loads an existing catalogsource into Builder struct.
 func PullCatalogSource(apiClient *clients.Settings, name, nsname string) (*CatalogSourceBuilder,
 	error) {
-	glog.V(100).Infof("Pulling existing catalogsource name %s in namespace %s", name, nsname)
+	return PullCatalogSourceWithContext(context.TODO(), apiClient, name, nsname)
+}
+
+// PullCatalogSourceWithContext is the context-aware variant of PullCatalogSource.
+func PullCatalogSourceWithContext(
+	ctx context.Context, apiClient *clients.Settings, name, nsname string) (*CatalogSourceBuilder,
+	error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing catalogsource", "name", name, "namespace", nsname)
 
 	builder := CatalogSourceBuilder{
 		apiClient: apiClient,
@@ -138,7 +145,7 @@ func PullCatalogSource(apiClient *clients.Settings, name, nsname string) (*Catal
 		builder.errorMsg = "catalogsource 'namespace' cannot be empty"
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("catalogsource object %s doesn't exist in namespace %s", name, nsname)
 	}
 
@@ -149,16 +156,26 @@ func PullCatalogSource(apiClient *clients.Settings, name, nsname string) (*Catal
 
 // Create makes an CatalogSourceBuilder in cluster and stores the created object in struct.
 func (builder *CatalogSourceBuilder) Create() (*CatalogSourceBuilder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *CatalogSourceBuilder) CreateWithContext(ctx context.Context) (*CatalogSourceBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the catalogsource %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Creating the catalogsource")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunCreate, builder.Definition)
+	}
 
 	var err error
-	if !builder.Exists() {
-		builder.Object, err = builder.apiClient.CatalogSources(builder.Definition.Namespace).Create(context.TODO(),
+	if !builder.ExistsWithContext(ctx) {
+		builder.Object, err = builder.apiClient.CatalogSources(builder.Definition.Namespace).Create(ctx,
 			builder.Definition, metav1.CreateOptions{})
 	}
 
@@ -167,36 +184,47 @@ func (builder *CatalogSourceBuilder) Create() (*CatalogSourceBuilder, error) {
 
 // Exists checks whether the given catalogsource exists.
 func (builder *CatalogSourceBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *CatalogSourceBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof(
-		"Checking if catalogSource %s exists",
-		builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Checking if catalogSource exists")
 
 	var err error
 	builder.Object, err = builder.apiClient.OperatorsV1alpha1Interface.CatalogSources(
 		builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete removes a catalogsource.
 func (builder *CatalogSourceBuilder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *CatalogSourceBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting catalogsource %s in namespace %s", builder.Definition.Name,
-		builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Deleting catalogsource")
 
-	if !builder.Exists() {
+	if builder.apiClient.IsDryRun() {
+		return builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
+
+	if !builder.ExistsWithContext(ctx) {
 		return nil
 	}
 
-	err := builder.apiClient.CatalogSources(builder.Definition.Namespace).Delete(context.TODO(),
+	err := builder.apiClient.CatalogSources(builder.Definition.Namespace).Delete(ctx,
 		builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
@@ -210,22 +238,26 @@ func (builder *CatalogSourceBuilder) Delete() error {
 
 // IsReady periodically checks if catalogsource is in Ready state.
 func (builder *CatalogSourceBuilder) IsReady(timeout time.Duration) bool {
+	return builder.IsReadyWithContext(context.TODO(), timeout)
+}
+
+// IsReadyWithContext is the context-aware variant of IsReady.
+func (builder *CatalogSourceBuilder) IsReadyWithContext(ctx context.Context, timeout time.Duration) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof("Running periodic check until catalogsource '%s' in namespace '%s' is ready",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Running periodic check until catalogsource is ready")
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return false
 	}
 
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			var err error
 			builder.Object, err = builder.apiClient.CatalogSources(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 
 			if err != nil {
 				return false, err
@@ -241,31 +273,41 @@ func (builder *CatalogSourceBuilder) IsReady(timeout time.Duration) bool {
 	return err == nil
 }
 
+// log returns the logger with the CatalogSource kind, name and namespace fields.
+func (builder *CatalogSourceBuilder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", "CatalogSource")
+	}
+
+	return logging.WithResource(logger, "CatalogSource", builder.Definition.Name, builder.Definition.Namespace)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *CatalogSourceBuilder) validate() (bool, error) {
 	resourceCRD := "catalogsource"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder apiclient is nil", "kind", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelTrace).Info("The builder has error message", "kind", resourceCRD,
+			"error", builder.errorMsg)
 
 		return false, errors.New(builder.errorMsg)
 	}
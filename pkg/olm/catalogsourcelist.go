@@ -4,18 +4,27 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ListCatalogSources returns catalogsource inventory in the given namespace.
 func ListCatalogSources(
+	apiClient *clients.Settings,
+	nsname string,
+	options ...metav1.ListOptions) ([]*CatalogSourceBuilder, error) {
+	return ListCatalogSourcesWithContext(context.TODO(), apiClient, nsname, options...)
+}
+
+// ListCatalogSourcesWithContext is the context-aware variant of ListCatalogSources.
+func ListCatalogSourcesWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	nsname string,
 	options ...metav1.ListOptions) ([]*CatalogSourceBuilder, error) {
 	if nsname == "" {
-		glog.V(100).Infof("catalogsource 'namespace' parameter can not be empty")
+		logger.V(logging.LevelTrace).Info("catalogsource 'namespace' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list catalogsource, 'namespace' parameter is empty")
 	}
@@ -24,7 +33,7 @@ func ListCatalogSources(
 	logMessage := fmt.Sprintf("Listing catalogsource in the namespace %s", nsname)
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logger.V(logging.LevelTrace).Info("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -34,13 +43,13 @@ func ListCatalogSources(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logger.V(logging.LevelTrace).Info(logMessage)
 
 	catalogSourceList, err := apiClient.OperatorsV1alpha1Interface.CatalogSources(nsname).List(
-		context.TODO(), passedOptions)
+		ctx, passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list catalogsources in the namespace %s due to %s", nsname, err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to list catalogsources", "namespace", nsname, "error", err)
 
 		return nil, err
 	}
@@ -5,9 +5,10 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/go-logr/logr"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/golang/glog"
 	oplmV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/msg"
@@ -31,7 +32,14 @@ type ClusterServiceVersionBuilder struct {
 // PullClusterServiceVersion loads an existing clusterserviceversion into Builder struct.
 func PullClusterServiceVersion(apiClient *clients.Settings, name, namespace string) (*ClusterServiceVersionBuilder,
 	error) {
-	glog.V(100).Infof("Pulling existing clusterserviceversion name %s in namespace %s", name, namespace)
+	return PullClusterServiceVersionWithContext(context.TODO(), apiClient, name, namespace)
+}
+
+// PullClusterServiceVersionWithContext is the context-aware variant of PullClusterServiceVersion.
+func PullClusterServiceVersionWithContext(
+	ctx context.Context, apiClient *clients.Settings, name, namespace string) (*ClusterServiceVersionBuilder,
+	error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing clusterserviceversion", "name", name, "namespace", namespace)
 
 	builder := ClusterServiceVersionBuilder{
 		apiClient: apiClient,
@@ -51,7 +59,7 @@ func PullClusterServiceVersion(apiClient *clients.Settings, name, namespace stri
 		builder.errorMsg = "clusterserviceversion 'namespace' cannot be empty"
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("clusterserviceversion object %s doesn't exist in namespace %s", name, namespace)
 	}
 
@@ -62,36 +70,47 @@ func PullClusterServiceVersion(apiClient *clients.Settings, name, namespace stri
 
 // Exists checks whether the given clusterserviceversion exists.
 func (builder *ClusterServiceVersionBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *ClusterServiceVersionBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof(
-		"Checking if clusterserviceversion %s exists",
-		builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Checking if clusterserviceversion exists")
 
 	var err error
 	builder.Object, err = builder.apiClient.OperatorsV1alpha1Interface.ClusterServiceVersions(
 		builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete removes a clusterserviceversion.
 func (builder *ClusterServiceVersionBuilder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *ClusterServiceVersionBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting clusterserviceversion %s in namespace %s", builder.Definition.Name,
-		builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Deleting clusterserviceversion")
 
-	if !builder.Exists() {
+	if builder.apiClient.IsDryRun() {
+		return builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
+
+	if !builder.ExistsWithContext(ctx) {
 		return nil
 	}
 
-	err := builder.apiClient.ClusterServiceVersions(builder.Definition.Namespace).Delete(context.TODO(),
+	err := builder.apiClient.ClusterServiceVersions(builder.Definition.Namespace).Delete(ctx,
 		builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
@@ -105,16 +124,20 @@ func (builder *ClusterServiceVersionBuilder) Delete() error {
 
 // GetAlmExamples extracts and returns the alm-examples block from the clusterserviceversion.
 func (builder *ClusterServiceVersionBuilder) GetAlmExamples() (string, error) {
+	return builder.GetAlmExamplesWithContext(context.TODO())
+}
+
+// GetAlmExamplesWithContext is the context-aware variant of GetAlmExamples.
+func (builder *ClusterServiceVersionBuilder) GetAlmExamplesWithContext(ctx context.Context) (string, error) {
 	if valid, err := builder.validate(); !valid {
 		return "", err
 	}
 
-	glog.V(100).Infof("Extracting the 'alm-examples' section from clusterserviceversion %s in "+
-		"namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Extracting the 'alm-examples' section from clusterserviceversion")
 
 	almExamples := "alm-examples"
 
-	if builder.Exists() {
+	if builder.ExistsWithContext(ctx) {
 		annotations := builder.Object.ObjectMeta.GetAnnotations()
 
 		if example, ok := annotations[almExamples]; ok {
@@ -128,14 +151,18 @@ func (builder *ClusterServiceVersionBuilder) GetAlmExamples() (string, error) {
 
 // IsSuccessful checks if the clusterserviceversion is Successful.
 func (builder *ClusterServiceVersionBuilder) IsSuccessful() (bool, error) {
+	return builder.IsSuccessfulWithContext(context.TODO())
+}
+
+// IsSuccessfulWithContext is the context-aware variant of IsSuccessful.
+func (builder *ClusterServiceVersionBuilder) IsSuccessfulWithContext(ctx context.Context) (bool, error) {
 	if valid, err := builder.validate(); !valid {
 		return false, err
 	}
 
-	glog.V(100).Infof("Verify clusterserviceversion %s in namespace %s is Successful",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Verify clusterserviceversion is Successful")
 
-	phase, err := builder.GetPhase()
+	phase, err := builder.GetPhaseWithContext(ctx)
 
 	if err != nil {
 		return false, fmt.Errorf("failed to get phase value for %s clusterserviceversion in %s namespace due to %w",
@@ -147,14 +174,19 @@ func (builder *ClusterServiceVersionBuilder) IsSuccessful() (bool, error) {
 
 // GetPhase gets current clusterserviceversion phase.
 func (builder *ClusterServiceVersionBuilder) GetPhase() (oplmV1alpha1.ClusterServiceVersionPhase, error) {
+	return builder.GetPhaseWithContext(context.TODO())
+}
+
+// GetPhaseWithContext is the context-aware variant of GetPhase.
+func (builder *ClusterServiceVersionBuilder) GetPhaseWithContext(
+	ctx context.Context) (oplmV1alpha1.ClusterServiceVersionPhase, error) {
 	if valid, err := builder.validate(); !valid {
 		return "", err
 	}
 
-	glog.V(100).Infof("Get clusterserviceversion %s phase in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Get clusterserviceversion phase")
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return "", fmt.Errorf("%s clusterserviceversion not found in %s namespace",
 			builder.Definition.Name, builder.Definition.Namespace)
 	}
@@ -162,31 +194,41 @@ func (builder *ClusterServiceVersionBuilder) GetPhase() (oplmV1alpha1.ClusterSer
 	return builder.Object.Status.Phase, nil
 }
 
+// log returns the logger with the ClusterServiceVersion kind, name and namespace fields.
+func (builder *ClusterServiceVersionBuilder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", "ClusterServiceVersion")
+	}
+
+	return logging.WithResource(logger, "ClusterServiceVersion", builder.Definition.Name, builder.Definition.Namespace)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClusterServiceVersionBuilder) validate() (bool, error) {
 	resourceCRD := "ClusterServiceVersion"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder apiclient is nil", "kind", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelTrace).Info("The builder has error message", "kind", resourceCRD,
+			"error", builder.errorMsg)
 
 		return false, errors.New(builder.errorMsg)
 	}
@@ -5,18 +5,27 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/golang/glog"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ListClusterServiceVersion returns clusterserviceversion inventory in the given namespace.
 func ListClusterServiceVersion(
+	apiClient *clients.Settings,
+	nsname string,
+	options ...metav1.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
+	return ListClusterServiceVersionWithContext(context.TODO(), apiClient, nsname, options...)
+}
+
+// ListClusterServiceVersionWithContext is the context-aware variant of ListClusterServiceVersion.
+func ListClusterServiceVersionWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	nsname string,
 	options ...metav1.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
 	if nsname == "" {
-		glog.V(100).Infof("clusterserviceversion 'nsname' parameter can not be empty")
+		logger.V(logging.LevelTrace).Info("clusterserviceversion 'nsname' parameter can not be empty")
 
 		return nil, fmt.Errorf("failed to list clusterserviceversion, 'nsname' parameter is empty")
 	}
@@ -25,7 +34,7 @@ func ListClusterServiceVersion(
 	logMessage := fmt.Sprintf("Listing clusterserviceversion in the namespace %s", nsname)
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logger.V(logging.LevelTrace).Info("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -35,13 +44,13 @@ func ListClusterServiceVersion(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logger.V(logging.LevelTrace).Info(logMessage)
 
 	csvList, err := apiClient.OperatorsV1alpha1Interface.ClusterServiceVersions(nsname).List(
-		context.TODO(), passedOptions)
+		ctx, passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list clusterserviceversion in the nsname %s due to %s", nsname, err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to list clusterserviceversion", "namespace", nsname, "error", err)
 
 		return nil, err
 	}
@@ -65,26 +74,38 @@ func ListClusterServiceVersion(
 // ListClusterServiceVersionWithNamePattern returns a cluster-wide clusterserviceversion inventory
 // filtered by the name pattern.
 func ListClusterServiceVersionWithNamePattern(
+	apiClient *clients.Settings,
+	namePattern string,
+	nsname string,
+	options ...metav1.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
+	return ListClusterServiceVersionWithNamePatternWithContext(context.TODO(), apiClient, namePattern, nsname,
+		options...)
+}
+
+// ListClusterServiceVersionWithNamePatternWithContext is the context-aware variant of
+// ListClusterServiceVersionWithNamePattern.
+func ListClusterServiceVersionWithNamePatternWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	namePattern string,
 	nsname string,
 	options ...metav1.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
 	if namePattern == "" {
-		glog.V(100).Info(
+		logger.V(logging.LevelTrace).Info(
 			"The namePattern field to filter out all relevant clusterserviceversion cannot be empty")
 
 		return nil, fmt.Errorf(
 			"the namePattern field to filter out all relevant clusterserviceversion cannot be empty")
 	}
 
-	glog.V(100).Infof("Listing clusterserviceversion filtered by the name pattern %s in %s namespace",
-		namePattern, nsname)
+	logger.V(logging.LevelTrace).Info("Listing clusterserviceversion filtered by the name pattern",
+		"namePattern", namePattern, "namespace", nsname)
 
-	notFilteredCsvList, err := ListClusterServiceVersion(apiClient, nsname, options...)
+	notFilteredCsvList, err := ListClusterServiceVersionWithContext(ctx, apiClient, nsname, options...)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list all clusterserviceversions in namespace %s due to %s",
-			nsname, err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to list all clusterserviceversions", "namespace", nsname,
+			"error", err)
 
 		return nil, err
 	}
@@ -102,13 +123,22 @@ func ListClusterServiceVersionWithNamePattern(
 
 // ListClusterServiceVersionInAllNamespaces returns cluster-wide clusterserviceversion inventory.
 func ListClusterServiceVersionInAllNamespaces(
+	apiClient *clients.Settings,
+	options ...metav1.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
+	return ListClusterServiceVersionInAllNamespacesWithContext(context.TODO(), apiClient, options...)
+}
+
+// ListClusterServiceVersionInAllNamespacesWithContext is the context-aware variant of
+// ListClusterServiceVersionInAllNamespaces.
+func ListClusterServiceVersionInAllNamespacesWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	options ...metav1.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
 	passedOptions := metav1.ListOptions{}
 	logMessage := "Listing CSVs in all namespaces"
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logger.V(logging.LevelTrace).Info("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -118,12 +148,12 @@ func ListClusterServiceVersionInAllNamespaces(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logger.V(logging.LevelTrace).Info(logMessage)
 
-	csvList, err := apiClient.ClusterServiceVersions("").List(context.TODO(), passedOptions)
+	csvList, err := apiClient.ClusterServiceVersions("").List(ctx, passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list CSVs in all namespaces due to %s", err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to list CSVs in all namespaces", "error", err)
 
 		return nil, err
 	}
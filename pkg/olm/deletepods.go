@@ -3,33 +3,42 @@ package olm
 import (
 	"context"
 
-	"github.com/golang/glog"
 	_ "github.com/rh-ecosystem-edge/nvidia-ci/internal/check"
 	_ "github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
 
-	_ "github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
-	"gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/logging"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func DeleteOLMPods(apiClient *clients.Settings, logLevel logging.Level) error {
-	log := glog.V(glog.Level(logLevel))
+func DeleteOLMPods(apiClient *clients.Settings) error {
+	return DeleteOLMPodsWithContext(context.TODO(), apiClient)
+}
+
+// DeleteOLMPodsWithContext is the context-aware variant of DeleteOLMPods.
+func DeleteOLMPodsWithContext(ctx context.Context, apiClient *clients.Settings) error {
 	olmNamespace := "openshift-operator-lifecycle-manager"
-	log.Info("Deleting catalog operator pods")
-	if err := apiClient.Pods(olmNamespace).DeleteCollection(context.TODO(),
+
+	if apiClient.IsDryRun() {
+		logger.V(logging.LevelDebug).Info("Dry-run: not deleting the OLM pods", "namespace", olmNamespace)
+
+		return nil
+	}
+
+	logger.V(logging.LevelDebug).Info("Deleting catalog operator pods", "namespace", olmNamespace)
+	if err := apiClient.Pods(olmNamespace).DeleteCollection(ctx,
 		metav1.DeleteOptions{},
 		metav1.ListOptions{LabelSelector: "app=catalog-operator"}); err != nil {
-		glog.Errorf("Error deleting catalog operator pods: %v", err)
+		logger.Error(err, "Error deleting catalog operator pods", "namespace", olmNamespace)
 		return err
 	}
 
-	log.Info("Deleting OLM operator pods")
+	logger.V(logging.LevelDebug).Info("Deleting OLM operator pods", "namespace", olmNamespace)
 	if err := apiClient.Pods(olmNamespace).DeleteCollection(
-		context.TODO(),
+		ctx,
 		metav1.DeleteOptions{},
 		metav1.ListOptions{LabelSelector: "app=olm-operator"}); err != nil {
-		glog.Errorf("Error deleting OLM operator pods: %v", err)
+		logger.Error(err, "Error deleting OLM operator pods", "namespace", olmNamespace)
 		return err
 	}
 
@@ -5,10 +5,11 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/go-logr/logr"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"github.com/golang/glog"
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,7 +30,7 @@ type InstallPlanBuilder struct {
 
 // NewInstallPlanBuilder creates new instance of InstallPlanBuilder.
 func NewInstallPlanBuilder(apiClient *clients.Settings, name, nsname string) *InstallPlanBuilder {
-	glog.V(100).Infof("Initializing new %s installplan structure", name)
+	logger.V(logging.LevelTrace).Info("Initializing new installplan structure", "name", name)
 
 	builder := InstallPlanBuilder{
 		apiClient: apiClient,
@@ -42,13 +43,13 @@ func NewInstallPlanBuilder(apiClient *clients.Settings, name, nsname string) *In
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The name of the installplan is empty")
+		logger.V(logging.LevelTrace).Info("The name of the installplan is empty")
 
 		builder.errorMsg = "installplan 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The nsname of the installplan is empty")
+		logger.V(logging.LevelTrace).Info("The nsname of the installplan is empty")
 
 		builder.errorMsg = "installplan 'nsname' cannot be empty"
 	}
@@ -58,16 +59,26 @@ func NewInstallPlanBuilder(apiClient *clients.Settings, name, nsname string) *In
 
 // Create makes an InstallPlanBuilder in cluster and stores the created object in struct.
 func (builder *InstallPlanBuilder) Create() (*InstallPlanBuilder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *InstallPlanBuilder) CreateWithContext(ctx context.Context) (*InstallPlanBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the InstallPlan %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Creating the InstallPlan")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunCreate, builder.Definition)
+	}
 
 	var err error
-	if !builder.Exists() {
-		builder.Object, err = builder.apiClient.InstallPlans(builder.Definition.Namespace).Create(context.TODO(),
+	if !builder.ExistsWithContext(ctx) {
+		builder.Object, err = builder.apiClient.InstallPlans(builder.Definition.Namespace).Create(ctx,
 			builder.Definition, metav1.CreateOptions{})
 	}
 
@@ -76,34 +87,46 @@ func (builder *InstallPlanBuilder) Create() (*InstallPlanBuilder, error) {
 
 // Exists checks whether the given installplan exists.
 func (builder *InstallPlanBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *InstallPlanBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if installplan %s exists in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Checking if installplan exists")
 
 	var err error
 	builder.Object, err = builder.apiClient.InstallPlans(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete removes an installplan.
 func (builder *InstallPlanBuilder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *InstallPlanBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting installplan %s in namespace %s", builder.Definition.Name,
-		builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Deleting installplan")
+
+	if builder.apiClient.IsDryRun() {
+		return builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil
 	}
 
-	err := builder.apiClient.InstallPlans(builder.Definition.Namespace).Delete(context.TODO(),
+	err := builder.apiClient.InstallPlans(builder.Definition.Namespace).Delete(ctx,
 		builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
@@ -117,45 +140,65 @@ func (builder *InstallPlanBuilder) Delete() error {
 
 // Update modifies the existing InstallPlanBuilder with the InstallPlan definition in InstallPlanBuilder.
 func (builder *InstallPlanBuilder) Update() (*InstallPlanBuilder, error) {
+	return builder.UpdateWithContext(context.TODO())
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (builder *InstallPlanBuilder) UpdateWithContext(ctx context.Context) (*InstallPlanBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating installPlan %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Updating installPlan")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunUpdate, builder.Definition)
+	}
 
 	var err error
 	builder.Object, err = builder.apiClient.InstallPlans(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		ctx, builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
 
+// log returns the logger with the InstallPlan kind, name and namespace fields.
+func (builder *InstallPlanBuilder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", "InstallPlan")
+	}
+
+	return logging.WithResource(logger, "InstallPlan", builder.Definition.Name, builder.Definition.Namespace)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *InstallPlanBuilder) validate() (bool, error) {
 	resourceCRD := "installplan"
 
 	if builder == nil {
-		glog.V(100).Infof("The builder %s is uninitialized", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The builder %s apiclient is nil", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder apiclient is nil", "kind", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The builder %s has error message: %w", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelTrace).Info("The builder has error message", "kind", resourceCRD,
+			"error", builder.errorMsg)
 
 		return false, errors.New(builder.errorMsg)
 	}
@@ -4,16 +4,23 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/golang/glog"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ListInstallPlan returns a list of installplans found for specific namespace.
 func ListInstallPlan(
+	apiClient *clients.Settings, nsname string, options ...v1.ListOptions) ([]*InstallPlanBuilder, error) {
+	return ListInstallPlanWithContext(context.TODO(), apiClient, nsname, options...)
+}
+
+// ListInstallPlanWithContext is the context-aware variant of ListInstallPlan.
+func ListInstallPlanWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings, nsname string, options ...v1.ListOptions) ([]*InstallPlanBuilder, error) {
 	if nsname == "" {
-		glog.V(100).Info("The nsname of the installplan is empty")
+		logger.V(logging.LevelTrace).Info("The nsname of the installplan is empty")
 
 		return nil, fmt.Errorf("the nsname of the installplan is empty")
 	}
@@ -22,7 +29,7 @@ func ListInstallPlan(
 	logMessage := fmt.Sprintf("Listing InstallPlans in namespace %s", nsname)
 
 	if len(options) > 1 {
-		glog.V(100).Infof("'options' parameter must be empty or single-valued")
+		logger.V(logging.LevelTrace).Info("'options' parameter must be empty or single-valued")
 
 		return nil, fmt.Errorf("error: more than one ListOptions was passed")
 	}
@@ -32,13 +39,12 @@ func ListInstallPlan(
 		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
 	}
 
-	glog.V(100).Infof(logMessage)
+	logger.V(logging.LevelTrace).Info(logMessage)
 
-	installPlanList, err := apiClient.InstallPlans(nsname).List(context.TODO(), passedOptions)
+	installPlanList, err := apiClient.InstallPlans(nsname).List(ctx, passedOptions)
 
 	if err != nil {
-		glog.V(100).Infof("Failed to list all installplan in namespace %s due to %s",
-			nsname, err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to list all installplan", "namespace", nsname, "error", err)
 
 		return nil, err
 	}
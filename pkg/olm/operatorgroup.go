@@ -5,7 +5,8 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/go-logr/logr"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
@@ -30,8 +31,8 @@ type OperatorGroupBuilder struct {
 
 // NewOperatorGroupBuilder returns an OperatorGroupBuilder struct.
 func NewOperatorGroupBuilder(apiClient *clients.Settings, groupName, nsName string) *OperatorGroupBuilder {
-	glog.V(100).Infof(
-		"Initializing new OperatorGroupBuilder structure with the following params: %s, %s", groupName, nsName)
+	logger.V(logging.LevelTrace).Info("Initializing new OperatorGroupBuilder structure", "name", groupName,
+		"namespace", nsName)
 
 	builder := &OperatorGroupBuilder{
 		apiClient: apiClient,
@@ -48,13 +49,13 @@ func NewOperatorGroupBuilder(apiClient *clients.Settings, groupName, nsName stri
 	}
 
 	if groupName == "" {
-		glog.V(100).Infof("The Name of the OperatorGroup is empty")
+		logger.V(logging.LevelTrace).Info("The Name of the OperatorGroup is empty")
 
 		builder.errorMsg = "OperatorGroup 'groupName' cannot be empty"
 	}
 
 	if nsName == "" {
-		glog.V(100).Infof("The Namespace of the OperatorGroup is empty")
+		logger.V(logging.LevelTrace).Info("The Namespace of the OperatorGroup is empty")
 
 		builder.errorMsg = "OperatorGroup 'Namespace' cannot be empty"
 	}
@@ -64,16 +65,26 @@ func NewOperatorGroupBuilder(apiClient *clients.Settings, groupName, nsName stri
 
 // Create makes an OperatorGroup in cluster and stores the created object in struct.
 func (builder *OperatorGroupBuilder) Create() (*OperatorGroupBuilder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *OperatorGroupBuilder) CreateWithContext(ctx context.Context) (*OperatorGroupBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the OperatorGroup %s",
-		builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Creating the OperatorGroup")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunCreate, builder.Definition)
+	}
 
 	var err error
-	if !builder.Exists() {
-		builder.Object, err = builder.apiClient.OperatorGroups(builder.Definition.Namespace).Create(context.TODO(),
+	if !builder.ExistsWithContext(ctx) {
+		builder.Object, err = builder.apiClient.OperatorGroups(builder.Definition.Namespace).Create(ctx,
 			builder.Definition, metav1.CreateOptions{})
 	}
 
@@ -82,35 +93,47 @@ func (builder *OperatorGroupBuilder) Create() (*OperatorGroupBuilder, error) {
 
 // Exists checks whether the given OperatorGroup exists.
 func (builder *OperatorGroupBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *OperatorGroupBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if OperatorGroup %s exists in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Checking if OperatorGroup exists")
 
 	var err error
 
 	builder.Object, err = builder.apiClient.OperatorGroups(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete removes an OperatorGroup.
 func (builder *OperatorGroupBuilder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *OperatorGroupBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting OperatorGroup %s in namespace %s", builder.Definition.Name,
-		builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Deleting OperatorGroup")
+
+	if builder.apiClient.IsDryRun() {
+		return builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil
 	}
 
-	err := builder.apiClient.OperatorGroups(builder.Definition.Namespace).Delete(context.TODO(), builder.Object.Name,
+	err := builder.apiClient.OperatorGroups(builder.Definition.Namespace).Delete(ctx, builder.Object.Name,
 		metav1.DeleteOptions{})
 
 	if err != nil {
@@ -124,24 +147,40 @@ func (builder *OperatorGroupBuilder) Delete() error {
 
 // Update modifies the existing OperatorGroup with the OperatorGroup definition in OperatorGroupBuilder.
 func (builder *OperatorGroupBuilder) Update() (*OperatorGroupBuilder, error) {
+	return builder.UpdateWithContext(context.TODO())
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (builder *OperatorGroupBuilder) UpdateWithContext(ctx context.Context) (*OperatorGroupBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating OperatorGroup %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Updating OperatorGroup")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunUpdate, builder.Definition)
+	}
 
 	var err error
 	builder.Object, err = builder.apiClient.OperatorGroups(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		ctx, builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
 
 // PullOperatorGroup loads existing OperatorGroup from cluster into the OperatorGroupBuilder struct.
 func PullOperatorGroup(apiClient *clients.Settings, groupName, nsName string) (*OperatorGroupBuilder, error) {
-	glog.V(100).Infof("Pulling existing OperatorGroup %s from cluster in namespace %s",
-		groupName, nsName)
+	return PullOperatorGroupWithContext(context.TODO(), apiClient, groupName, nsName)
+}
+
+// PullOperatorGroupWithContext is the context-aware variant of PullOperatorGroup.
+func PullOperatorGroupWithContext(
+	ctx context.Context, apiClient *clients.Settings, groupName, nsName string) (*OperatorGroupBuilder, error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing OperatorGroup from cluster", "name", groupName,
+		"namespace", nsName)
 
 	builder := &OperatorGroupBuilder{
 		apiClient: apiClient,
@@ -155,18 +194,18 @@ func PullOperatorGroup(apiClient *clients.Settings, groupName, nsName string) (*
 	}
 
 	if groupName == "" {
-		glog.V(100).Infof("The name of the OperatorGroup is empty")
+		logger.V(logging.LevelTrace).Info("The name of the OperatorGroup is empty")
 
 		builder.errorMsg = "OperatorGroup 'Name' cannot be empty"
 	}
 
 	if nsName == "" {
-		glog.V(100).Infof("The namespace of the OperatorGroup is empty")
+		logger.V(logging.LevelTrace).Info("The namespace of the OperatorGroup is empty")
 
 		builder.errorMsg = "OperatorGroup 'Namespace' cannot be empty"
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("OperatorGroup object named %s doesn't exist", nsName)
 	}
 
@@ -175,31 +214,41 @@ func PullOperatorGroup(apiClient *clients.Settings, groupName, nsName string) (*
 	return builder, nil
 }
 
+// log returns the logger with the OperatorGroup kind, name and namespace fields.
+func (builder *OperatorGroupBuilder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", "OperatorGroup")
+	}
+
+	return logging.WithResource(logger, "OperatorGroup", builder.Definition.Name, builder.Definition.Namespace)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *OperatorGroupBuilder) validate() (bool, error) {
 	resourceCRD := "OperatorGroup"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder apiclient is nil", "kind", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelTrace).Info("The builder has error message", "kind", resourceCRD,
+			"error", builder.errorMsg)
 
 		return false, errors.New(builder.errorMsg)
 	}
@@ -6,9 +6,10 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
+	"github.com/go-logr/logr"
 	pkgManifestV1 "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apis/operators/v1"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,7 +32,13 @@ type PackageManifestBuilder struct {
 
 // PullPackageManifest loads an existing PackageManifest into Builder struct.
 func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*PackageManifestBuilder, error) {
-	glog.V(100).Infof("Pulling existing PackageManifest name %s in namespace %s", name, nsname)
+	return PullPackageManifestWithContext(context.TODO(), apiClient, name, nsname)
+}
+
+// PullPackageManifestWithContext is the context-aware variant of PullPackageManifest.
+func PullPackageManifestWithContext(
+	ctx context.Context, apiClient *clients.Settings, name, nsname string) (*PackageManifestBuilder, error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing PackageManifest", "name", name, "namespace", nsname)
 
 	builder := &PackageManifestBuilder{
 		apiClient: apiClient,
@@ -44,18 +51,18 @@ func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*Pac
 	}
 
 	if name == "" {
-		glog.V(100).Infof("The Name of the PackageManifest is empty")
+		logger.V(logging.LevelTrace).Info("The Name of the PackageManifest is empty")
 
 		builder.errorMsg = "PackageManifest 'name' cannot be empty"
 	}
 
 	if nsname == "" {
-		glog.V(100).Infof("The Namespace of the PackageManifest is empty")
+		logger.V(logging.LevelTrace).Info("The Namespace of the PackageManifest is empty")
 
 		builder.errorMsg = "PackageManifest 'nsname' cannot be empty"
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("PackageManifest object %s doesn't exist in namespace %s", name, nsname)
 	}
 
@@ -67,10 +74,18 @@ func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*Pac
 // PullPackageManifestByCatalogWithTimeout loads an existing PackageManifest from specified catalog into Builder struct with timeout.
 func PullPackageManifestByCatalogWithTimeout(apiClient *clients.Settings, name, nsname,
 	catalog string, backoff time.Duration, timeout time.Duration) (*PackageManifestBuilder, error) {
-	glog.V(100).Infof("Pulling existing PackageManifest name %s in namespace %s and from catalog %s with backoff of %v and timeout of %v",
-		name, nsname, catalog, backoff, timeout)
+	return PullPackageManifestByCatalogWithTimeoutWithContext(context.TODO(), apiClient, name, nsname, catalog,
+		backoff, timeout)
+}
+
+// PullPackageManifestByCatalogWithTimeoutWithContext is the context-aware variant of
+// PullPackageManifestByCatalogWithTimeout.
+func PullPackageManifestByCatalogWithTimeoutWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname,
+	catalog string, backoff time.Duration, timeout time.Duration) (*PackageManifestBuilder, error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing PackageManifest from catalog with backoff and timeout",
+		"name", name, "namespace", nsname, "catalog", catalog, "backoff", backoff, "timeout", timeout)
 	if nsname == "" {
-		glog.V(100).Infof("packagemanifest 'nsname' parameter can not be empty")
+		logger.V(logging.LevelTrace).Info("packagemanifest 'nsname' parameter can not be empty")
 		return nil, fmt.Errorf("failed to list packagemanifests, 'nsname' parameter is empty")
 	}
 	passedOptions := metav1.ListOptions{
@@ -78,12 +93,12 @@ func PullPackageManifestByCatalogWithTimeout(apiClient *clients.Settings, name,
 		FieldSelector: fmt.Sprintf("metadata.name=%s", name),
 	}
 	logMessage := fmt.Sprintf("Listing PackageManifests in the namespace %s with the options %v", nsname, passedOptions)
-	glog.V(100).Infof(logMessage)
+	logger.V(logging.LevelTrace).Info(logMessage)
 	var pkgManifestList *pkgManifestV1.PackageManifestList
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), backoff, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, backoff, timeout, true, func(ctx context.Context) (bool, error) {
 			var err error
-			pkgManifestList, err = apiClient.PackageManifestInterface.PackageManifests(nsname).List(context.TODO(),
+			pkgManifestList, err = apiClient.PackageManifestInterface.PackageManifests(nsname).List(ctx,
 				passedOptions)
 			if err != nil {
 				return false, err
@@ -94,8 +109,7 @@ func PullPackageManifestByCatalogWithTimeout(apiClient *clients.Settings, name,
 			return false, nil
 		})
 	if err != nil {
-		glog.V(100).Infof("Failed to list PackageManifests in the namespace %s due to %s",
-			nsname, err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to list PackageManifests", "namespace", nsname, "error", err)
 		return nil, err
 	}
 	var pkgManifestObjects []*PackageManifestBuilder
@@ -109,11 +123,11 @@ func PullPackageManifestByCatalogWithTimeout(apiClient *clients.Settings, name,
 		pkgManifestObjects = append(pkgManifestObjects, pkgManifestBuilder)
 	}
 	if len(pkgManifestObjects) == 0 {
-		glog.V(100).Infof("The list of matching PackageManifests is empty")
+		logger.V(logging.LevelTrace).Info("The list of matching PackageManifests is empty")
 		return nil, fmt.Errorf("no matching PackageManifests were found")
 	}
 	if len(pkgManifestObjects) > 1 {
-		glog.V(100).Infof("More than one matching PackageManifests were found")
+		logger.V(logging.LevelTrace).Info("More than one matching PackageManifests were found")
 		return nil, fmt.Errorf("more than one matching PackageManifests were found")
 	}
 	return pkgManifestObjects[0], nil
@@ -122,10 +136,16 @@ func PullPackageManifestByCatalogWithTimeout(apiClient *clients.Settings, name,
 // PullPackageManifestByCatalog loads an existing PackageManifest from specified catalog into Builder struct.
 func PullPackageManifestByCatalog(apiClient *clients.Settings, name, nsname,
 	catalog string) (*PackageManifestBuilder, error) {
-	glog.V(100).Infof("Pulling existing PackageManifest name %s in namespace %s and from catalog %s",
-		name, nsname, catalog)
+	return PullPackageManifestByCatalogWithContext(context.TODO(), apiClient, name, nsname, catalog)
+}
+
+// PullPackageManifestByCatalogWithContext is the context-aware variant of PullPackageManifestByCatalog.
+func PullPackageManifestByCatalogWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname,
+	catalog string) (*PackageManifestBuilder, error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing PackageManifest from catalog", "name", name,
+		"namespace", nsname, "catalog", catalog)
 	if nsname == "" {
-		glog.V(100).Infof("packagemanifest 'nsname' parameter can not be empty")
+		logger.V(logging.LevelTrace).Info("packagemanifest 'nsname' parameter can not be empty")
 		return nil, fmt.Errorf("failed to list packagemanifests, 'nsname' parameter is empty")
 	}
 	passedOptions := metav1.ListOptions{
@@ -133,12 +153,11 @@ func PullPackageManifestByCatalog(apiClient *clients.Settings, name, nsname,
 		FieldSelector: fmt.Sprintf("metadata.name=%s", name),
 	}
 	logMessage := fmt.Sprintf("Listing PackageManifests in the namespace %s with the options %v", nsname, passedOptions)
-	glog.V(100).Infof(logMessage)
-	pkgManifestList, err := apiClient.PackageManifestInterface.PackageManifests(nsname).List(context.TODO(),
+	logger.V(logging.LevelTrace).Info(logMessage)
+	pkgManifestList, err := apiClient.PackageManifestInterface.PackageManifests(nsname).List(ctx,
 		passedOptions)
 	if err != nil {
-		glog.V(100).Infof("Failed to list PackageManifests in the namespace %s due to %s",
-			nsname, err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to list PackageManifests", "namespace", nsname, "error", err)
 		return nil, err
 	}
 	var pkgManifestObjects []*PackageManifestBuilder
@@ -152,11 +171,11 @@ func PullPackageManifestByCatalog(apiClient *clients.Settings, name, nsname,
 		pkgManifestObjects = append(pkgManifestObjects, pkgManifestBuilder)
 	}
 	if len(pkgManifestObjects) == 0 {
-		glog.V(100).Infof("The list of matching PackageManifests is empty")
+		logger.V(logging.LevelTrace).Info("The list of matching PackageManifests is empty")
 		return nil, fmt.Errorf("no matching PackageManifests were found")
 	}
 	if len(pkgManifestObjects) > 1 {
-		glog.V(100).Infof("More than one matching PackageManifests were found")
+		logger.V(logging.LevelTrace).Info("More than one matching PackageManifests were found")
 		return nil, fmt.Errorf("more than one matching PackageManifests were found")
 	}
 	return pkgManifestObjects[0], nil
@@ -164,35 +183,47 @@ func PullPackageManifestByCatalog(apiClient *clients.Settings, name, nsname,
 
 // Exists checks whether the given PackageManifest exists.
 func (builder *PackageManifestBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *PackageManifestBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof(
-		"Checking if PackageManifest %s exists", builder.Definition.Name)
+	builder.log().V(logging.LevelTrace).Info("Checking if PackageManifest exists")
 
 	var err error
 	builder.Object, err = builder.apiClient.PackageManifestInterface.PackageManifests(
-		builder.Definition.Namespace).Get(context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		builder.Definition.Namespace).Get(ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete removes a PackageManifest.
 func (builder *PackageManifestBuilder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *PackageManifestBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting PackageManifest %s in namespace %s", builder.Definition.Name,
-		builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Deleting PackageManifest")
+
+	if builder.apiClient.IsDryRun() {
+		return builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil
 	}
 
 	err := builder.apiClient.PackageManifestInterface.PackageManifests(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		ctx, builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return err
@@ -203,31 +234,41 @@ func (builder *PackageManifestBuilder) Delete() error {
 	return err
 }
 
+// log returns the logger with the PackageManifest kind, name and namespace fields.
+func (builder *PackageManifestBuilder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", "PackageManifest")
+	}
+
+	return logging.WithResource(logger, "PackageManifest", builder.Definition.Name, builder.Definition.Namespace)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PackageManifestBuilder) validate() (bool, error) {
 	resourceCRD := "PackageManifest"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder apiclient is nil", "kind", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelTrace).Info("The builder has error message", "kind", resourceCRD,
+			"error", builder.errorMsg)
 
 		return false, errors.New(builder.errorMsg)
 	}
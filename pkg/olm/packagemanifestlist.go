@@ -5,29 +5,37 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/golang/glog"
 	v1 "github.com/operator-framework/operator-lifecycle-manager/pkg/package-server/apis/operators/v1"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 // ListPackageManifest returns PackageManifest inventory in the given namespace.
 func ListPackageManifest(
+	apiClient *clients.Settings,
+	nsname string,
+	options metav1.ListOptions) ([]*PackageManifestBuilder, error) {
+	return ListPackageManifestWithContext(context.TODO(), apiClient, nsname, options)
+}
+
+// ListPackageManifestWithContext is the context-aware variant of ListPackageManifest.
+func ListPackageManifestWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	nsname string,
 	options metav1.ListOptions) ([]*PackageManifestBuilder, error) {
 	if nsname == "" {
-		glog.V(100).Infof("packagemanifest 'nsname' parameter can not be empty")
+		logger.V(logging.LevelTrace).Info("packagemanifest 'nsname' parameter can not be empty")
 		return nil, fmt.Errorf("failed to list packagemanifests, 'nsname' parameter is empty")
 	}
 
-	glog.V(100).Infof("Listing PackageManifests in the namespace %s", nsname)
+	logger.V(logging.LevelTrace).Info("Listing PackageManifests", "namespace", nsname)
 
-	pkgManifestList, err := apiClient.PackageManifestInterface.PackageManifests(nsname).List(context.TODO(), options)
+	pkgManifestList, err := apiClient.PackageManifestInterface.PackageManifests(nsname).List(ctx, options)
 	if err != nil {
-		glog.V(100).Infof("Failed to list PackageManifests in the namespace %s due to %s",
-			nsname, err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to list PackageManifests", "namespace", nsname, "error", err)
 		return nil, err
 	}
 
@@ -46,22 +54,33 @@ func ListPackageManifest(
 
 // ListPackageManifestWithTimeout returns PackageManifest inventory in the given namespace and timeout.
 func ListPackageManifestWithTimeout(
+	apiClient *clients.Settings,
+	nsname string,
+	backoff time.Duration,
+	timeout time.Duration,
+	options metav1.ListOptions) ([]*PackageManifestBuilder, error) {
+	return ListPackageManifestWithTimeoutWithContext(context.TODO(), apiClient, nsname, backoff, timeout, options)
+}
+
+// ListPackageManifestWithTimeoutWithContext is the context-aware variant of ListPackageManifestWithTimeout.
+func ListPackageManifestWithTimeoutWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	nsname string,
 	backoff time.Duration,
 	timeout time.Duration,
 	options metav1.ListOptions) ([]*PackageManifestBuilder, error) {
 	if nsname == "" {
-		glog.V(100).Infof("packagemanifest 'nsname' parameter can not be empty")
+		logger.V(logging.LevelTrace).Info("packagemanifest 'nsname' parameter can not be empty")
 		return nil, fmt.Errorf("failed to list packagemanifests, 'nsname' parameter is empty")
 	}
 
-	glog.V(100).Infof("Listing PackageManifests in the namespace %s", nsname)
+	logger.V(logging.LevelTrace).Info("Listing PackageManifests", "namespace", nsname)
 	var pkgManifestList *v1.PackageManifestList
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), backoff, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, backoff, timeout, true, func(ctx context.Context) (bool, error) {
 			var err error
-			pkgManifestList, err = apiClient.PackageManifestInterface.PackageManifests(nsname).List(context.TODO(), options)
+			pkgManifestList, err = apiClient.PackageManifestInterface.PackageManifests(nsname).List(ctx, options)
 			if err != nil {
 				return false, err
 			}
@@ -71,8 +90,7 @@ func ListPackageManifestWithTimeout(
 			return false, nil
 		})
 	if err != nil {
-		glog.V(100).Infof("Failed to list PackageManifests in the namespace %s due to %s",
-			nsname, err.Error())
+		logger.V(logging.LevelTrace).Info("Failed to list PackageManifests", "namespace", nsname, "error", err)
 		return nil, err
 	}
 
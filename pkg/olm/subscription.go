@@ -5,9 +5,10 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/golang/glog"
+	"github.com/go-logr/logr"
 	operatorsV1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,10 +30,9 @@ type SubscriptionBuilder struct {
 // NewSubscriptionBuilder returns a SubscriptionBuilder.
 func NewSubscriptionBuilder(apiClient *clients.Settings, subName, subNamespace, catalogSource, catalogSourceNamespace,
 	packageName string) *SubscriptionBuilder {
-	glog.V(100).Infof(
-		"Initializing new SubscriptionBuilder structure with the following params, subName: %s, "+
-			"subNamespace: %s, catalogSource: %s, catalogSourceNamespace: %s, packageName: %s ",
-		subName, subNamespace, catalogSource, catalogSourceNamespace, packageName)
+	logger.V(logging.LevelTrace).Info("Initializing new SubscriptionBuilder structure", "name", subName,
+		"namespace", subNamespace, "catalogSource", catalogSource, "catalogSourceNamespace", catalogSourceNamespace,
+		"packageName", packageName)
 
 	builder := &SubscriptionBuilder{
 		apiClient: apiClient,
@@ -50,31 +50,31 @@ func NewSubscriptionBuilder(apiClient *clients.Settings, subName, subNamespace,
 	}
 
 	if subName == "" {
-		glog.V(100).Infof("The Name of the Subscription is empty")
+		logger.V(logging.LevelTrace).Info("The Name of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'subName' cannot be empty"
 	}
 
 	if subNamespace == "" {
-		glog.V(100).Infof("The Namespace of the Subscription is empty")
+		logger.V(logging.LevelTrace).Info("The Namespace of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'subNamespace' cannot be empty"
 	}
 
 	if catalogSource == "" {
-		glog.V(100).Infof("The Catalogsource of the Subscription is empty")
+		logger.V(logging.LevelTrace).Info("The Catalogsource of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'catalogSource' cannot be empty"
 	}
 
 	if catalogSourceNamespace == "" {
-		glog.V(100).Infof("The Catalogsource namespace of the Subscription is empty")
+		logger.V(logging.LevelTrace).Info("The Catalogsource namespace of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'catalogSourceNamespace' cannot be empty"
 	}
 
 	if packageName == "" {
-		glog.V(100).Infof("The Package name of the Subscription is empty")
+		logger.V(logging.LevelTrace).Info("The Package name of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'packageName' cannot be empty"
 	}
@@ -88,7 +88,7 @@ func (builder *SubscriptionBuilder) WithChannel(channel string) *SubscriptionBui
 		return builder
 	}
 
-	glog.V(100).Infof("Defining Subscription builder object with channel: %s", channel)
+	logger.V(logging.LevelTrace).Info("Defining Subscription builder object with channel", "channel", channel)
 
 	if channel == "" {
 		builder.errorMsg = "can not redefine subscription with empty channel"
@@ -109,8 +109,8 @@ func (builder *SubscriptionBuilder) WithStartingCSV(startingCSV string) *Subscri
 		return builder
 	}
 
-	glog.V(100).Infof("Defining Subscription builder object with startingCSV: %s",
-		startingCSV)
+	logger.V(logging.LevelTrace).Info("Defining Subscription builder object with startingCSV",
+		"startingCSV", startingCSV)
 
 	if startingCSV == "" {
 		builder.errorMsg = "can not redefine subscription with empty startingCSV"
@@ -132,11 +132,11 @@ func (builder *SubscriptionBuilder) WithInstallPlanApproval(
 		return builder
 	}
 
-	glog.V(100).Infof("Defining Subscription builder object with "+
-		"installPlanApproval: %s", installPlanApproval)
+	logger.V(logging.LevelTrace).Info("Defining Subscription builder object with installPlanApproval",
+		"installPlanApproval", installPlanApproval)
 
 	if !(installPlanApproval == "Automatic" || installPlanApproval == "Manual") {
-		glog.V(100).Infof("The InstallPlanApproval of the Subscription must be either \"Automatic\" " +
+		logger.V(logging.LevelTrace).Info("The InstallPlanApproval of the Subscription must be either \"Automatic\" " +
 			"or \"Manual\"")
 
 		builder.errorMsg = "Subscription 'installPlanApproval' must be either \"Automatic\" or \"Manual\""
@@ -153,16 +153,26 @@ func (builder *SubscriptionBuilder) WithInstallPlanApproval(
 
 // Create makes an Subscription in cluster and stores the created object in struct.
 func (builder *SubscriptionBuilder) Create() (*SubscriptionBuilder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *SubscriptionBuilder) CreateWithContext(ctx context.Context) (*SubscriptionBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Creating the Subscription %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Creating the Subscription")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunCreate, builder.Definition)
+	}
 
 	var err error
-	if !builder.Exists() {
-		builder.Object, err = builder.apiClient.Subscriptions(builder.Definition.Namespace).Create(context.TODO(),
+	if !builder.ExistsWithContext(ctx) {
+		builder.Object, err = builder.apiClient.Subscriptions(builder.Definition.Namespace).Create(ctx,
 			builder.Definition, metav1.CreateOptions{})
 	}
 
@@ -171,35 +181,47 @@ func (builder *SubscriptionBuilder) Create() (*SubscriptionBuilder, error) {
 
 // Exists checks whether the given Subscription exists.
 func (builder *SubscriptionBuilder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *SubscriptionBuilder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
 
-	glog.V(100).Infof("Checking if Subscription %s exists in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Checking if Subscription exists")
 
 	var err error
 
 	builder.Object, err = builder.apiClient.Subscriptions(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
 // Delete removes a Subscription.
 func (builder *SubscriptionBuilder) Delete() error {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *SubscriptionBuilder) DeleteWithContext(ctx context.Context) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
 
-	glog.V(100).Infof("Deleting Subscription %s in namespace %s", builder.Definition.Name,
-		builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Deleting Subscription")
+
+	if builder.apiClient.IsDryRun() {
+		return builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil
 	}
 
-	err := builder.apiClient.Subscriptions(builder.Definition.Namespace).Delete(context.TODO(), builder.Object.Name,
+	err := builder.apiClient.Subscriptions(builder.Definition.Namespace).Delete(ctx, builder.Object.Name,
 		metav1.DeleteOptions{})
 
 	if err != nil {
@@ -213,14 +235,24 @@ func (builder *SubscriptionBuilder) Delete() error {
 
 // Update modifies the existing Subscription with the Subscription definition in SubscriptionBuilder.
 func (builder *SubscriptionBuilder) Update() (*SubscriptionBuilder, error) {
+	return builder.UpdateWithContext(context.TODO())
+}
+
+// UpdateWithContext is the context-aware variant of Update.
+func (builder *SubscriptionBuilder) UpdateWithContext(ctx context.Context) (*SubscriptionBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
-	glog.V(100).Infof("Updating Subscription %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
+	builder.log().V(logging.LevelTrace).Info("Updating Subscription")
+
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
 
-	if !builder.Exists() {
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunUpdate, builder.Definition)
+	}
+
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("subscription named %s in namespace %s doesn't exist",
 			builder.Definition.Name, builder.Definition.Namespace)
 	}
@@ -228,15 +260,21 @@ func (builder *SubscriptionBuilder) Update() (*SubscriptionBuilder, error) {
 	var err error
 
 	builder.Object, err = builder.apiClient.Subscriptions(builder.Definition.Namespace).Update(
-		context.TODO(), builder.Definition, metav1.UpdateOptions{})
+		ctx, builder.Definition, metav1.UpdateOptions{})
 
 	return builder, err
 }
 
 // PullSubscription loads existing Subscription from cluster into the SubscriptionBuilder struct.
 func PullSubscription(apiClient *clients.Settings, subName, subNamespace string) (*SubscriptionBuilder, error) {
-	glog.V(100).Infof("Pulling existing Subscription %s from cluster in namespace %s",
-		subName, subNamespace)
+	return PullSubscriptionWithContext(context.TODO(), apiClient, subName, subNamespace)
+}
+
+// PullSubscriptionWithContext is the context-aware variant of PullSubscription.
+func PullSubscriptionWithContext(
+	ctx context.Context, apiClient *clients.Settings, subName, subNamespace string) (*SubscriptionBuilder, error) {
+	logger.V(logging.LevelTrace).Info("Pulling existing Subscription from cluster", "name", subName,
+		"namespace", subNamespace)
 
 	builder := &SubscriptionBuilder{
 		apiClient: apiClient,
@@ -249,18 +287,18 @@ func PullSubscription(apiClient *clients.Settings, subName, subNamespace string)
 	}
 
 	if subName == "" {
-		glog.V(100).Infof("The name of the Subscription is empty")
+		logger.V(logging.LevelTrace).Info("The name of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'subName' cannot be empty"
 	}
 
 	if subNamespace == "" {
-		glog.V(100).Infof("The namespace of the Subscription is empty")
+		logger.V(logging.LevelTrace).Info("The namespace of the Subscription is empty")
 
 		builder.errorMsg = "Subscription 'subNamespace' cannot be empty"
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		return nil, fmt.Errorf("subscription object named %s doesn't exist", subName)
 	}
 
@@ -269,31 +307,41 @@ func PullSubscription(apiClient *clients.Settings, subName, subNamespace string)
 	return builder, nil
 }
 
+// log returns the logger with the Subscription kind, name and namespace fields.
+func (builder *SubscriptionBuilder) log() logr.Logger {
+	if builder == nil || builder.Definition == nil {
+		return logger.WithValues("kind", "Subscription")
+	}
+
+	return logging.WithResource(logger, "Subscription", builder.Definition.Name, builder.Definition.Namespace)
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *SubscriptionBuilder) validate() (bool, error) {
 	resourceCRD := "Subscription"
 
 	if builder == nil {
-		glog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder is uninitialized", "kind", resourceCRD)
 
 		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
 	}
 
 	if builder.Definition == nil {
-		glog.V(100).Infof("The %s is undefined", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder definition is undefined", "kind", resourceCRD)
 
 		builder.errorMsg = msg.UndefinedCrdObjectErrString(resourceCRD)
 	}
 
 	if builder.apiClient == nil {
-		glog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+		logger.V(logging.LevelTrace).Info("The builder apiclient is nil", "kind", resourceCRD)
 
 		builder.errorMsg = fmt.Sprintf("%s builder cannot have nil apiClient", resourceCRD)
 	}
 
 	if builder.errorMsg != "" {
-		glog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+		logger.V(logging.LevelTrace).Info("The builder has error message", "kind", resourceCRD,
+			"error", builder.errorMsg)
 
 		return false, errors.New(builder.errorMsg)
 	}
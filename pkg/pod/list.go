@@ -13,6 +13,12 @@ import (
 
 // List returns pod inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...v1.ListOptions) ([]*Builder, error) {
+	return ListWithContext(context.TODO(), apiClient, nsname, options...)
+}
+
+// ListWithContext is the context-aware variant of List.
+func ListWithContext(
+	ctx context.Context, apiClient *clients.Settings, nsname string, options ...v1.ListOptions) ([]*Builder, error) {
 	if nsname == "" {
 		glog.V(100).Infof("pod 'nsname' parameter can not be empty")
 
@@ -33,7 +39,7 @@ func List(apiClient *clients.Settings, nsname string, options ...v1.ListOptions)
 
 	glog.V(100).Infof(logMessage)
 
-	podList, err := apiClient.Pods(nsname).List(context.TODO(), passedOptions)
+	podList, err := apiClient.Pods(nsname).List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list pods in the nsname %s due to %s", nsname, err.Error())
@@ -59,6 +65,12 @@ func List(apiClient *clients.Settings, nsname string, options ...v1.ListOptions)
 
 // ListInAllNamespaces returns a cluster-wide pod inventory.
 func ListInAllNamespaces(apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, error) {
+	return ListInAllNamespacesWithContext(context.TODO(), apiClient, options...)
+}
+
+// ListInAllNamespacesWithContext is the context-aware variant of ListInAllNamespaces.
+func ListInAllNamespacesWithContext(
+	ctx context.Context, apiClient *clients.Settings, options ...v1.ListOptions) ([]*Builder, error) {
 	logMessage := "Listing all pods in all namespaces"
 	passedOptions := v1.ListOptions{}
 
@@ -75,7 +87,7 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...v1.ListOptions)
 
 	glog.V(100).Infof(logMessage)
 
-	podList, err := apiClient.Pods("").List(context.TODO(), passedOptions)
+	podList, err := apiClient.Pods("").List(ctx, passedOptions)
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list all pods due to %s", err.Error())
@@ -101,6 +113,12 @@ func ListInAllNamespaces(apiClient *clients.Settings, options ...v1.ListOptions)
 
 // ListByNamePattern returns pod inventory in the given namespace filtered by name pattern.
 func ListByNamePattern(apiClient *clients.Settings, namePattern, nsname string) ([]*Builder, error) {
+	return ListByNamePatternWithContext(context.TODO(), apiClient, namePattern, nsname)
+}
+
+// ListByNamePatternWithContext is the context-aware variant of ListByNamePattern.
+func ListByNamePatternWithContext(
+	ctx context.Context, apiClient *clients.Settings, namePattern, nsname string) ([]*Builder, error) {
 	glog.V(100).Infof("Listing pods in the nsname %s filtered by the name pattern %s", nsname, namePattern)
 
 	if nsname == "" {
@@ -109,7 +127,7 @@ func ListByNamePattern(apiClient *clients.Settings, namePattern, nsname string)
 		return nil, fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
 	}
 
-	podList, err := apiClient.Pods(nsname).List(context.TODO(), v1.ListOptions{})
+	podList, err := apiClient.Pods(nsname).List(ctx, v1.ListOptions{})
 
 	if err != nil {
 		glog.V(100).Infof("Failed to list pods filtered by the name pattern %s in the nsname %s due to %s",
@@ -138,6 +156,16 @@ func ListByNamePattern(apiClient *clients.Settings, namePattern, nsname string)
 
 // WaitForAllPodsInNamespaceRunning wait until all pods in namespace that match options are in running state.
 func WaitForAllPodsInNamespaceRunning(
+	apiClient *clients.Settings,
+	nsname string,
+	timeout time.Duration,
+	options ...v1.ListOptions) (bool, error) {
+	return WaitForAllPodsInNamespaceRunningWithContext(context.TODO(), apiClient, nsname, timeout, options...)
+}
+
+// WaitForAllPodsInNamespaceRunningWithContext is the context-aware variant of WaitForAllPodsInNamespaceRunning.
+func WaitForAllPodsInNamespaceRunningWithContext(
+	ctx context.Context,
 	apiClient *clients.Settings,
 	nsname string,
 	timeout time.Duration,
@@ -164,7 +192,7 @@ func WaitForAllPodsInNamespaceRunning(
 
 	glog.V(100).Infof(logMessage + " are in running state")
 
-	podList, err := List(apiClient, nsname, passedOptions)
+	podList, err := ListWithContext(ctx, apiClient, nsname, passedOptions)
 	if err != nil {
 		glog.V(100).Infof("Failed to list all pods due to %s", err.Error())
 
@@ -87,8 +87,54 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 	return builder
 }
 
+// NewBuilderFromDefinition creates a new instance of Builder from a complete pod definition, such as a rendered
+// workload pod.
+func NewBuilderFromDefinition(apiClient *clients.Settings, definition *corev1.Pod) *Builder {
+	builder := &Builder{
+		apiClient:  apiClient,
+		Definition: definition,
+	}
+
+	if definition == nil {
+		glog.V(100).Infof("The pod definition is nil")
+
+		builder.errorMsg = "pod's definition is nil"
+
+		return builder
+	}
+
+	glog.V(100).Infof(
+		"Initializing new pod structure from definition with the following params: name: %s, namespace: %s",
+		definition.Name, definition.Namespace)
+
+	if definition.Name == "" {
+		glog.V(100).Infof("The name of the pod is empty")
+
+		builder.errorMsg = "pod's name is empty"
+	}
+
+	if definition.Namespace == "" {
+		glog.V(100).Infof("The namespace of the pod is empty")
+
+		builder.errorMsg = "namespace's name is empty"
+	}
+
+	if len(definition.Spec.Containers) == 0 {
+		glog.V(100).Infof("The pod has no container")
+
+		builder.errorMsg = "pod has no container"
+	}
+
+	return builder
+}
+
 // Pull loads an existing pod into the Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	return PullWithContext(context.TODO(), apiClient, name, nsname)
+}
+
+// PullWithContext is the context-aware variant of Pull.
+func PullWithContext(ctx context.Context, apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	glog.V(100).Infof("Pulling existing pod name: %s namespace:%s", name, nsname)
 
 	builder := Builder{
@@ -117,7 +163,7 @@ func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 		return nil, fmt.Errorf("faield to pull pod object due to the following error: %s", builder.errorMsg)
 	}
 
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		glog.V(100).Infof("Failed to pull pod object %s from namespace %s. Object doesn't exist",
 			name, nsname)
 
@@ -160,6 +206,11 @@ func (builder *Builder) DefineOnNode(nodeName string) *Builder {
 
 // Create makes a pod according to the pod definition and stores the created object in the pod builder.
 func (builder *Builder) Create() (*Builder, error) {
+	return builder.CreateWithContext(context.TODO())
+}
+
+// CreateWithContext is the context-aware variant of Create.
+func (builder *Builder) CreateWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -167,10 +218,16 @@ func (builder *Builder) Create() (*Builder, error) {
 	glog.V(100).Infof("Creating pod %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
+	if builder.apiClient.IsDryRun() {
+		builder.Object = builder.Definition
+
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunCreate, builder.Definition)
+	}
+
 	var err error
-	if !builder.Exists() {
+	if !builder.ExistsWithContext(ctx) {
 		builder.Object, err = builder.apiClient.Pods(builder.Definition.Namespace).Create(
-			context.TODO(), builder.Definition, metav1.CreateOptions{})
+			ctx, builder.Definition, metav1.CreateOptions{})
 	}
 
 	return builder, err
@@ -178,6 +235,11 @@ func (builder *Builder) Create() (*Builder, error) {
 
 // Delete removes the pod object and resets the builder object.
 func (builder *Builder) Delete() (*Builder, error) {
+	return builder.DeleteWithContext(context.TODO())
+}
+
+// DeleteWithContext is the context-aware variant of Delete.
+func (builder *Builder) DeleteWithContext(ctx context.Context) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -185,12 +247,16 @@ func (builder *Builder) Delete() (*Builder, error) {
 	glog.V(100).Infof("Deleting pod %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
+	if builder.apiClient.IsDryRun() {
+		return builder, builder.apiClient.RecordDryRun(clients.DryRunDelete, builder.Definition)
+	}
+
+	if !builder.ExistsWithContext(ctx) {
 		return builder, fmt.Errorf("pod cannot be deleted because it does not exist")
 	}
 
 	err := builder.apiClient.Pods(builder.Definition.Namespace).Delete(
-		context.TODO(), builder.Object.Name, metav1.DeleteOptions{})
+		ctx, builder.Object.Name, metav1.DeleteOptions{})
 
 	if err != nil {
 		return builder, fmt.Errorf("can not delete pod: %w", err)
@@ -203,6 +269,11 @@ func (builder *Builder) Delete() (*Builder, error) {
 
 // DeleteAndWait deletes the pod object and waits until the pod is deleted.
 func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
+	return builder.DeleteAndWaitWithContext(context.TODO(), timeout)
+}
+
+// DeleteAndWaitWithContext is the context-aware variant of DeleteAndWait.
+func (builder *Builder) DeleteAndWaitWithContext(ctx context.Context, timeout time.Duration) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -210,12 +281,16 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
 	glog.V(100).Infof("Deleting pod %s in namespace %s and waiting for the defined period until it's removed",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	builder, err := builder.Delete()
+	builder, err := builder.DeleteWithContext(ctx)
 	if err != nil {
 		return builder, err
 	}
 
-	err = builder.WaitUntilDeleted(timeout)
+	if builder.apiClient.IsDryRun() {
+		return builder, nil
+	}
+
+	err = builder.WaitUntilDeletedWithContext(ctx, timeout)
 
 	if err != nil {
 		return builder, err
@@ -226,6 +301,12 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
 
 // CreateAndWaitUntilRunning creates the pod object and waits until the pod is running.
 func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Builder, error) {
+	return builder.CreateAndWaitUntilRunningWithContext(context.TODO(), timeout)
+}
+
+// CreateAndWaitUntilRunningWithContext is the context-aware variant of CreateAndWaitUntilRunning.
+func (builder *Builder) CreateAndWaitUntilRunningWithContext(
+	ctx context.Context, timeout time.Duration) (*Builder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
@@ -233,12 +314,16 @@ func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Build
 	glog.V(100).Infof("Creating pod %s in namespace %s and waiting for the defined period until it's ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	builder, err := builder.Create()
+	builder, err := builder.CreateWithContext(ctx)
 	if err != nil {
 		return builder, err
 	}
 
-	err = builder.WaitUntilRunning(timeout)
+	if builder.apiClient.IsDryRun() {
+		return builder, nil
+	}
+
+	err = builder.WaitUntilRunningWithContext(ctx, timeout)
 
 	if err != nil {
 		return builder, err
@@ -249,6 +334,11 @@ func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Build
 
 // WaitUntilRunning waits for the duration of the defined timeout or until the pod is running.
 func (builder *Builder) WaitUntilRunning(timeout time.Duration) error {
+	return builder.WaitUntilRunningWithContext(context.TODO(), timeout)
+}
+
+// WaitUntilRunningWithContext is the context-aware variant of WaitUntilRunning.
+func (builder *Builder) WaitUntilRunningWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -256,11 +346,17 @@ func (builder *Builder) WaitUntilRunning(timeout time.Duration) error {
 	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is running",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	return builder.WaitUntilInStatus(corev1.PodRunning, timeout)
+	return builder.WaitUntilInStatusWithContext(ctx, corev1.PodRunning, timeout)
 }
 
 // WaitUntilInStatus waits for the duration of the defined timeout or until the pod gets to a specific status.
 func (builder *Builder) WaitUntilInStatus(status corev1.PodPhase, timeout time.Duration) error {
+	return builder.WaitUntilInStatusWithContext(context.TODO(), status, timeout)
+}
+
+// WaitUntilInStatusWithContext is the context-aware variant of WaitUntilInStatus.
+func (builder *Builder) WaitUntilInStatusWithContext(
+	ctx context.Context, status corev1.PodPhase, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -269,9 +365,9 @@ func (builder *Builder) WaitUntilInStatus(status corev1.PodPhase, timeout time.D
 		builder.Definition.Name, builder.Definition.Namespace, status)
 
 	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			updatePod, err := builder.apiClient.Pods(builder.Object.Namespace).Get(
-				context.TODO(), builder.Object.Name, metav1.GetOptions{})
+				ctx, builder.Object.Name, metav1.GetOptions{})
 			if err != nil {
 				return false, nil
 			}
@@ -282,6 +378,11 @@ func (builder *Builder) WaitUntilInStatus(status corev1.PodPhase, timeout time.D
 
 // WaitUntilDeleted waits for the duration of the defined timeout or until the pod is deleted.
 func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
+	return builder.WaitUntilDeletedWithContext(context.TODO(), timeout)
+}
+
+// WaitUntilDeletedWithContext is the context-aware variant of WaitUntilDeleted.
+func (builder *Builder) WaitUntilDeletedWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -290,9 +391,9 @@ func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
 		builder.Definition.Name, builder.Definition.Namespace)
 
 	err := wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, false, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, false, func(ctx context.Context) (bool, error) {
 			_, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
-				context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+				ctx, builder.Definition.Name, metav1.GetOptions{})
 			if err == nil {
 				glog.V(100).Infof("pod %s/%s still present", builder.Definition.Namespace, builder.Definition.Name)
 
@@ -315,6 +416,11 @@ func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
 
 // WaitUntilReady waits for the duration of the defined timeout or until the pod reaches the Ready condition.
 func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
+	return builder.WaitUntilReadyWithContext(context.TODO(), timeout)
+}
+
+// WaitUntilReadyWithContext is the context-aware variant of WaitUntilReady.
+func (builder *Builder) WaitUntilReadyWithContext(ctx context.Context, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -322,11 +428,17 @@ func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
 	glog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s is Ready",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	return builder.WaitUntilCondition(corev1.PodReady, timeout)
+	return builder.WaitUntilConditionWithContext(ctx, corev1.PodReady, timeout)
 }
 
 // WaitUntilCondition waits for the duration of the defined timeout or until the pod gets to a specific condition.
 func (builder *Builder) WaitUntilCondition(condition corev1.PodConditionType, timeout time.Duration) error {
+	return builder.WaitUntilConditionWithContext(context.TODO(), condition, timeout)
+}
+
+// WaitUntilConditionWithContext is the context-aware variant of WaitUntilCondition.
+func (builder *Builder) WaitUntilConditionWithContext(
+	ctx context.Context, condition corev1.PodConditionType, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -335,9 +447,9 @@ func (builder *Builder) WaitUntilCondition(condition corev1.PodConditionType, ti
 		builder.Definition.Name, builder.Definition.Namespace, condition)
 
 	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+		ctx, time.Second, timeout, true, func(ctx context.Context) (bool, error) {
 			updatePod, err := builder.apiClient.Pods(builder.Object.Namespace).Get(
-				context.TODO(), builder.Object.Name, metav1.GetOptions{})
+				ctx, builder.Object.Name, metav1.GetOptions{})
 			if err != nil {
 				return false, nil
 			}
@@ -354,6 +466,12 @@ func (builder *Builder) WaitUntilCondition(condition corev1.PodConditionType, ti
 
 // ExecCommand runs command in the pod and returns the buffer output.
 func (builder *Builder) ExecCommand(command []string, containerName ...string) (bytes.Buffer, error) {
+	return builder.ExecCommandWithContext(context.TODO(), command, containerName...)
+}
+
+// ExecCommandWithContext is the context-aware variant of ExecCommand.
+func (builder *Builder) ExecCommandWithContext(
+	ctx context.Context, command []string, containerName ...string) (bytes.Buffer, error) {
 	if valid, err := builder.validate(); !valid {
 		return bytes.Buffer{}, err
 	}
@@ -393,7 +511,7 @@ func (builder *Builder) ExecCommand(command []string, containerName ...string) (
 		return buffer, err
 	}
 
-	err = exec.StreamWithContext(context.TODO(), remotecommand.StreamOptions{
+	err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
 		Stdin:  os.Stdin,
 		Stdout: &buffer,
 		Stderr: os.Stderr,
@@ -410,6 +528,12 @@ func (builder *Builder) ExecCommand(command []string, containerName ...string) (
 // Copy returns the contents of a file or path from a specified container into a buffer.
 // Setting the tar option returns a tar archive of the specified path.
 func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer, error) {
+	return builder.CopyWithContext(context.TODO(), path, containerName, tar)
+}
+
+// CopyWithContext is the context-aware variant of Copy.
+func (builder *Builder) CopyWithContext(
+	ctx context.Context, path, containerName string, tar bool) (bytes.Buffer, error) {
 	if valid, err := builder.validate(); !valid {
 		return bytes.Buffer{}, err
 	}
@@ -481,7 +605,7 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 		return buffer, err
 	}
 
-	err = exec.StreamWithContext(context.TODO(), remotecommand.StreamOptions{
+	err = exec.StreamWithContext(ctx, remotecommand.StreamOptions{
 		Stdin:  os.Stdin,
 		Stdout: &buffer,
 		Stderr: os.Stderr,
@@ -497,6 +621,11 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 
 // Exists checks whether the given pod exists.
 func (builder *Builder) Exists() bool {
+	return builder.ExistsWithContext(context.TODO())
+}
+
+// ExistsWithContext is the context-aware variant of Exists.
+func (builder *Builder) ExistsWithContext(ctx context.Context) bool {
 	if valid, _ := builder.validate(); !valid {
 		return false
 	}
@@ -506,7 +635,7 @@ func (builder *Builder) Exists() bool {
 
 	var err error
 	builder.Object, err = builder.apiClient.Pods(builder.Definition.Namespace).Get(
-		context.TODO(), builder.Definition.Name, metav1.GetOptions{})
+		ctx, builder.Definition.Name, metav1.GetOptions{})
 
 	return err == nil || !k8serrors.IsNotFound(err)
 }
@@ -950,6 +1079,11 @@ func (builder *Builder) WithSecurityContext(securityContext *corev1.PodSecurityC
 
 // PullImage pulls image for given pod's container and removes it.
 func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error {
+	return builder.PullImageWithContext(context.TODO(), timeout, testCmd)
+}
+
+// PullImageWithContext is the context-aware variant of PullImage.
+func (builder *Builder) PullImageWithContext(ctx context.Context, timeout time.Duration, testCmd []string) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -960,7 +1094,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 
 	builder.WithRestartPolicy(corev1.RestartPolicyNever)
 	builder.RedefineDefaultCMD(testCmd)
-	_, err := builder.Create()
+	_, err := builder.CreateWithContext(ctx)
 
 	if err != nil {
 		glog.V(100).Infof(
@@ -971,7 +1105,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 		return err
 	}
 
-	statusErr := builder.WaitUntilInStatus(corev1.PodSucceeded, timeout)
+	statusErr := builder.WaitUntilInStatusWithContext(ctx, corev1.PodSucceeded, timeout)
 
 	if statusErr != nil {
 		glog.V(100).Infof(
@@ -980,7 +1114,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 			builder.Definition.Name, builder.Definition.Namespace, builder.Definition.Spec.Containers[0].Image,
 			builder.Definition.Spec.NodeName)
 
-		_, err = builder.Delete()
+		_, err = builder.DeleteWithContext(ctx)
 
 		if err != nil {
 			glog.V(100).Infof(
@@ -993,7 +1127,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 		return statusErr
 	}
 
-	_, err = builder.Delete()
+	_, err = builder.DeleteWithContext(ctx)
 
 	return err
 }
@@ -1073,6 +1207,12 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 
 // GetLog connects to a pod and fetches log.
 func (builder *Builder) GetLog(logStartTime time.Duration, containerName string) (string, error) {
+	return builder.GetLogWithContext(context.TODO(), logStartTime, containerName)
+}
+
+// GetLogWithContext is the context-aware variant of GetLog.
+func (builder *Builder) GetLogWithContext(
+	ctx context.Context, logStartTime time.Duration, containerName string) (string, error) {
 	if valid, err := builder.validate(); !valid {
 		return "", err
 	}
@@ -1080,7 +1220,7 @@ func (builder *Builder) GetLog(logStartTime time.Duration, containerName string)
 	logStart := int64(logStartTime.Seconds())
 	req := builder.apiClient.Pods(builder.Definition.Namespace).GetLogs(builder.Definition.Name, &corev1.PodLogOptions{
 		SinceSeconds: &logStart, Container: containerName})
-	log, err := req.Stream(context.TODO())
+	log, err := req.Stream(ctx)
 
 	if err != nil {
 		return "", err
@@ -1102,12 +1242,56 @@ func (builder *Builder) GetLog(logStartTime time.Duration, containerName string)
 
 // GetFullLog connects to a pod and fetches the full log since pod creation.
 func (builder *Builder) GetFullLog(containerName string) (string, error) {
+	return builder.GetFullLogWithContext(context.TODO(), containerName)
+}
+
+// GetFullLogWithContext is the context-aware variant of GetFullLog.
+func (builder *Builder) GetFullLogWithContext(ctx context.Context, containerName string) (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	logStream, err := builder.apiClient.Pods(builder.Definition.Namespace).GetLogs(builder.Definition.Name,
+		&corev1.PodLogOptions{Container: containerName}).Stream(ctx)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		_ = logStream.Close()
+	}()
+
+	logBuffer := new(bytes.Buffer)
+	_, err = io.Copy(logBuffer, logStream)
+
+	if err != nil {
+		return "", err
+	}
+
+	return logBuffer.String(), nil
+}
+
+// GetTimestampedLog connects to a pod and fetches the log since sinceTime, the full log if it is zero, every line
+// prefixed with its RFC 3339 timestamp.
+func (builder *Builder) GetTimestampedLog(sinceTime time.Time, containerName string) (string, error) {
+	return builder.GetTimestampedLogWithContext(context.TODO(), sinceTime, containerName)
+}
+
+// GetTimestampedLogWithContext is the context-aware variant of GetTimestampedLog.
+func (builder *Builder) GetTimestampedLogWithContext(
+	ctx context.Context, sinceTime time.Time, containerName string) (string, error) {
 	if valid, err := builder.validate(); !valid {
 		return "", err
 	}
 
+	logOptions := &corev1.PodLogOptions{Container: containerName, Timestamps: true}
+	if !sinceTime.IsZero() {
+		logOptions.SinceTime = &metav1.Time{Time: sinceTime}
+	}
+
 	logStream, err := builder.apiClient.Pods(builder.Definition.Namespace).GetLogs(builder.Definition.Name,
-		&corev1.PodLogOptions{Container: containerName}).Stream(context.TODO())
+		logOptions).Stream(ctx)
 
 	if err != nil {
 		return "", err
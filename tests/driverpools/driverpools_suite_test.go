@@ -0,0 +1,28 @@
+package driverpools
+
+import (
+	"runtime"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/runsummary"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/suite"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/tsparams"
+)
+
+var _, currentFile, _, _ = runtime.Caller(0)
+
+var runSummary = runsummary.New("DriverPools")
+
+var _ = suite.Register(runSummary, currentFile, tsparams.DriverPoolsReporterNamespacesToDump,
+	tsparams.DriverPoolsReporterCRDsToDump)
+
+func TestDriverPools(t *testing.T) {
+	_, reporterConfig := GinkgoConfiguration()
+	reporterConfig.JUnitReport = inittools.GeneralConfig.GetJunitReportPath(currentFile)
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DriverPools", Label("nvidia-ci", "driver-pools"), reporterConfig)
+}
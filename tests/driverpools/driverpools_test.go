@@ -0,0 +1,329 @@
+package driverpools
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	nvidiagpuv1alpha1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1alpha1"
+	"github.com/golang/glog"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/check"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/nvidiagpuconfig"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/tsparams"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/wait"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nodes"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/olm"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// DriverPoolLabel is the node label assigning the GPU nodes to a driver pool.
+	DriverPoolLabel = "nvidia-ci.rh-ecosystem-edge.io/driver-pool"
+	// OverlappingDriverName is the name of the NVIDIADriver whose node selector overlaps the driver pools.
+	OverlappingDriverName = "overlapping-pool"
+	// GPUOperatorCSVLabel selects the GPU operator CSV holding the alm-examples.
+	GPUOperatorCSVLabel = "operators.coreos.com/gpu-operator-certified.nvidia-gpu-operator"
+	// OverlapCheckDuration is how long the overlapping NVIDIADriver must stay not ready.
+	OverlapCheckDuration = 3 * time.Minute
+	// TestDuration is how long to wait for the operands and the node labels.
+	TestDuration = 20 * time.Minute
+	// TimeStep is the polling interval of the test.
+	TimeStep = 30 * time.Second
+)
+
+var (
+	nvidiaGPUConfig *nvidiagpuconfig.NvidiaGPUConfig
+)
+
+var _ = Describe("DriverPools", Ordered, Label(tsparams.LabelSuite), func() {
+	var (
+		poolNames     []string
+		poolNodes     = make(map[string][]string)
+		almExamples   string
+		clusterPolicy *nvidiagpu.Builder
+		nvidiaDrivers = make(map[string]*nvidiagpu.NVIDIADriverBuilder)
+	)
+
+	gpuNodeSelector := map[string]string{
+		inittools.GeneralConfig.WorkerLabel: "",
+		nvidiagpu.NvidiaGPULabel:            "true",
+	}
+
+	nvidiaGPUConfig = nvidiagpuconfig.NewNvidiaGPUConfig()
+
+	BeforeAll(func(ctx SpecContext) {
+		glog.V(gpuparams.GpuLogLevel).Info("Starting driver pools test suite")
+
+		Expect(nvidiaGPUConfig).ToNot(BeNil(), "error loading NvidiaGPUConfig from profile file "+
+			"and NVIDIAGPU_* env variables")
+
+		if err := inittools.GeneralConfig.WriteEffectiveConfig(nvidiagpuconfig.ProfileSection,
+			nvidiaGPUConfig); err != nil {
+			glog.Error("Error writing the effective NvidiaGPUConfig: ", err)
+		}
+
+		if len(nvidiaGPUConfig.DriverPoolVersions) < 2 {
+			Skip("NVIDIAGPU_DRIVER_POOL_VERSIONS must define the driver versions of at least two driver pools")
+		}
+
+		poolNames = slices.Sorted(maps.Keys(nvidiaGPUConfig.DriverPoolVersions))
+
+		gpuNodes, err := nodes.ListWithContext(ctx, inittools.APIClient,
+			metav1.ListOptions{LabelSelector: labels.Set(gpuNodeSelector).String()})
+		Expect(err).ToNot(HaveOccurred(), "error listing GPU nodes: %v", err)
+
+		if len(gpuNodes) < len(poolNames) {
+			Skip(fmt.Sprintf("found %d GPU nodes, at least one per driver pool (%d) is required",
+				len(gpuNodes), len(poolNames)))
+		}
+
+		var gpuNodeNames []string
+		for _, gpuNode := range gpuNodes {
+			gpuNodeNames = append(gpuNodeNames, gpuNode.Object.Name)
+		}
+
+		slices.Sort(gpuNodeNames)
+
+		for index, nodeName := range gpuNodeNames {
+			pool := poolNames[index%len(poolNames)]
+			poolNodes[pool] = append(poolNodes[pool], nodeName)
+		}
+
+		glog.V(gpuparams.GpuLogLevel).Infof("Driver pool nodes: %v", poolNodes)
+
+		if existingClusterPolicy, err := nvidiagpu.PullWithContext(ctx, inittools.APIClient,
+			nvidiagpu.ClusterPolicyName); err == nil {
+			_, err := existingClusterPolicy.DeleteWithContext(ctx)
+			Expect(err).ToNot(HaveOccurred(), "error deleting the existing ClusterPolicy: %v", err)
+
+			ensureOnlyOperatorIsRunning()
+		}
+
+		csvList, err := olm.ListClusterServiceVersion(inittools.APIClient, nvidiagpu.NvidiaGPUNamespace,
+			metav1.ListOptions{LabelSelector: GPUOperatorCSVLabel})
+		Expect(err).ToNot(HaveOccurred(), "error listing the GPU operator CSV: %v", err)
+		Expect(csvList).ToNot(BeEmpty(), "no GPU operator CSV found in namespace %s", nvidiagpu.NvidiaGPUNamespace)
+
+		almExamples, err = csvList[0].GetAlmExamplesWithContext(ctx)
+		Expect(err).ToNot(HaveOccurred(), "error getting the GPU operator CSV alm-examples: %v", err)
+
+		for _, pool := range poolNames {
+			nvidiaDrivers[pool] = nvidiagpu.NewNVIDIADriverBuilderFromObjectString(inittools.APIClient, almExamples).
+				WithName(pool).
+				WithNodeSelector(map[string]string{DriverPoolLabel: pool}).
+				WithVersion(nvidiaGPUConfig.DriverPoolVersions[pool])
+
+			if nvidiaGPUConfig.NvidiaDriverRepository != "" {
+				nvidiaDrivers[pool] = nvidiaDrivers[pool].WithRepository(nvidiaGPUConfig.NvidiaDriverRepository)
+			}
+
+			if nvidiaGPUConfig.NvidiaDriverPrecompiled {
+				nvidiaDrivers[pool] = nvidiaDrivers[pool].WithPrecompiled(true)
+			}
+		}
+	})
+
+	AfterAll(func(ctx SpecContext) {
+		for _, pool := range poolNames {
+			if nvidiaDrivers[pool].ExistsWithContext(ctx) {
+				if _, err := nvidiaDrivers[pool].DeleteWithContext(ctx); err != nil {
+					glog.Errorf("Error deleting NVIDIADriver %s: %v", pool, err)
+				}
+			}
+		}
+
+		if clusterPolicy != nil {
+			if _, err := clusterPolicy.DeleteWithContext(ctx); err != nil {
+				glog.Errorf("Error deleting ClusterPolicy: %v", err)
+			}
+		}
+
+		for _, nodeNames := range poolNodes {
+			for _, nodeName := range nodeNames {
+				nodeBuilder, err := nodes.PullWithContext(ctx, inittools.APIClient, nodeName)
+				if err != nil {
+					glog.Errorf("Error pulling node %s: %v", nodeName, err)
+
+					continue
+				}
+
+				if _, err := nodeBuilder.RemoveLabel(DriverPoolLabel, "").UpdateWithContext(ctx); err != nil {
+					glog.Errorf("Error removing label %s from node %s: %v", DriverPoolLabel, nodeName, err)
+				}
+			}
+		}
+	})
+
+	It("Should assign every GPU node to a driver pool", Label("driver-pools"), func(ctx SpecContext) {
+		for pool, nodeNames := range poolNodes {
+			for _, nodeName := range nodeNames {
+				nodeBuilder, err := nodes.PullWithContext(ctx, inittools.APIClient, nodeName)
+				Expect(err).ToNot(HaveOccurred(), "error pulling node %s: %v", nodeName, err)
+
+				_, err = nodeBuilder.RemoveLabel(DriverPoolLabel, "").
+					WithNewLabel(DriverPoolLabel, pool).
+					UpdateWithContext(ctx)
+				Expect(err).ToNot(HaveOccurred(), "error labeling node %s with driver pool %s: %v",
+					nodeName, pool, err)
+			}
+		}
+
+		labeled, err := check.AllNodeLabel(inittools.APIClient, DriverPoolLabel, poolNames, gpuNodeSelector)
+		Expect(err).ToNot(HaveOccurred(), "error checking the driver pool labels: %v", err)
+		Expect(labeled).To(BeTrue(), "not all GPU nodes are assigned to a driver pool")
+	})
+
+	It("Should reject overlapping driver pool node selectors", Label("driver-pools"), func() {
+		poolDrivers := slices.Collect(maps.Values(nvidiaDrivers))
+		Expect(nvidiagpu.CheckDisjointNodeSelectors(poolDrivers...)).To(Succeed())
+
+		overlappingDriver := nvidiagpu.NewNVIDIADriverBuilderFromObjectString(inittools.APIClient, almExamples).
+			WithName(OverlappingDriverName).
+			WithNodeSelector(gpuNodeSelector)
+		Expect(nvidiagpu.CheckDisjointNodeSelectors(append(poolDrivers, overlappingDriver)...)).ToNot(Succeed())
+	})
+
+	It("Should run the driver version of its pool on every GPU node", Label("driver-pools"), func(ctx SpecContext) {
+		By("Deploy ClusterPolicy with driver.useNvidiaDriverCRD")
+		clusterPolicyBuilder := nvidiagpu.NewBuilderFromObjectString(inittools.APIClient, almExamples).
+			WithNvidiaDriverCRD(true)
+
+		var err error
+		clusterPolicy, err = clusterPolicyBuilder.CreateWithContext(ctx)
+		Expect(err).ToNot(HaveOccurred(), "error creating ClusterPolicy: %v", err)
+
+		for _, pool := range poolNames {
+			By(fmt.Sprintf("Deploy NVIDIADriver %s with driver version %s", pool,
+				nvidiaGPUConfig.DriverPoolVersions[pool]))
+			_, err := nvidiaDrivers[pool].CreateWithContext(ctx)
+			Expect(err).ToNot(HaveOccurred(), "error creating NVIDIADriver %s: %v", pool, err)
+		}
+
+		for _, pool := range poolNames {
+			By(fmt.Sprintf("Wait up to %s for NVIDIADriver %s to be ready", nvidiagpu.NVIDIADriverReadyTimeout, pool))
+			err := wait.NVIDIADriverReadyWithContext(ctx, inittools.APIClient, pool,
+				nvidiagpu.ClusterPolicyReadyCheckInterval, nvidiagpu.NVIDIADriverReadyTimeout)
+			Expect(err).ToNot(HaveOccurred(), "error waiting for NVIDIADriver %s to be ready: %v", pool, err)
+		}
+
+		err = wait.ClusterPolicyReadyWithContext(ctx, inittools.APIClient, nvidiagpu.ClusterPolicyName,
+			nvidiagpu.ClusterPolicyReadyCheckInterval, nvidiagpu.ClusterPolicyReadyTimeout)
+		Expect(err).ToNot(HaveOccurred(), "error waiting for ClusterPolicy to be ready: %v", err)
+
+		for _, pool := range poolNames {
+			expectedVersion := nvidiaGPUConfig.DriverPoolVersions[pool]
+
+			By(fmt.Sprintf("Check the driver pods of NVIDIADriver %s run driver version %s", pool, expectedVersion))
+			nodeVersions := driverPoolVersions(ctx, nvidiaDrivers[pool])
+			Expect(slices.Sorted(maps.Keys(nodeVersions))).To(Equal(poolNodes[pool]),
+				"NVIDIADriver %s driver pods do not run on exactly the driver pool nodes", pool)
+
+			for nodeName, version := range nodeVersions {
+				Expect(nvidiagpu.DriverVersionMatches(expectedVersion, version)).To(BeTrue(),
+					"driver pod on node %s runs driver version %s instead of %s", nodeName, version, expectedVersion)
+			}
+
+			By(fmt.Sprintf("Check the GFD labels of the driver pool %s nodes show driver version %s", pool,
+				expectedVersion))
+
+			for _, nodeName := range poolNodes[pool] {
+				var gfdVersion string
+
+				Eventually(func() bool {
+					nodeBuilder, err := nodes.PullWithContext(ctx, inittools.APIClient, nodeName)
+					if err != nil {
+						glog.Errorf("Error pulling node %s: %v", nodeName, err)
+
+						return false
+					}
+
+					gfdVersion = nvidiagpu.GFDDriverVersion(nodeBuilder.Object)
+
+					return nvidiagpu.DriverVersionMatches(expectedVersion, gfdVersion)
+				}, TestDuration, TimeStep).Should(BeTrue(), func() string {
+					return fmt.Sprintf("node %s GFD driver version is '%s' instead of %s", nodeName, gfdVersion,
+						expectedVersion)
+				})
+			}
+		}
+	})
+
+	It("Should not deploy an NVIDIADriver overlapping the driver pools", Label("driver-pools"),
+		func(ctx SpecContext) {
+			overlappingDriver, err := nvidiagpu.NewNVIDIADriverBuilderFromObjectString(inittools.APIClient,
+				almExamples).
+				WithName(OverlappingDriverName).
+				WithNodeSelector(gpuNodeSelector).
+				CreateWithContext(ctx)
+			Expect(err).ToNot(HaveOccurred(), "error creating NVIDIADriver %s: %v", OverlappingDriverName, err)
+
+			DeferCleanup(func(ctx SpecContext) {
+				_, err := overlappingDriver.DeleteWithContext(ctx)
+				Expect(err).ToNot(HaveOccurred(), "error deleting NVIDIADriver %s: %v", OverlappingDriverName, err)
+			})
+
+			Consistently(func() nvidiagpuv1alpha1.State {
+				pulledDriver, err := nvidiagpu.PullNVIDIADriverWithContext(ctx, inittools.APIClient,
+					OverlappingDriverName)
+				if err != nil {
+					glog.Errorf("Error pulling NVIDIADriver %s: %v", OverlappingDriverName, err)
+
+					return ""
+				}
+
+				return pulledDriver.Object.Status.State
+			}, OverlapCheckDuration, TimeStep).ShouldNot(Equal(nvidiagpuv1alpha1.Ready),
+				"NVIDIADriver %s overlapping the driver pools became ready", OverlappingDriverName)
+
+			for _, pool := range poolNames {
+				Expect(slices.Sorted(maps.Keys(driverPoolVersions(ctx, nvidiaDrivers[pool])))).
+					To(Equal(poolNodes[pool]), "NVIDIADriver %s driver pods changed", pool)
+			}
+		})
+})
+
+// driverPoolVersions returns the driver version of the NVIDIADriver pods keyed by node name.
+func driverPoolVersions(ctx context.Context, nvidiaDriver *nvidiagpu.NVIDIADriverBuilder) map[string]string {
+	namespace := nvidiagpu.NvidiaGPUNamespace
+	if nvidiaDriver.Object != nil && nvidiaDriver.Object.Status.Namespace != "" {
+		namespace = nvidiaDriver.Object.Status.Namespace
+	}
+
+	nodeVersions, err := nvidiaDriver.NodeDriverVersionsWithContext(ctx, namespace)
+	Expect(err).ToNot(HaveOccurred(), "error getting NVIDIADriver %s driver versions: %v",
+		nvidiaDriver.Definition.Name, err)
+
+	glog.V(gpuparams.GpuLogLevel).Infof("NVIDIADriver %s driver versions: %v", nvidiaDriver.Definition.Name,
+		nodeVersions)
+
+	return nodeVersions
+}
+
+// ensureOnlyOperatorIsRunning waits until the operand DaemonSets and pods are removed from the operator namespace.
+func ensureOnlyOperatorIsRunning() {
+	var remaining []string
+
+	Eventually(func() bool {
+		var err error
+
+		remaining, err = nvidiagpu.RemainingOperands(context.TODO(), inittools.APIClient,
+			nvidiagpu.NvidiaGPUNamespace)
+		if err != nil {
+			glog.Errorf("Error listing remaining operands: %v", err)
+
+			return false
+		}
+
+		return len(remaining) == 0
+	}, TestDuration, TimeStep).Should(BeTrue(), func() string {
+		return fmt.Sprintf("operands still present in namespace %s: %v", nvidiagpu.NvidiaGPUNamespace, remaining)
+	})
+}
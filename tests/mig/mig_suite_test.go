@@ -0,0 +1,27 @@
+package mig
+
+import (
+	"runtime"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/runsummary"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/suite"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/tsparams"
+)
+
+var _, currentFile, _, _ = runtime.Caller(0)
+
+var runSummary = runsummary.New("MIG")
+
+var _ = suite.Register(runSummary, currentFile, tsparams.MigReporterNamespacesToDump, tsparams.MigReporterCRDsToDump)
+
+func TestMIG(t *testing.T) {
+	_, reporterConfig := GinkgoConfiguration()
+	reporterConfig.JUnitReport = inittools.GeneralConfig.GetJunitReportPath(currentFile)
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "MIG", Label("nvidia-ci", "mig"), reporterConfig)
+}
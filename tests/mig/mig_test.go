@@ -0,0 +1,279 @@
+package mig
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	"github.com/golang/glog"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/imagecatalog"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/nvidiagpuconfig"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/tsparams"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/wait"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/namespace"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nodes"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiasmi"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// TestNamespace is the namespace where the MIG workloads run.
+	TestNamespace = "test-mig"
+	// WorkloadContainerName is the name of the container the MIG devices it was allocated are queried in.
+	WorkloadContainerName = "mig-workload"
+	// MIGConfigTimeout is how long to wait for the mig-manager to apply a MIG configuration.
+	MIGConfigTimeout = 20 * time.Minute
+	// TestDuration is how long to wait for the node to advertise the MIG devices and for the workload to run.
+	TestDuration = 10 * time.Minute
+	// TimeStep is the polling interval of the test.
+	TimeStep = 30 * time.Second
+)
+
+var (
+	nvidiaGPUConfig *nvidiagpuconfig.NvidiaGPUConfig
+	imageCatalog    *imagecatalog.Catalog
+	imageCatalogErr error
+	// workloadImage is the container image of the MIG workload, resolved for the architecture of the MIG node.
+	workloadImage string
+)
+
+var _ = Describe("MIG", Ordered, Label(tsparams.LabelSuite), func() {
+	var (
+		migNode           string
+		slice             string
+		originalStrategy  nvidiagpuv1.MIGStrategy
+		originalMIGConfig string
+		hadMIGConfig      bool
+		nsBuilder         *namespace.Builder
+	)
+
+	nvidiaGPUConfig = nvidiagpuconfig.NewNvidiaGPUConfig()
+	imageCatalog, imageCatalogErr = imagecatalog.NewCatalog()
+
+	BeforeAll(func(ctx SpecContext) {
+		glog.V(gpuparams.GpuLogLevel).Info("Starting MIG test suite")
+
+		Expect(nvidiaGPUConfig).ToNot(BeNil(), "error loading NvidiaGPUConfig from profile file "+
+			"and NVIDIAGPU_* env variables")
+		Expect(imageCatalogErr).ToNot(HaveOccurred(), "error loading the image catalog: %v", imageCatalogErr)
+
+		if err := inittools.GeneralConfig.WriteEffectiveConfig(nvidiagpuconfig.ProfileSection,
+			nvidiaGPUConfig); err != nil {
+			glog.Error("Error writing the effective NvidiaGPUConfig: ", err)
+		}
+
+		if err := inittools.GeneralConfig.WriteEffectiveConfig(imagecatalog.ProfileSection,
+			imageCatalog); err != nil {
+			glog.Error("Error writing the effective image catalog: ", err)
+		}
+
+		if nvidiaGPUConfig.MIGConfig == "" {
+			Skip("NVIDIAGPU_MIG_CONFIG must define the MIG configuration to apply, such as all-1g.10gb")
+		}
+
+		var err error
+		slice, err = nvidiagpu.MIGSlice(nvidiaGPUConfig.MIGConfig)
+		Expect(err).ToNot(HaveOccurred(), "invalid NVIDIAGPU_MIG_CONFIG: %v", err)
+
+		migNodes, err := nodes.ListWithContext(ctx, inittools.APIClient, metav1.ListOptions{
+			LabelSelector: labels.Set{nvidiagpu.MIGCapableLabel: "true"}.String()})
+		Expect(err).ToNot(HaveOccurred(), "error listing MIG capable nodes: %v", err)
+
+		if len(migNodes) == 0 {
+			Skip(fmt.Sprintf("no node labeled %s=true found", nvidiagpu.MIGCapableLabel))
+		}
+
+		var migNodeNames []string
+		for _, node := range migNodes {
+			migNodeNames = append(migNodeNames, node.Object.Name)
+		}
+
+		migNode = slices.Min(migNodeNames)
+		migNodeLabels := migNodes[slices.Index(migNodeNames, migNode)].Object.Labels
+		originalMIGConfig, hadMIGConfig = migNodeLabels[nvidiagpu.MIGConfigLabel]
+
+		workloadImage, err = imageCatalog.Image(imagecatalog.CUDABase, migNodeLabels[corev1.LabelArchStable])
+		Expect(err).ToNot(HaveOccurred(), "error resolving the MIG workload image: %v", err)
+
+		glog.V(gpuparams.GpuLogLevel).Infof("Running the MIG workloads on node %s, original MIG config '%s'",
+			migNode, originalMIGConfig)
+
+		clusterPolicy, err := nvidiagpu.PullWithContext(ctx, inittools.APIClient, nvidiagpu.ClusterPolicyName)
+		Expect(err).ToNot(HaveOccurred(), "error pulling ClusterPolicy, the MIG tests require a deployed "+
+			"GPU operator: %v", err)
+
+		originalStrategy = clusterPolicy.Object.Spec.MIG.Strategy
+		if originalStrategy == "" {
+			originalStrategy = nvidiagpuv1.MIGStrategySingle
+		}
+
+		nsBuilder = namespace.NewBuilder(inittools.APIClient, TestNamespace)
+		if !nsBuilder.ExistsWithContext(ctx) {
+			_, err := nsBuilder.WithMultipleLabels(map[string]string{
+				"pod-security.kubernetes.io/enforce": "privileged",
+			}).CreateWithContext(ctx)
+			Expect(err).ToNot(HaveOccurred(), "error creating namespace %s: %v", TestNamespace, err)
+		}
+	})
+
+	AfterAll(func(ctx SpecContext) {
+		if migNode == "" {
+			return
+		}
+
+		if err := setMIGStrategy(ctx, originalStrategy); err != nil {
+			glog.Errorf("Error restoring ClusterPolicy MIG strategy %s: %v", originalStrategy, err)
+		}
+
+		if err := restoreMIGConfig(ctx, migNode, originalMIGConfig, hadMIGConfig); err != nil {
+			glog.Errorf("Error restoring node %s MIG config '%s': %v", migNode, originalMIGConfig, err)
+		}
+
+		if err := nsBuilder.DeleteWithContext(ctx); err != nil {
+			glog.Errorf("Error deleting namespace %s: %v", TestNamespace, err)
+		}
+	})
+
+	for _, strategy := range []nvidiagpuv1.MIGStrategy{nvidiagpuv1.MIGStrategySingle, nvidiagpuv1.MIGStrategyMixed} {
+		It(fmt.Sprintf("Should run a workload on a MIG device with the %s MIG strategy", strategy), Label("mig"),
+			func(ctx SpecContext) {
+				By(fmt.Sprintf("Set ClusterPolicy MIG strategy %s", strategy))
+				Expect(setMIGStrategy(ctx, strategy)).To(Succeed(), "error setting ClusterPolicy MIG strategy")
+
+				By(fmt.Sprintf("Label node %s with MIG config %s", migNode, nvidiaGPUConfig.MIGConfig))
+				Expect(applyMIGConfig(ctx, migNode, nvidiaGPUConfig.MIGConfig)).To(Succeed(),
+					"error labeling node %s with MIG config", migNode)
+
+				By(fmt.Sprintf("Wait up to %s for the mig-manager to apply the MIG config", MIGConfigTimeout))
+				err := wait.MIGConfigAppliedWithContext(ctx, inittools.APIClient, migNode,
+					nvidiaGPUConfig.MIGConfig, TimeStep, MIGConfigTimeout)
+				Expect(err).ToNot(HaveOccurred(), "error waiting for MIG config %s on node %s: %v",
+					nvidiaGPUConfig.MIGConfig, migNode, err)
+
+				err = wait.ClusterPolicyReadyWithContext(ctx, inittools.APIClient, nvidiagpu.ClusterPolicyName,
+					nvidiagpu.ClusterPolicyReadyCheckInterval, nvidiagpu.ClusterPolicyReadyTimeout)
+				Expect(err).ToNot(HaveOccurred(), "error waiting for ClusterPolicy to be ready: %v", err)
+
+				By(fmt.Sprintf("Check node %s advertises the %s MIG devices", migNode, slice))
+				Eventually(func() error {
+					node, err := nodes.PullWithContext(ctx, inittools.APIClient, migNode)
+					if err != nil {
+						return err
+					}
+
+					return nvidiagpu.CheckNodeMIGConfig(node.Object, strategy, nvidiaGPUConfig.MIGConfig)
+				}, TestDuration, TimeStep).Should(Succeed(), "node %s does not advertise the MIG devices", migNode)
+
+				By(fmt.Sprintf("Run a workload on a %s MIG device", slice))
+				resourceName, err := nvidiagpu.MIGResourceName(strategy, slice)
+				Expect(err).ToNot(HaveOccurred(), "error getting the MIG resource name: %v", err)
+
+				smiLog := runMIGWorkload(ctx, fmt.Sprintf("%s-%s", WorkloadContainerName, strategy), migNode,
+					resourceName)
+				Expect(nvidiagpu.CheckMIGDevice(smiLog, slice)).To(Succeed(),
+					"MIG workload was not allocated exactly one %s MIG device", slice)
+			})
+	}
+})
+
+// setMIGStrategy updates the MIG strategy of the ClusterPolicy.
+func setMIGStrategy(ctx context.Context, strategy nvidiagpuv1.MIGStrategy) error {
+	clusterPolicy, err := nvidiagpu.PullWithContext(ctx, inittools.APIClient, nvidiagpu.ClusterPolicyName)
+	if err != nil {
+		return err
+	}
+
+	if clusterPolicy.Object.Spec.MIG.Strategy == strategy {
+		return nil
+	}
+
+	_, err = clusterPolicy.WithMIGStrategy(strategy).UpdateWithContext(ctx, true)
+
+	return err
+}
+
+// applyMIGConfig labels the node with the MIG config. The MIG config state label is removed along with a changed
+// MIG config label so that a stale success state of the previous config is not mistaken for the new one.
+func applyMIGConfig(ctx context.Context, nodeName, migConfig string) error {
+	node, err := nodes.PullWithContext(ctx, inittools.APIClient, nodeName)
+	if err != nil {
+		return err
+	}
+
+	if node.Object.Labels[nvidiagpu.MIGConfigLabel] == migConfig {
+		return nil
+	}
+
+	_, err = node.RemoveLabel(nvidiagpu.MIGConfigLabel, "").
+		RemoveLabel(nvidiagpu.MIGConfigStateLabel, "").
+		WithNewLabel(nvidiagpu.MIGConfigLabel, migConfig).
+		UpdateWithContext(ctx)
+
+	return err
+}
+
+// restoreMIGConfig relabels the node with its original MIG config and waits for the mig-manager to apply it, or
+// removes the MIG config label if the node had none.
+func restoreMIGConfig(ctx context.Context, nodeName, migConfig string, hadMIGConfig bool) error {
+	if !hadMIGConfig {
+		node, err := nodes.PullWithContext(ctx, inittools.APIClient, nodeName)
+		if err != nil {
+			return err
+		}
+
+		_, err = node.RemoveLabel(nvidiagpu.MIGConfigLabel, "").UpdateWithContext(ctx)
+
+		return err
+	}
+
+	if err := applyMIGConfig(ctx, nodeName, migConfig); err != nil {
+		return err
+	}
+
+	return wait.MIGConfigAppliedWithContext(ctx, inittools.APIClient, nodeName, migConfig, TimeStep,
+		MIGConfigTimeout)
+}
+
+// runMIGWorkload runs a pod on the node with one MIG device resource and returns the nvidia-smi query of its GPUs.
+func runMIGWorkload(ctx context.Context, podName, nodeName string,
+	resourceName corev1.ResourceName) *nvidiasmi.Log {
+	container, err := pod.NewContainerBuilder(WorkloadContainerName, workloadImage, []string{"sleep", "infinity"}).
+		WithCustomResourcesLimits(corev1.ResourceList{resourceName: resource.MustParse("1")}).
+		GetContainerCfg()
+	Expect(err).ToNot(HaveOccurred(), "error defining the MIG workload container: %v", err)
+
+	workload, err := pod.NewBuilder(inittools.APIClient, podName, TestNamespace, workloadImage).
+		RedefineDefaultContainer(*container).
+		DefineOnNode(nodeName).
+		WithRestartPolicy(corev1.RestartPolicyNever).
+		CreateWithContext(ctx)
+	Expect(err).ToNot(HaveOccurred(), "error creating MIG workload pod %s: %v", podName, err)
+
+	DeferCleanup(func(ctx SpecContext) {
+		if _, err := workload.DeleteWithContext(ctx); err != nil {
+			glog.Errorf("Error deleting MIG workload pod %s: %v", podName, err)
+		}
+	})
+
+	err = workload.WaitUntilInStatusWithContext(ctx, corev1.PodRunning, TestDuration)
+	Expect(err).ToNot(HaveOccurred(), "error waiting for MIG workload pod %s to run: %v", podName, err)
+
+	smiLog, err := nvidiasmi.QueryWithContext(ctx, workload, WorkloadContainerName)
+	Expect(err).ToNot(HaveOccurred(), "error querying the GPUs of MIG workload pod %s: %v", podName, err)
+
+	glog.V(gpuparams.GpuLogLevel).Infof("MIG workload pod %s has %d MIG devices", podName,
+		smiLog.MIGDeviceCount())
+
+	return smiLog
+}
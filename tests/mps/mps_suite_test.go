@@ -4,8 +4,12 @@ import (
 	"runtime"
 	"testing"
 
+	"github.com/golang/glog"
+
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/reporter"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/runsummary"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -15,6 +19,8 @@ import (
 
 var _, currentFile, _, _ = runtime.Caller(0)
 
+var runSummary = runsummary.New("MPS")
+
 func TestMPS(t *testing.T) {
 	_, reporterConfig := GinkgoConfiguration()
 	reporterConfig.JUnitReport = inittools.GeneralConfig.GetJunitReportPath(currentFile)
@@ -23,9 +29,31 @@ func TestMPS(t *testing.T) {
 	RunSpecs(t, "MPS", Label("nvidia-ci", "mps"), reporterConfig)
 }
 
-var _ = JustAfterEach(func() {
+var _ = JustAfterEach(func(ctx SpecContext) {
 	specReport := CurrentSpecReport()
 	reporter.ReportIfFailed(
 		specReport, currentFile, tsparams.MpsReporterNamespacesToDump, tsparams.MpsReporterCRDsToDump, clients.SetScheme)
+	reporter.CollectMustGatherIfFailed(ctx, specReport, tsparams.MpsReporterNamespacesToDump)
+
+})
+
+var _ = BeforeEach(func() {
+	specLogPath := inittools.GeneralConfig.GetReportPath(logging.SpecLogFileName(CurrentSpecReport().FullText()))
+	if err := logging.StartSpecLog(specLogPath); err != nil {
+		glog.Errorf("Failed to start spec log: %v", err)
+	}
+})
+
+var _ = AfterEach(func() {
+	if err := logging.StopSpecLog(); err != nil {
+		glog.Errorf("Failed to stop spec log: %v", err)
+	}
+})
+
+var _ = ReportAfterSuite("run summary", func(report Report) {
+	runSummary.AddSpecReports(report.SpecReports)
 
+	if err := runSummary.Write(inittools.GeneralConfig); err != nil {
+		glog.Errorf("Failed to write run summary: %v", err)
+	}
 })
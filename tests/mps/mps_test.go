@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"regexp"
 	"strings"
 	"time"
 
@@ -12,16 +11,19 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/imagecatalog"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/mps"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/nvidiagpuconfig"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/tsparams"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/workload"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/configmap"
 
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/namespace"
 
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nodes"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiasmi"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/pod"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,8 +43,6 @@ const (
 	PodName = "mps-test-pod"
 	// MPSReplicas is the number of GPU replicas for MPS
 	MPSReplicas = 10
-	// MPSImage is the container image for MPS testing
-	MPSImage = "nvcr.io/nvidia/pytorch:23.12-py3"
 	// Number of worker pods to create
 	NumWorkerPods = 20
 	// TestDuration is how long each pod should run
@@ -51,10 +51,14 @@ const (
 	GPUOperatorNamespace = "nvidia-gpu-operator"
 	LargeMPSReplicas     = 49
 	TimeStep             = "30s"
+	// MPSWorkerSuccessTimeout is how long the MPS worker pods may take to complete their workload
+	MPSWorkerSuccessTimeout = mps.WorkerDuration + 5*time.Minute
 )
 
 var (
 	nvidiaGPUConfig *nvidiagpuconfig.NvidiaGPUConfig
+	imageCatalog    *imagecatalog.Catalog
+	imageCatalogErr error
 )
 
 var _ = Describe("MPS", Ordered, Label(tsparams.LabelSuite), func() {
@@ -64,11 +68,26 @@ var _ = Describe("MPS", Ordered, Label(tsparams.LabelSuite), func() {
 		clusterPolicy *nvidiagpu.Builder
 	)
 	nvidiaGPUConfig = nvidiagpuconfig.NewNvidiaGPUConfig()
+	imageCatalog, imageCatalogErr = imagecatalog.NewCatalog()
 
 	BeforeAll(func() {
 		// Set log level
 		glog.V(gpuparams.GpuLogLevel).Info("Starting MPS test suite")
 
+		Expect(nvidiaGPUConfig).ToNot(BeNil(), "error loading NvidiaGPUConfig from profile file "+
+			"and NVIDIAGPU_* env variables")
+		Expect(imageCatalogErr).ToNot(HaveOccurred(), "error loading the image catalog: %v", imageCatalogErr)
+
+		if err := inittools.GeneralConfig.WriteEffectiveConfig(nvidiagpuconfig.ProfileSection,
+			nvidiaGPUConfig); err != nil {
+			glog.Error("Error writing the effective NvidiaGPUConfig: ", err)
+		}
+
+		if err := inittools.GeneralConfig.WriteEffectiveConfig(imagecatalog.ProfileSection,
+			imageCatalog); err != nil {
+			glog.Error("Error writing the effective image catalog: ", err)
+		}
+
 		if tmpClusterPolicyBulider, err := nvidiagpu.Pull(inittools.APIClient, nvidiagpu.ClusterPolicyName); err == nil {
 			if _, err := tmpClusterPolicyBulider.Get(); err == nil {
 
@@ -119,7 +138,7 @@ var _ = Describe("MPS", Ordered, Label(tsparams.LabelSuite), func() {
 	})
 
 	Context("MPS with large number of replicas", Label("mps-with-large-replicas"), func() {
-		It("MPS daemon should fail and log error message", Label("mps"), func() {
+		It("MPS daemon should fail and log error message", Label("mps"), func(ctx SpecContext) {
 
 			var err error
 			configMap, err = mps.CreateDevicePluginConfigMap(
@@ -164,7 +183,7 @@ var _ = Describe("MPS", Ordered, Label(tsparams.LabelSuite), func() {
 				return false
 			}, TestDuration, TimeStep).Should(BeTrue(), "MPS daemon failed as expected")
 
-			mpsDaemons, err := pod.List(inittools.APIClient, GPUOperatorNamespace, metav1.ListOptions{LabelSelector: "app=nvidia-device-plugin-mps-control-daemon"})
+			mpsDaemons, err := pod.ListWithContext(ctx, inittools.APIClient, GPUOperatorNamespace, metav1.ListOptions{LabelSelector: "app=nvidia-device-plugin-mps-control-daemon"})
 			Expect(err).ToNot(HaveOccurred(), "Failed locate MPS daemon %v", err)
 			if len(mpsDaemons) == 0 {
 				glog.Errorf("No NVIDIA driver pods found in namespace %s", GPUOperatorNamespace)
@@ -218,7 +237,7 @@ var _ = Describe("MPS", Ordered, Label(tsparams.LabelSuite), func() {
 	})
 
 	Context("MPS Multiple Worker Pods", Label("mps-with-valid-replicas-number"), func() {
-		It("Should run multiple worker pods with MPS enabled", Label("mps"), func() {
+		It("Should run multiple worker pods with MPS enabled", Label("mps"), func(ctx SpecContext) {
 
 			var err error
 			// Create worker ConfigMap
@@ -234,44 +253,20 @@ var _ = Describe("MPS", Ordered, Label(tsparams.LabelSuite), func() {
 			Expect(configMap).ToNot(BeNil())
 
 			EnsureAllGpuPodsAreRunning()
-			testPodsCM, err := mps.CreateWorkerPodConfigMap(inittools.APIClient, TestNamespace)
-			Expect(err).ToNot(HaveOccurred(), "error creating MPS worker ConfigMap: %v", err)
-			DeferCleanup(func() {
-				err = testPodsCM.Delete()
-				glog.Error("failed deleteing mps worker config map %v", err)
-			})
 			// Create and run multiple worker pods
-			for i := 0; i < NumWorkerPods; i++ {
-				workerPodName := fmt.Sprintf("mps-worker-%d", i)
-
-				// Create MPS worker pod
-				workerPod, err := mps.CreateMPSTestPod(
-					inittools.APIClient,
-					workerPodName,
-					TestNamespace,
-					MPSImage,
-				)
-				Expect(err).ToNot(HaveOccurred(), "error creating MPS worker pod %s: %v", workerPodName, err)
-				Expect(workerPod).ToNot(BeNil())
-
-				// Create pod in cluster
-				_, err = inittools.APIClient.Pods(TestNamespace).Create(context.TODO(), workerPod, metav1.CreateOptions{})
-				Expect(err).ToNot(HaveOccurred(), "error creating worker pod %s in cluster: %v", workerPodName, err)
-
-				// Cleanup worker pod
-				DeferCleanup(func() {
-					podBuilder, err := pod.Pull(inittools.APIClient, workerPodName, TestNamespace)
-					if err != nil {
-						glog.Errorf("Error pulling pod %s: %v", workerPodName, err)
-					} else {
-						_, err = podBuilder.Delete()
-						if err != nil {
-							glog.Errorf("Error deleting pod %s: %v", workerPodName, err)
-						}
-					}
-				})
-
-			}
+			mpsImage, err := imageCatalog.Resolve(inittools.APIClient, imagecatalog.MPSPyTorch,
+				inittools.GeneralConfig.WorkerLabelMap)
+			Expect(err).ToNot(HaveOccurred(), "error resolving the MPS worker image: %v", err)
+
+			mpsWorker := &mps.Workload{Image: mpsImage}
+			workerRun, err := workload.DeployWithContext(ctx, inittools.APIClient, mpsWorker, TestNamespace,
+				workload.Targets("mps-worker", NumWorkerPods))
+			DeferCleanup(func(ctx SpecContext) {
+				if err := workerRun.CleanupWithContext(ctx); err != nil {
+					glog.Errorf("Error cleaning up the MPS worker pods: %v", err)
+				}
+			})
+			Expect(err).ToNot(HaveOccurred(), "error deploying the MPS worker pods: %v", err)
 
 			// Wait for worker pods to run for a while
 			glog.V(gpuparams.GpuLogLevel).Infof("Waiting for worker pods to run for 2 minutes...")
@@ -310,18 +305,17 @@ var _ = Describe("MPS", Ordered, Label(tsparams.LabelSuite), func() {
 			for _, driverPod := range driverPods.Items {
 				glog.V(gpuparams.GpuLogLevel).Infof("Executing nvidia-smi commands on driver pod %s", driverPod.Name)
 
-				// Execute nvidia-smi
-				cmd := []string{"nvidia-smi"}
-				output, err := executeCommandInPod(driverPod.Name, GPUOperatorNamespace, cmd)
-				Expect(err).ToNot(HaveOccurred(), "error executing nvidia-smi on pod %s: %v", driverPod.Name, err)
-				glog.V(gpuparams.GpuLogLevel).Infof("nvidia-smi output from pod %s:\n%s", driverPod.Name, output)
-
-				// Parse the nvidia-smi output to check for Python processes in M+C mode
-				parseNvidiaSmiOutput(output, driverPod.Name)
+				// Query nvidia-smi to check for Python processes in M+C mode
+				driverPodBuilder, err := pod.PullWithContext(ctx, inittools.APIClient, driverPod.Name,
+					GPUOperatorNamespace)
+				Expect(err).ToNot(HaveOccurred(), "error pulling driver pod %s: %v", driverPod.Name, err)
+				smiLog, err := nvidiasmi.QueryWithContext(ctx, driverPodBuilder, nvidiagpu.DriverContainerName)
+				Expect(err).ToNot(HaveOccurred(), "error querying nvidia-smi on pod %s: %v", driverPod.Name, err)
+				checkMPSProcesses(smiLog, driverPod.Name)
 
 				// Execute nvidia-smi pmon -c 1 -s m
-				cmd = []string{"nvidia-smi", "pmon", "-c", "1", "-s", "m"}
-				output, err = executeCommandInPod(driverPod.Name, GPUOperatorNamespace, cmd)
+				cmd := []string{"nvidia-smi", "pmon", "-c", "1", "-s", "m"}
+				output, err := executeCommandInPod(driverPod.Name, GPUOperatorNamespace, cmd)
 				Expect(err).ToNot(HaveOccurred(), "error executing nvidia-smi pmon on pod %s: %v", driverPod.Name, err)
 				glog.V(gpuparams.GpuLogLevel).Infof("nvidia-smi pmon output from pod %s:\n%s", driverPod.Name, output)
 
@@ -335,11 +329,22 @@ var _ = Describe("MPS", Ordered, Label(tsparams.LabelSuite), func() {
 				glog.V(gpuparams.GpuLogLevel).Infof("nvidia-smi topo output from pod %s:\n%s", driverPod.Name, output)
 			}
 
+			By(fmt.Sprintf("Wait for up to %s for the MPS worker pods to complete", MPSWorkerSuccessTimeout))
+			err = workerRun.WaitForPhaseWithContext(ctx, corev1.PodSucceeded, MPSWorkerSuccessTimeout)
+			Expect(err).ToNot(HaveOccurred(), "error waiting for the MPS worker pods to complete: %v", err)
+
+			workerResults, err := workerRun.ValidateWithContext(ctx)
+			Expect(err).ToNot(HaveOccurred(), "error validating the MPS worker pods output: %v", err)
+
+			for _, workerResult := range workerResults {
+				Expect(workerResult.Problems).To(BeEmpty(), "MPS worker pod %s failed", workerResult.PodName)
+			}
+
 		})
 	})
 
 	Context("MPS renameByDefault set to true", Label("mps-renameByDefault"), func() {
-		It("Node should advertise on gpu.shared", Label("mps"), func() {
+		It("Node should advertise on gpu.shared", Label("mps"), func(ctx SpecContext) {
 			var err error
 			configMap, err = mps.CreateDevicePluginConfigMap(
 				inittools.APIClient,
@@ -351,7 +356,7 @@ var _ = Describe("MPS", Ordered, Label(tsparams.LabelSuite), func() {
 			clusterPolicy, err = mps.CreateClusterPolicyFromCSV(inittools.APIClient, GPUOperatorNamespace, nvidiagpu.ClusterPolicyName)
 			Expect(err).ToNot(HaveOccurred(), "error updating cluster policy: %v", err)
 			EnsureAllGpuPodsAreRunning()
-			clusterNodes, err := nodes.List(inittools.APIClient)
+			clusterNodes, err := nodes.ListWithContext(ctx, inittools.APIClient)
 			Expect(err).ToNot(HaveOccurred(), "error updating cluster policy: %v", err)
 
 			for _, clusterNode := range clusterNodes {
@@ -418,141 +423,64 @@ func executeCommandInPod(podName, namespace string, command []string) (string, e
 	return stdout.String() + stderr.String(), nil
 }
 
-// parseNvidiaSmiOutput parses the nvidia-smi output to check for Python processes in M+C mode
-func parseNvidiaSmiOutput(output, podName string) {
-	// Split the output into lines
-	lines := strings.Split(output, "\n")
-
-	// Find the table header line
-	var headerLineIndex int
-	for i, line := range lines {
-		if strings.Contains(line, "PID") && strings.Contains(line, "Type") {
-			headerLineIndex = i
-			break
+// checkMPSProcesses checks that the Python processes reported by nvidia-smi are running in M+C mode
+func checkMPSProcesses(smiLog *nvidiasmi.Log, podName string) {
+	for _, process := range smiLog.Processes() {
+		if !strings.Contains(process.ProcessName, "python") {
+			continue
 		}
-	}
-
-	// If we found a header line, parse the table
-	if headerLineIndex > 0 && headerLineIndex < len(lines)-1 {
-		// Get the separator line to determine column positions
-		separatorLine := lines[headerLineIndex+1]
-
-		// Find column positions
-		pidPos := strings.Index(separatorLine, "PID")
-		typePos := strings.Index(separatorLine, "Type")
-		namePos := strings.Index(separatorLine, "Name")
-
-		if pidPos >= 0 && typePos >= 0 && namePos >= 0 {
-			// Process each row in the table
-			for i := headerLineIndex + 2; i < len(lines); i++ {
-				line := lines[i]
-				if len(line) < namePos {
-					continue
-				}
-
-				// Extract process name
-				processName := strings.TrimSpace(line[namePos:])
 
-				// Check if it's a Python process
-				if strings.Contains(processName, "python") {
-					// Extract process type
-					processType := ""
-					if len(line) >= typePos+10 {
-						processType = strings.TrimSpace(line[typePos:namePos])
-					}
-
-					// Verify it's running in M+C mode
-					Expect(processType).To(ContainSubstring("M+C"),
-						"Python process %s is not running in M+C mode in pod %s",
-						processName, podName)
+		Expect(process.Type).To(Equal(nvidiasmi.MPSComputeProcess),
+			"Python process %s (PID %d) is not running in M+C mode in pod %s",
+			process.ProcessName, process.PID, podName)
 
-					glog.V(gpuparams.GpuLogLevel).Infof("Found Python process %s running in %s mode", processName, processType)
-				}
-			}
-		}
-	} else {
-		// If we couldn't find a table, try a simpler approach with regex
-		pythonProcessRegex := regexp.MustCompile(`(\d+)\s+(\w+)\s+(\w+)\s+python`)
-		matches := pythonProcessRegex.FindAllStringSubmatch(output, -1)
-
-		for _, match := range matches {
-			if len(match) >= 4 {
-				pid := match[1]
-				processType := match[2]
-				processName := match[3]
-
-				Expect(processType).To(ContainSubstring("M+C"),
-					"Python process %s (PID %s) is not running in M+C mode in pod %s",
-					processName, pid, podName)
-
-				glog.V(gpuparams.GpuLogLevel).Infof("Found Python process %s (PID %s) running in %s mode",
-					processName, pid, processType)
-			}
-		}
+		glog.V(gpuparams.GpuLogLevel).Infof("Found Python process %s (PID %d) running in %s mode",
+			process.ProcessName, process.PID, process.Type)
 	}
 }
 
+// EnsureAllGpuPodsAreRunning waits until every operand expected from the ClusterPolicy spec is healthy.
 func EnsureAllGpuPodsAreRunning() {
+	var report *nvidiagpu.HealthReport
 
 	Eventually(func() bool {
-		driverPods, err := inittools.APIClient.Pods(GPUOperatorNamespace).List(context.TODO(), metav1.ListOptions{})
+		clusterPolicyBuilder, err := nvidiagpu.Pull(inittools.APIClient, nvidiagpu.ClusterPolicyName)
 		if err != nil {
-			glog.Errorf("Error listing NVIDIA driver pods: %v", err)
+			glog.Errorf("Error pulling ClusterPolicy: %v", err)
 			return false
 		}
 
-		if len(driverPods.Items) < 8 {
-			glog.Errorf("Not all NVIDIA driver pods found in namespace %s", GPUOperatorNamespace)
+		report, err = clusterPolicyBuilder.HealthReport()
+		if err != nil {
+			glog.Errorf("Error building ClusterPolicy health report: %v", err)
 			return false
 		}
 
-		for _, pod := range driverPods.Items {
-			glog.V(gpuparams.GpuLogLevel).Infof("Pod %s is %s ", pod.Name, pod.Status.Phase)
-			// Check container ready status
-			for _, containerStatus := range pod.Status.ContainerStatuses {
-				if !containerStatus.Ready && containerStatus.State.Terminated == nil {
-					return false
-				}
-
-			}
-		}
-		return true
-	}, TestDuration, TimeStep).Should(BeTrue(), "NVIDIA driver pods did not become ready")
+		glog.V(gpuparams.GpuLogLevel).Infof("%s", report)
 
-	driverPods, err := inittools.APIClient.Pods(GPUOperatorNamespace).List(context.TODO(), metav1.ListOptions{})
-	Expect(err).ToNot(HaveOccurred(), "error listing NVIDIA driver pods: %v", err)
-	Expect(driverPods.Items).ToNot(BeEmpty(), "no NVIDIA driver pods found")
-
-	// Verify all pods are running
-	for _, pod := range driverPods.Items {
-		Expect(pod.Status.Phase).To(Or(Equal(corev1.PodRunning), Equal(corev1.PodSucceeded)), "NVIDIA driver pod %s is not running or succeeded", pod.Name)
-	}
+		return report.Healthy()
+	}, TestDuration, TimeStep).Should(BeTrue(), func() string {
+		return fmt.Sprintf("ClusterPolicy operands did not become healthy: %v", report)
+	})
 }
 
+// EnsureOnlyOperatorIsRunning waits until the operand DaemonSets and pods are removed from the operator namespace.
 func EnsureOnlyOperatorIsRunning() {
+	var remaining []string
 
 	Eventually(func() bool {
-		driverPods, err := inittools.APIClient.Pods(GPUOperatorNamespace).List(context.TODO(), metav1.ListOptions{})
-		if err != nil {
-			glog.Errorf("Error listing NVIDIA driver pods: %v", err)
-			return false
-		}
+		var err error
 
-		if len(driverPods.Items) > 1 || len(driverPods.Items) == 0 {
-			glog.Errorf("Not all NVIDIA driver pods deleted in namespace %s or namespace is empty", GPUOperatorNamespace)
+		remaining, err = nvidiagpu.RemainingOperands(context.TODO(), inittools.APIClient, GPUOperatorNamespace)
+		if err != nil {
+			glog.Errorf("Error listing remaining operands in namespace %s: %v", GPUOperatorNamespace, err)
 			return false
 		}
 
-		for _, pod := range driverPods.Items {
-			glog.V(gpuparams.GpuLogLevel).Infof("Pod %s is %s ", pod.Name, pod.Status.Phase)
-			// Check container ready status
-			for _, containerStatus := range pod.Status.ContainerStatuses {
-				if !containerStatus.Ready && containerStatus.State.Terminated == nil {
-					return false
-				}
-			}
-		}
-		return true
-	}, TestDuration, TimeStep).Should(BeTrue(), "NVIDIA driver pods did not become ready")
+		glog.V(gpuparams.GpuLogLevel).Infof("Operands remaining in namespace %s: %v", GPUOperatorNamespace, remaining)
 
+		return len(remaining) == 0
+	}, TestDuration, TimeStep).Should(BeTrue(), func() string {
+		return fmt.Sprintf("operands still present in namespace %s: %v", GPUOperatorNamespace, remaining)
+	})
 }
@@ -4,13 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
-	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
-	nvidiagpuv1alpha1 "github.com/NVIDIA/k8s-operator-libs/api/upgrade/v1alpha1"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/imagecatalog"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
-	"github.com/rh-ecosystem-edge/nvidia-ci/internal/networkparams"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/runsummary"
 
 	internalNFD "github.com/rh-ecosystem-edge/nvidia-ci/internal/nfd"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/nvidiagpuconfig"
@@ -20,13 +20,13 @@ import (
 
 	nfd "github.com/rh-ecosystem-edge/nvidia-ci/pkg/nfd"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nfdcheck"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nodes"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/operatorconfig"
 
 	"github.com/golang/glog"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/configmap"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/deployment"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/namespace"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/olm"
@@ -34,17 +34,24 @@ import (
 
 	"github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/check"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/cudasamples"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/dcgm"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/deploy"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/get"
 	gpuburn "github.com/rh-ecosystem-edge/nvidia-ci/internal/gpu-burn"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
+	_ "github.com/rh-ecosystem-edge/nvidia-ci/internal/mps"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/tsparams"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/wait"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/workload"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 var (
+	runSummary = runsummary.New("GPU")
+
 	nfdInstance = operatorconfig.NewCustomConfig()
 	burn        = nvidiagpu.NewDefaultGPUBurnConfig()
 
@@ -55,11 +62,6 @@ var (
 		nvidiagpu.NvidiaGPULabel:            "true",
 	}
 
-	BurnImageName = map[string]string{
-		"amd64": "quay.io/wabouham/gpu_burn_amd64:ubi9",
-		"arm64": "quay.io/wabouham/gpu_burn_arm64:ubi9",
-	}
-
 	machineSetNamespace         = "openshift-machine-api"
 	replicas              int32 = 1
 	workerMachineSetLabel       = "machine.openshift.io/cluster-api-machine-role"
@@ -67,6 +69,7 @@ var (
 	// NvidiaGPUConfig provides access to general configuration parameters.
 	nvidiaGPUConfig *nvidiagpuconfig.NvidiaGPUConfig
 	nfdConfig       *internalNFD.NFDConfig
+	imageCatalog    *imagecatalog.Catalog
 
 	ScaleCluster  = false
 	CatalogSource = UndefinedValue
@@ -86,6 +89,7 @@ var (
 	CurrentCSV                 = ""
 	CurrentCSVVersion          = ""
 	clusterArchitecture        = UndefinedValue
+	cudaSamplesNamespace       = "test-cuda-samples"
 )
 
 var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
@@ -93,15 +97,37 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 	var (
 		deployBundle       deploy.Deploy
 		deployBundleConfig deploy.BundleConfig
+		nfdConfigErr       error
+		imageCatalogErr    error
 	)
 
 	nvidiaGPUConfig = nvidiagpuconfig.NewNvidiaGPUConfig()
 
-	nfdConfig, _ = internalNFD.NewNFDConfig()
+	nfdConfig, nfdConfigErr = internalNFD.NewNFDConfig()
+	imageCatalog, imageCatalogErr = imagecatalog.NewCatalog()
 
 	Context("DeployGpu", Label("deploy-gpu-with-dtk"), func() {
 
 		BeforeAll(func() {
+			Expect(nvidiaGPUConfig).ToNot(BeNil(), "error loading NvidiaGPUConfig from profile file "+
+				"and NVIDIAGPU_* env variables")
+			Expect(nfdConfigErr).ToNot(HaveOccurred(), "error loading NFDConfig:  %v", nfdConfigErr)
+			Expect(imageCatalogErr).ToNot(HaveOccurred(), "error loading the image catalog:  %v", imageCatalogErr)
+
+			if err := inittools.GeneralConfig.WriteEffectiveConfig(nvidiagpuconfig.ProfileSection,
+				nvidiaGPUConfig); err != nil {
+				glog.Error("Error writing the effective NvidiaGPUConfig: ", err)
+			}
+
+			if err := inittools.GeneralConfig.WriteEffectiveConfig(internalNFD.ProfileSection, nfdConfig); err != nil {
+				glog.Error("Error writing the effective NFDConfig: ", err)
+			}
+
+			if err := inittools.GeneralConfig.WriteEffectiveConfig(imagecatalog.ProfileSection,
+				imageCatalog); err != nil {
+				glog.Error("Error writing the effective image catalog: ", err)
+			}
+
 			if nvidiaGPUConfig.InstanceType == "" {
 				glog.V(gpuparams.GpuLogLevel).Infof("env variable NVIDIAGPU_GPU_MACHINESET_INSTANCE_TYPE" +
 					" is not set, skipping scaling cluster")
@@ -153,16 +179,9 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				deployFromBundle = nvidiaGPUConfig.DeployFromBundle
 				glog.V(gpuparams.GpuLogLevel).Infof("Flag deploy GPU operator from bundle is set to env "+
 					"variable NVIDIAGPU_DEPLOY_FROM_BUNDLE value '%v'", deployFromBundle)
-				if nvidiaGPUConfig.BundleImage == "" {
-					glog.V(gpuparams.GpuLogLevel).Infof("env variable NVIDIAGPU_BUNDLE_IMAGE"+
-						" is not set, will use the default bundle image '%s'",
-						nvidiagpu.OperatorDefaultMasterBundleImage)
-					operatorBundleImage = nvidiagpu.OperatorDefaultMasterBundleImage
-				} else {
-					operatorBundleImage = nvidiaGPUConfig.BundleImage
-					glog.V(gpuparams.GpuLogLevel).Infof("env variable NVIDIAGPU_BUNDLE_IMAGE"+
-						" is set, will use the specified bundle image '%s'", operatorBundleImage)
-				}
+				operatorBundleImage = nvidiaGPUConfig.BundleImage
+				glog.V(gpuparams.GpuLogLevel).Infof("env variable NVIDIAGPU_BUNDLE_IMAGE"+
+					" is set, will use the specified bundle image '%s'", operatorBundleImage)
 			} else {
 				glog.V(gpuparams.GpuLogLevel).Infof("env variable NVIDIAGPU_DEPLOY_FROM_BUNDLE" +
 					" is set to false or is not set, will deploy GPU Operator from catalogsource")
@@ -233,7 +252,9 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				glog.Error("Error writing an OpenShift version file: ", err)
 			}
 
-			nfd.EnsureNFDIsInstalled(inittools.APIClient, nfdInstance, ocpVersion, gpuparams.GpuLogLevel)
+			runSummary.SetOpenShiftVersion(ocpVersion)
+
+			nfd.EnsureNFDIsInstalled(inittools.APIClient, nfdInstance, ocpVersion)
 
 		})
 
@@ -253,9 +274,10 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 			}
 		})
 
-		It("Deploy NVIDIA GPU Operator with DTK", Label("nvidia-ci:gpu"), func() {
+		It("Deploy NVIDIA GPU Operator with DTK", Label("nvidia-ci:gpu"), func(ctx SpecContext) {
 
-			nfdcheck.CheckNfdInstallation(inittools.APIClient, nfd.OSLabel, nfd.GetAllowedOSLabels(), inittools.GeneralConfig.WorkerLabelMap, networkparams.LogLevel)
+			nfdcheck.CheckNfdInstallation(inittools.APIClient, nfd.OSLabel, nfd.GetAllowedOSLabels(),
+				inittools.GeneralConfig.WorkerLabelMap)
 
 			By("Check if at least one worker node is GPU enabled")
 			gpuNodeFound, _ := check.NodeWithLabel(inittools.APIClient, nvidiagpu.NvidiaGPULabel, inittools.GeneralConfig.WorkerLabelMap)
@@ -290,12 +312,12 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 					"Creating MachineSet named: %s", gpuMsBuilder.Definition.Name)
 
 				By("Create the new GPU enabled MachineSet")
-				createdMsBuilder, err := gpuMsBuilder.Create()
+				createdMsBuilder, err := gpuMsBuilder.CreateWithContext(ctx)
 
 				Expect(err).ToNot(HaveOccurred(), "error creating a GPU enabled machineset: %v",
 					err)
 
-				pulledMachineSetBuilder, err := machine.PullSet(inittools.APIClient,
+				pulledMachineSetBuilder, err := machine.PullSetWithContext(ctx, inittools.APIClient,
 					createdMsBuilder.Definition.ObjectMeta.Name,
 					machineSetNamespace)
 
@@ -309,7 +331,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				glog.V(gpuparams.GpuLogLevel).Infof("Just before waiting for GPU enabled machineset %s "+
 					"to be in Ready state", createdMsBuilder.Definition.ObjectMeta.Name)
 
-				err = machine.WaitForMachineSetReady(inittools.APIClient, createdMsBuilder.Definition.ObjectMeta.Name,
+				err = machine.WaitForMachineSetReadyWithContext(ctx, inittools.APIClient, createdMsBuilder.Definition.ObjectMeta.Name,
 					machineSetNamespace, nvidiagpu.MachineReadyWaitDuration)
 
 				Expect(err).ToNot(HaveOccurred(), "Failed to detect at least one replica"+
@@ -327,8 +349,11 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 
 			// Here we don't need this step is we already have a GPU worker node on cluster
 			if ScaleCluster {
-				glog.V(gpuparams.GpuLogLevel).Infof("Sleeping for %s to allow the newly created GPU worker node to be labeled by NFD", nvidiagpu.NodeLabelingDelay.String())
-				time.Sleep(nvidiagpu.NodeLabelingDelay)
+				By(fmt.Sprintf("Wait up to %s for the newly created GPU worker node to be labeled by NFD",
+					nvidiagpu.NodeLabelingTimeout))
+				err := wait.WatchNodesLabeled(ctx, inittools.APIClient, WorkerNodeSelector,
+					nvidiagpu.NodeLabelingTimeout)
+				Expect(err).ToNot(HaveOccurred(), "error waiting for GPU worker node to be labeled: %v", err)
 			}
 
 			By("Get Cluster Architecture from first GPU enabled worker node")
@@ -340,6 +365,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 			clusterArchitecture = clusterArch
 			glog.V(gpuparams.GpuLogLevel).Infof("cluster architecture for GPU enabled worker node is: %s",
 				clusterArchitecture)
+			runSummary.SetClusterArchitecture(clusterArchitecture)
 
 			By("Check if GPU Operator Deployment is from Bundle")
 			if deployFromBundle {
@@ -355,7 +381,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				glog.V(gpuparams.GpuLogLevel).Infof("Using default GPU catalogsource '%s'",
 					nvidiagpu.CatalogSourceDefault)
 
-				gpuPkgManifestBuilderByCatalog, err := olm.PullPackageManifestByCatalog(inittools.APIClient,
+				gpuPkgManifestBuilderByCatalog, err := olm.PullPackageManifestByCatalogWithContext(ctx, inittools.APIClient,
 					nvidiagpu.Package, nvidiagpu.CatalogSourceNamespace, nvidiagpu.CatalogSourceDefault)
 
 				if err != nil {
@@ -381,25 +407,25 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 						Expect(gpuCustomCatalogSourceBuilder).NotTo(BeNil(), "Failed to Initialize "+
 							"CatalogSourceBuilder for custom GPU catalogsource '%s'", CustomCatalogSource)
 
-						createdGPUCustomCatalogSourceBuilder, err := gpuCustomCatalogSourceBuilder.Create()
+						createdGPUCustomCatalogSourceBuilder, err := gpuCustomCatalogSourceBuilder.CreateWithContext(ctx)
 						glog.V(gpuparams.GpuLogLevel).Infof("Creating custom GPU Catalogsource builder object "+
 							"'%s'", createdGPUCustomCatalogSourceBuilder.Definition.Name)
 						Expect(err).ToNot(HaveOccurred(), "error creating custom GPU catalogsource "+
 							"builder Object name %s:  %v", CustomCatalogSource, err)
 
-						By(fmt.Sprintf("Sleep for %s to allow the GPU custom catalogsource to be created", nvidiagpu.CatalogSourceCreationDelay))
-						time.Sleep(nvidiagpu.CatalogSourceCreationDelay)
-
-						glog.V(gpuparams.GpuLogLevel).Infof("Wait up to %s for custom GPU catalogsource to be ready", nvidiagpu.CatalogSourceReadyTimeout)
-
-						Expect(createdGPUCustomCatalogSourceBuilder.IsReady(nvidiagpu.CatalogSourceReadyTimeout)).NotTo(BeFalse())
+						By(fmt.Sprintf("Wait up to %s for custom GPU catalogsource to be ready",
+							nvidiagpu.CatalogSourceReadyTimeout))
+						err = wait.WatchCatalogSourceReady(ctx, inittools.APIClient, CustomCatalogSource,
+							nvidiagpu.CatalogSourceNamespace, nvidiagpu.CatalogSourceReadyTimeout)
+						Expect(err).ToNot(HaveOccurred(), "error waiting for custom GPU catalogsource "+
+							"'%s' to be ready: %v", CustomCatalogSource, err)
 
 						CatalogSource = createdGPUCustomCatalogSourceBuilder.Definition.Name
 
 						glog.V(gpuparams.GpuLogLevel).Infof("Custom GPU catalogsource '%s' is now ready",
 							createdGPUCustomCatalogSourceBuilder.Definition.Name)
 
-						gpuPkgManifestBuilderByCustomCatalog, err := olm.PullPackageManifestByCatalogWithTimeout(inittools.APIClient,
+						gpuPkgManifestBuilderByCustomCatalog, err := olm.PullPackageManifestByCatalogWithTimeoutWithContext(ctx, inittools.APIClient,
 							nvidiagpu.Package, nvidiagpu.CatalogSourceNamespace, CustomCatalogSource,
 							nvidiagpu.PackageManifestCheckInterval, nvidiagpu.PackageManifestTimeout)
 
@@ -432,12 +458,12 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 
 			By("Check if NVIDIA GPU Operator namespace exists, otherwise created it and label it")
 			nsBuilder := namespace.NewBuilder(inittools.APIClient, nvidiagpu.NvidiaGPUNamespace)
-			if nsBuilder.Exists() {
+			if nsBuilder.ExistsWithContext(ctx) {
 				glog.V(gpuparams.GpuLogLevel).Infof("The namespace '%s' already exists",
 					nsBuilder.Object.Name)
 			} else {
 				glog.V(gpuparams.GpuLogLevel).Infof("Creating the namespace:  %v", nvidiagpu.NvidiaGPUNamespace)
-				createdNsBuilder, err := nsBuilder.Create()
+				createdNsBuilder, err := nsBuilder.CreateWithContext(ctx)
 				Expect(err).ToNot(HaveOccurred(), "error creating namespace '%s' :  %v ",
 					nsBuilder.Definition.Name, err)
 
@@ -452,7 +478,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 					"pod-security.kubernetes.io/enforce": "privileged",
 				})
 
-				newLabeledNsBuilder, err := labeledNsBuilder.Update()
+				newLabeledNsBuilder, err := labeledNsBuilder.UpdateWithContext(ctx)
 				Expect(err).ToNot(HaveOccurred(), "error labeling namespace %v :  %v ",
 					newLabeledNsBuilder.Definition.Name, err)
 
@@ -483,14 +509,14 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 			} else {
 				By("Create OperatorGroup in NVIDIA GPU Operator Namespace")
 				ogBuilder := olm.NewOperatorGroupBuilder(inittools.APIClient, nvidiagpu.OperatorGroupName, nvidiagpu.NvidiaGPUNamespace)
-				if ogBuilder.Exists() {
+				if ogBuilder.ExistsWithContext(ctx) {
 					glog.V(gpuparams.GpuLogLevel).Infof("The ogBuilder that exists has name:  %v",
 						ogBuilder.Object.Name)
 				} else {
 					glog.V(gpuparams.GpuLogLevel).Infof("Create a new operatorgroup with name:  %v",
 						ogBuilder.Object.Name)
 
-					ogBuilderCreated, err := ogBuilder.Create()
+					ogBuilderCreated, err := ogBuilder.CreateWithContext(ctx)
 					Expect(err).ToNot(HaveOccurred(), "error creating operatorgroup %v :  %v ",
 						ogBuilderCreated.Definition.Name, err)
 				}
@@ -519,7 +545,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				subBuilder.WithInstallPlanApproval(InstallPlanApproval)
 
 				glog.V(gpuparams.GpuLogLevel).Infof("Creating the subscription, i.e Deploy the GPU operator")
-				createdSub, err := subBuilder.Create()
+				createdSub, err := subBuilder.CreateWithContext(ctx)
 
 				Expect(err).ToNot(HaveOccurred(), "error creating subscription %v :  %v ",
 					createdSub.Definition.Name, err)
@@ -527,7 +553,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				glog.V(gpuparams.GpuLogLevel).Infof("Newly created subscription: %s was successfully created",
 					createdSub.Object.Name)
 
-				if createdSub.Exists() {
+				if createdSub.ExistsWithContext(ctx) {
 					glog.V(gpuparams.GpuLogLevel).Infof("The newly created subscription '%s' in namespace '%v' "+
 						"has current CSV  '%v'", createdSub.Object.Name, createdSub.Object.Namespace,
 						createdSub.Object.Status.CurrentCSV)
@@ -542,22 +568,14 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 
 			}
 
-			By(fmt.Sprintf("Sleep for %s to allow the GPU Operator deployment to be created", nvidiagpu.OperatorDeploymentCreationDelay))
-			glog.V(gpuparams.GpuLogLevel).Infof("Sleep for %s to allow the GPU Operator deployment to be created", nvidiagpu.OperatorDeploymentCreationDelay)
-			time.Sleep(nvidiagpu.OperatorDeploymentCreationDelay)
-
 			By(fmt.Sprintf("Wait for up to %s for GPU Operator deployment to be created", nvidiagpu.DeploymentCreationTimeout))
-			gpuDeploymentCreated := wait.DeploymentCreated(
-				inittools.APIClient,
-				nvidiagpu.OperatorDeployment,
-				nvidiagpu.NvidiaGPUNamespace,
-				nvidiagpu.DeploymentCreationCheckInterval,
-				nvidiagpu.DeploymentCreationTimeout)
+			err = wait.WatchDeploymentCreated(ctx, inittools.APIClient, nvidiagpu.OperatorDeployment,
+				nvidiagpu.NvidiaGPUNamespace, nvidiagpu.DeploymentCreationTimeout)
 
-			Expect(gpuDeploymentCreated).ToNot(BeFalse(), "timed out waiting to deploy GPU operator")
+			Expect(err).ToNot(HaveOccurred(), "timed out waiting to deploy GPU operator: %v", err)
 
 			By("Check if the GPU operator deployment is ready")
-			gpuOperatorDeployment, err := deployment.Pull(inittools.APIClient, nvidiagpu.OperatorDeployment, nvidiagpu.NvidiaGPUNamespace)
+			gpuOperatorDeployment, err := deployment.PullWithContext(ctx, inittools.APIClient, nvidiagpu.OperatorDeployment, nvidiagpu.NvidiaGPUNamespace)
 
 			Expect(err).ToNot(HaveOccurred(), "Error trying to pull GPU operator "+
 				"deployment is: %v", err)
@@ -565,13 +583,13 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 			glog.V(gpuparams.GpuLogLevel).Infof("Pulled GPU operator deployment is:  %v ",
 				gpuOperatorDeployment.Definition.Name)
 
-			if gpuOperatorDeployment.IsReady(nvidiagpu.OperatorDeploymentReadyTimeout) {
+			if gpuOperatorDeployment.IsReadyWithContext(ctx, nvidiagpu.OperatorDeploymentReadyTimeout) {
 				glog.V(gpuparams.GpuLogLevel).Infof("Pulled GPU operator deployment '%s' is Ready",
 					gpuOperatorDeployment.Definition.Name)
 			}
 
 			By("Get the CSV deployed in NVIDIA GPU Operator namespace")
-			csvBuilderList, err := olm.ListClusterServiceVersion(inittools.APIClient, nvidiagpu.NvidiaGPUNamespace)
+			csvBuilderList, err := olm.ListClusterServiceVersionWithContext(ctx, inittools.APIClient, nvidiagpu.NvidiaGPUNamespace)
 
 			Expect(err).ToNot(HaveOccurred(), "Error getting list of CSVs in GPU operator "+
 				"namespace: '%v'", err)
@@ -596,10 +614,14 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				glog.Error("Error writing an operator version file: ", err)
 			}
 
+			if err := runSummary.CollectOperator(ctx, inittools.APIClient, nvidiagpu.NvidiaGPUNamespace); err != nil {
+				glog.Error("Error collecting the operator for the run summary: ", err)
+			}
+
 			By("Wait for deployed ClusterServiceVersion to be in Succeeded phase")
 			glog.V(gpuparams.GpuLogLevel).Infof("Waiting for ClusterServiceVersion '%s' to be in Succeeded phase",
 				CurrentCSV)
-			err = wait.CSVSucceeded(inittools.APIClient, CurrentCSV, nvidiagpu.NvidiaGPUNamespace,
+			err = wait.CSVSucceededWithContext(ctx, inittools.APIClient, CurrentCSV, nvidiagpu.NvidiaGPUNamespace,
 				nvidiagpu.CsvSucceededCheckInterval, nvidiagpu.CsvSucceededTimeout)
 			glog.V(gpuparams.GpuLogLevel).Info("error waiting for ClusterServiceVersion '%s' to be "+
 				"in Succeeded phase:  %v ", CurrentCSV, err)
@@ -607,7 +629,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				"in Succeeded phase: ", err)
 
 			By("Pull existing CSV in NVIDIA GPU Operator Namespace")
-			clusterCSV, err := olm.PullClusterServiceVersion(inittools.APIClient, CurrentCSV, nvidiagpu.NvidiaGPUNamespace)
+			clusterCSV, err := olm.PullClusterServiceVersionWithContext(ctx, inittools.APIClient, CurrentCSV, nvidiagpu.NvidiaGPUNamespace)
 			Expect(err).ToNot(HaveOccurred(), "error pulling CSV from cluster:  %v", err)
 
 			glog.V(gpuparams.GpuLogLevel).Infof("clusterCSV from cluster lastUpdatedTime is : %v ",
@@ -628,7 +650,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 			}()
 
 			By("Get ALM examples block form CSV")
-			almExamples, err := clusterCSV.GetAlmExamples()
+			almExamples, err := clusterCSV.GetAlmExamplesWithContext(ctx)
 			Expect(err).ToNot(HaveOccurred(), "Error from pulling almExamples from csv "+
 				"from cluster:  %v ", err)
 			glog.V(gpuparams.GpuLogLevel).Infof("almExamples block from clusterCSV  is : %v ", almExamples)
@@ -644,7 +666,13 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				clusterPolicyBuilder = nvidiagpu.NewBuilderFromObjectStringAndPatch(inittools.APIClient, almExamples, nvidiaGPUConfig.ClusterPolicyPatch)
 			}
 
-			createdClusterPolicyBuilder, err := clusterPolicyBuilder.Create()
+			if nvidiaGPUConfig.UseNvidiaDriverCRD {
+				glog.V(gpuparams.GpuLogLevel).Infof("Enabling driver.useNvidiaDriverCRD in the ClusterPolicy, " +
+					"the driver will be managed by an NVIDIADriver")
+				clusterPolicyBuilder = clusterPolicyBuilder.WithNvidiaDriverCRD(true)
+			}
+
+			createdClusterPolicyBuilder, err := clusterPolicyBuilder.CreateWithContext(ctx)
 			Expect(err).ToNot(HaveOccurred(), "Error Creating ClusterPolicy from csv "+
 				"almExamples  %v ", err)
 			glog.V(gpuparams.GpuLogLevel).Infof("ClusterPolicy '%s' is successfully created",
@@ -658,7 +686,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 			}()
 
 			By("Pull the ClusterPolicy just created from cluster, with updated fields")
-			pulledClusterPolicy, err := nvidiagpu.Pull(inittools.APIClient, nvidiagpu.ClusterPolicyName)
+			pulledClusterPolicy, err := nvidiagpu.PullWithContext(ctx, inittools.APIClient, nvidiagpu.ClusterPolicyName)
 			Expect(err).ToNot(HaveOccurred(), "error pulling ClusterPolicy %s from cluster: "+
 				" %v ", nvidiagpu.ClusterPolicyName, err)
 
@@ -674,9 +702,45 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 					err)
 			}
 
+			if nvidiaGPUConfig.UseNvidiaDriverCRD {
+				By("Deploy NVIDIADriver")
+				nvidiaDriverBuilder := nvidiagpu.NewNVIDIADriverBuilderFromObjectString(inittools.APIClient,
+					almExamples)
+
+				if nvidiaGPUConfig.NvidiaDriverVersion != "" {
+					nvidiaDriverBuilder = nvidiaDriverBuilder.WithVersion(nvidiaGPUConfig.NvidiaDriverVersion)
+				}
+
+				if nvidiaGPUConfig.NvidiaDriverRepository != "" {
+					nvidiaDriverBuilder = nvidiaDriverBuilder.WithRepository(nvidiaGPUConfig.NvidiaDriverRepository)
+				}
+
+				if nvidiaGPUConfig.NvidiaDriverPrecompiled {
+					nvidiaDriverBuilder = nvidiaDriverBuilder.WithPrecompiled(true)
+				}
+
+				createdNVIDIADriverBuilder, err := nvidiaDriverBuilder.CreateWithContext(ctx)
+				Expect(err).ToNot(HaveOccurred(), "Error Creating NVIDIADriver from csv almExamples  %v ", err)
+				glog.V(gpuparams.GpuLogLevel).Infof("NVIDIADriver '%s' is successfully created",
+					createdNVIDIADriverBuilder.Definition.Name)
+
+				defer func() {
+					if cleanupAfterTest {
+						_, err := createdNVIDIADriverBuilder.Delete()
+						Expect(err).ToNot(HaveOccurred())
+					}
+				}()
+
+				By(fmt.Sprintf("Wait up to %s for NVIDIADriver to be ready", nvidiagpu.NVIDIADriverReadyTimeout))
+				err = wait.NVIDIADriverReadyWithContext(ctx, inittools.APIClient,
+					createdNVIDIADriverBuilder.Definition.Name, nvidiagpu.ClusterPolicyReadyCheckInterval,
+					nvidiagpu.NVIDIADriverReadyTimeout)
+				Expect(err).ToNot(HaveOccurred(), "error waiting for NVIDIADriver to be Ready:  %v ", err)
+			}
+
 			By(fmt.Sprintf("Wait up to %s for ClusterPolicy to be ready", nvidiagpu.ClusterPolicyReadyTimeout))
 			glog.V(gpuparams.GpuLogLevel).Infof("Waiting up to %s for ClusterPolicy to be ready", nvidiagpu.ClusterPolicyReadyTimeout)
-			err = wait.ClusterPolicyReady(inittools.APIClient, nvidiagpu.ClusterPolicyName,
+			err = wait.ClusterPolicyReadyWithContext(ctx, inittools.APIClient, nvidiagpu.ClusterPolicyName,
 				nvidiagpu.ClusterPolicyReadyCheckInterval, nvidiagpu.ClusterPolicyReadyTimeout)
 
 			glog.V(gpuparams.GpuLogLevel).Infof("error waiting for ClusterPolicy to be Ready:  %v ", err)
@@ -684,10 +748,54 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				err)
 
 			By("Pull the ready ClusterPolicy from cluster, with updated fields")
-			pulledReadyClusterPolicy, err := nvidiagpu.Pull(inittools.APIClient, nvidiagpu.ClusterPolicyName)
+			pulledReadyClusterPolicy, err := nvidiagpu.PullWithContext(ctx, inittools.APIClient, nvidiagpu.ClusterPolicyName)
 			Expect(err).ToNot(HaveOccurred(), "error pulling ClusterPolicy %s from cluster: "+
 				" %v ", nvidiagpu.ClusterPolicyName, err)
 
+			if err := runSummary.SetClusterPolicySpec(pulledReadyClusterPolicy.Object.Spec); err != nil {
+				glog.Error("Error hashing the ClusterPolicy spec for the run summary: ", err)
+			}
+
+			if err := runSummary.CollectNodes(ctx, inittools.APIClient, WorkerNodeSelector); err != nil {
+				glog.Error("Error collecting the GPU nodes for the run summary: ", err)
+			}
+
+			By("Check the health of every operand enabled in the ClusterPolicy")
+			healthReport, err := pulledReadyClusterPolicy.HealthReportWithContext(ctx)
+			Expect(err).ToNot(HaveOccurred(), "error building ClusterPolicy health report: %v", err)
+			glog.V(gpuparams.GpuLogLevel).Infof("ClusterPolicy health report: %s", healthReport)
+			Expect(healthReport.Problems()).To(BeEmpty(), "ClusterPolicy operands are not healthy")
+
+			if pulledReadyClusterPolicy.Object.Spec.GPUFeatureDiscovery.IsEnabled() {
+				By("Check the GPU feature discovery labels of every GPU node against nvidia-smi")
+				gfdReport, err := nvidiagpu.CollectGFDLabelReportWithContext(ctx, inittools.APIClient,
+					healthReport.Namespace, WorkerNodeSelector)
+				Expect(err).ToNot(HaveOccurred(), "error building GPU feature discovery label report: %v", err)
+				glog.V(gpuparams.GpuLogLevel).Infof("GPU feature discovery label report: %s", gfdReport)
+				Expect(gfdReport.Problems()).To(BeEmpty(), "GPU feature discovery labels are not consistent")
+			}
+
+			if pulledReadyClusterPolicy.Object.Spec.DCGMExporter.IsEnabled() {
+				gpuNodes, err := nodes.ListWithContext(ctx, inittools.APIClient,
+					metav1.ListOptions{LabelSelector: labels.Set(WorkerNodeSelector).String()})
+				Expect(err).ToNot(HaveOccurred(), "error listing GPU nodes: %v", err)
+				Expect(gpuNodes).ToNot(BeEmpty(), "no GPU node found to check the DCGM exporter metrics of")
+
+				for _, gpuNode := range gpuNodes {
+					gpuCount, err := strconv.Atoi(gpuNode.Object.Labels[nvidiagpu.GPUCountLabel])
+					Expect(err).ToNot(HaveOccurred(), "node '%s' has no valid '%s' label: %v", gpuNode.Object.Name,
+						nvidiagpu.GPUCountLabel, err)
+
+					By(fmt.Sprintf("Wait for up to %s for the DCGM exporter to report the core metrics of the %d "+
+						"GPUs of node '%s'", nvidiagpu.DCGMMetricsTimeout, gpuCount, gpuNode.Object.Name))
+					err = wait.DCGMNodeMetricsValidWithContext(ctx, inittools.APIClient, healthReport.Namespace,
+						gpuNode.Object.Name, gpuCount, dcgm.CoreMetrics, nvidiagpu.DCGMMetricsCheckInterval,
+						nvidiagpu.DCGMMetricsTimeout)
+					Expect(err).ToNot(HaveOccurred(), "error validating the DCGM exporter metrics of node "+
+						"'%s': %v", gpuNode.Object.Name, err)
+				}
+			}
+
 			cpReadyJSON, err := json.MarshalIndent(pulledReadyClusterPolicy, "", " ")
 
 			if err == nil {
@@ -702,13 +810,13 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 
 			By("Create GPU Burn namespace 'test-gpu-burn'")
 			gpuBurnNsBuilder := namespace.NewBuilder(inittools.APIClient, burn.Namespace)
-			if gpuBurnNsBuilder.Exists() {
+			if gpuBurnNsBuilder.ExistsWithContext(ctx) {
 				glog.V(gpuparams.GpuLogLevel).Infof("The namespace '%s' already exists",
 					gpuBurnNsBuilder.Object.Name)
 			} else {
 				glog.V(gpuparams.GpuLogLevel).Infof("Creating the gpu burn namespace '%s'",
 					burn.Namespace)
-				createdGPUBurnNsBuilder, err := gpuBurnNsBuilder.Create()
+				createdGPUBurnNsBuilder, err := gpuBurnNsBuilder.CreateWithContext(ctx)
 				Expect(err).ToNot(HaveOccurred(), "error creating gpu burn "+
 					"namespace '%s' :  %v ", burn.Namespace, err)
 
@@ -723,7 +831,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 					"pod-security.kubernetes.io/enforce": "privileged",
 				})
 
-				newGPUBurnLabeledNsBuilder, err := labeledGPUBurnNsBuilder.Update()
+				newGPUBurnLabeledNsBuilder, err := labeledGPUBurnNsBuilder.UpdateWithContext(ctx)
 				Expect(err).ToNot(HaveOccurred(), "error labeling namespace %v :  %v ",
 					newGPUBurnLabeledNsBuilder.Definition.Name, err)
 
@@ -738,99 +846,204 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				}
 			}()
 
-			By("Deploy GPU Burn configmap in test-gpu-burn namespace")
-			gpuBurnConfigMap, err := gpuburn.CreateGPUBurnConfigMap(inittools.APIClient, burn.ConfigMapName,
-				burn.Namespace)
-			Expect(err).ToNot(HaveOccurred(), "Error Creating gpu burn configmap: %v", err)
+			By(fmt.Sprintf("Build the '%s' GPU workload", nvidiaGPUConfig.Workload))
+			gpuWorkload, err := workload.New(nvidiaGPUConfig.Workload, workload.Params{
+				Config:       nvidiaGPUConfig,
+				Architecture: clusterArchitecture,
+				Images:       imageCatalog,
+				NodeSelector: WorkerNodeSelector,
+			})
+			Expect(err).ToNot(HaveOccurred(), "error building the '%s' GPU workload: %v",
+				nvidiaGPUConfig.Workload, err)
+
+			// The first pod keeps the name the operator upgrade test pulls it by.
+			workloadTargets := []workload.Target{{Name: burn.Namespace}}
+
+			if nvidiaGPUConfig.BurnAllNodes {
+				By(fmt.Sprintf("List the GPU nodes to run one '%s' pod on each", gpuWorkload.Name()))
+				gpuNodes, err := nodes.ListWithContext(ctx, inittools.APIClient,
+					metav1.ListOptions{LabelSelector: labels.Set(WorkerNodeSelector).String()})
+				Expect(err).ToNot(HaveOccurred(), "error listing GPU nodes: %v", err)
+				Expect(gpuNodes).ToNot(BeEmpty(), "no GPU node found to run '%s' on", gpuWorkload.Name())
+
+				workloadTargets = nil
+
+				for nodeIndex, gpuNode := range gpuNodes {
+					gpus := nvidiaGPUConfig.BurnGPUsPerPod
+					if gpus == 0 {
+						allocatable := gpuNode.Object.Status.Allocatable[nvidiagpu.GPUResourceName]
+						gpus = int(allocatable.Value())
+					}
+
+					targetName := burn.Namespace
+					if nodeIndex > 0 {
+						targetName = fmt.Sprintf("%s-%d", burn.Namespace, nodeIndex)
+					}
 
-			glog.V(gpuparams.GpuLogLevel).Infof("The created gpuBurnConfigMap has name: %s",
-				gpuBurnConfigMap.Name)
+					glog.V(gpuparams.GpuLogLevel).Infof("'%s' pod '%s' will request %d GPUs on node '%s'",
+						gpuWorkload.Name(), targetName, gpus, gpuNode.Object.Name)
+					workloadTargets = append(workloadTargets, workload.Target{
+						Name:     targetName,
+						NodeName: gpuNode.Object.Name,
+						GPUs:     gpus,
+					})
+				}
+			}
 
-			configmapBuilder, err := configmap.Pull(inittools.APIClient, burn.ConfigMapName, burn.Namespace)
-			Expect(err).ToNot(HaveOccurred(), "Error pulling gpu-burn configmap '%s' from "+
-				"namespace '%s': %v", burn.ConfigMapName, burn.Namespace, err)
+			workloadSuccessTimeout := max(nvidiagpu.BurnPodSuccessTimeout,
+				nvidiagpu.BurnPodSuccessTimeout+gpuWorkload.Duration()-gpuburn.DefaultSeconds*time.Second)
 
-			glog.V(gpuparams.GpuLogLevel).Infof("The pulled gpuBurnConfigMap has name: %s",
-				configmapBuilder.Definition.Name)
+			By(fmt.Sprintf("Deploy %d '%s' pods and their ConfigMaps in test-gpu-burn namespace",
+				len(workloadTargets), gpuWorkload.Name()))
+			workloadRun, err := workload.DeployWithContext(ctx, inittools.APIClient, gpuWorkload, burn.Namespace,
+				workloadTargets)
 
+			By("Cleanup the workload pods only if cleanupAfterTest is true and, for the first pod, " +
+				"OperatorUpgradeToChannel is undefined")
 			defer func() {
-				if cleanupAfterTest {
-					err := configmapBuilder.Delete()
+				if !cleanupAfterTest {
+					return
+				}
+
+				for podIndex, workloadPod := range workloadRun.Pods {
+					if podIndex > 0 || OperatorUpgradeToChannel == UndefinedValue {
+						_, err := workloadPod.Delete()
+						Expect(err).ToNot(HaveOccurred())
+					}
+				}
+
+				for _, workloadConfigMap := range workloadRun.ConfigMaps {
+					err := workloadConfigMap.Delete()
 					Expect(err).ToNot(HaveOccurred())
 				}
 			}()
 
-			By("Deploy gpu-burn pod in test-gpu-burn namespace")
-			glog.V(gpuparams.GpuLogLevel).Infof("gpu-burn pod image name is: '%s', in namespace '%s'",
-				BurnImageName[clusterArchitecture], burn.Namespace)
+			Expect(err).ToNot(HaveOccurred(), "error deploying the '%s' workload: %v", gpuWorkload.Name(), err)
+
+			for _, workloadPod := range workloadRun.Pods {
+				workloadPodName := workloadPod.Definition.Name
+
+				By(fmt.Sprintf("Wait for up to %s for '%s' pod '%s' to be in Running phase",
+					nvidiagpu.BurnPodRunningTimeout, gpuWorkload.Name(), workloadPodName))
+				err = workloadPod.WaitUntilInStatusWithContext(ctx, corev1.PodRunning, nvidiagpu.BurnPodRunningTimeout)
+				Expect(err).ToNot(HaveOccurred(), "timeout waiting for '%s' pod '%s' in "+
+					"namespace '%s' to go to Running phase:  %v ", gpuWorkload.Name(), workloadPodName,
+					burn.Namespace, err)
+
+				// The node of the pods of the targets not pinned to a node is only known once they are scheduled.
+				workloadPod, err = pod.PullWithContext(ctx, inittools.APIClient, workloadPodName, burn.Namespace)
+				Expect(err).ToNot(HaveOccurred(), "error pulling '%s' pod '%s' in namespace '%s': %v",
+					gpuWorkload.Name(), workloadPodName, burn.Namespace, err)
+				glog.V(gpuparams.GpuLogLevel).Infof("'%s' pod '%s' now in Running phase on node '%s'",
+					gpuWorkload.Name(), workloadPodName, workloadPod.Object.Spec.NodeName)
+
+				if pulledReadyClusterPolicy.Object.Spec.DCGMExporter.IsEnabled() {
+					By(fmt.Sprintf("Wait for up to %s for the DCGM exporter to report the '%s' pod '%s' GPUs "+
+						"as busy", nvidiagpu.DCGMMetricsTimeout, gpuWorkload.Name(), workloadPodName))
+					workloadLabels := map[string]string{
+						dcgm.PodLabel:       workloadPodName,
+						dcgm.NamespaceLabel: burn.Namespace,
+					}
+					err = wait.DCGMMetricsValidWithContext(ctx, inittools.APIClient, healthReport.Namespace,
+						workloadPod.Object.Spec.NodeName, workloadLabels, dcgm.BusyMetrics,
+						nvidiagpu.DCGMMetricsCheckInterval, nvidiagpu.DCGMMetricsTimeout)
+					Expect(err).ToNot(HaveOccurred(), "error validating the DCGM exporter metrics of the "+
+						"'%s' pod '%s' GPUs: %v", gpuWorkload.Name(), workloadPodName, err)
+				}
+			}
 
-			gpuBurnPod, err := gpuburn.CreateGPUBurnPod(inittools.APIClient, burn.Namespace, burn.Namespace,
-				BurnImageName[(clusterArchitecture)], nvidiagpu.BurnPodCreationTimeout)
-			Expect(err).ToNot(HaveOccurred(), "Error creating gpu burn pod: %v", err)
+			By(fmt.Sprintf("Wait for up to %s for every '%s' pod to run to completion and be in "+
+				"Succeeded phase/Completed status", workloadSuccessTimeout, gpuWorkload.Name()))
+			err = workloadRun.WaitForPhaseWithContext(ctx, corev1.PodSucceeded, workloadSuccessTimeout)
+			Expect(err).ToNot(HaveOccurred(), "error waiting for the '%s' pods to complete: %v",
+				gpuWorkload.Name(), err)
 
-			glog.V(gpuparams.GpuLogLevel).Infof("Creating gpu-burn pod '%s' in namespace '%s'",
-				burn.Namespace, burn.Namespace)
+			By(fmt.Sprintf("Collect and validate the output of every '%s' pod", gpuWorkload.Name()))
+			workloadResults, err := workloadRun.ValidateWithContext(ctx)
+			Expect(err).ToNot(HaveOccurred(), "error validating the '%s' pods output: %v", gpuWorkload.Name(), err)
 
-			_, err = inittools.APIClient.Pods(gpuBurnPod.Namespace).Create(context.TODO(), gpuBurnPod,
-				metav1.CreateOptions{})
-			Expect(err).ToNot(HaveOccurred(), "Error creating gpu-burn '%s' in "+
-				"namespace '%s': %v", burn.Namespace, burn.Namespace, err)
+			for _, workloadResult := range workloadResults {
+				for _, metric := range workloadResult.Metrics {
+					runSummary.AddMetric(metric.Name, metric.Value, metric.Unit, metric.Labels)
+				}
 
-			glog.V(gpuparams.GpuLogLevel).Infof("The created gpuBurnPod has name: %s has status: %v ",
-				gpuBurnPod.Name, gpuBurnPod.Status)
+				Expect(workloadResult.Problems).To(BeEmpty(), "'%s' pod '%s' execution on node '%s' was FAILED",
+					gpuWorkload.Name(), workloadResult.PodName, workloadResult.NodeName)
+				glog.V(gpuparams.GpuLogLevel).Infof("'%s' pod '%s' execution was successful",
+					gpuWorkload.Name(), workloadResult.PodName)
+			}
 
-			By("Get the gpu-burn pod with label \"app=gpu-burn-app\"")
-			gpuPodName, err := get.GetFirstPodNameWithLabel(inittools.APIClient, burn.Namespace, burn.PodLabel)
-			Expect(err).ToNot(HaveOccurred(), "error getting gpu-burn pod with label "+
-				"'app=gpu-burn-app' from namespace '%s' :  %v ", burn.Namespace, err)
-			glog.V(gpuparams.GpuLogLevel).Infof("gpuPodName is %s ", gpuPodName)
+		})
 
-			By("Pull the gpu-burn pod object from the cluster")
-			gpuPodPulled, err := pod.Pull(inittools.APIClient, gpuPodName, burn.Namespace)
-			Expect(err).ToNot(HaveOccurred(), "error pulling gpu-burn pod from "+
-				"namespace '%s' :  %v ", burn.Namespace, err)
+		It("Validate CUDA samples on every GPU node", Label("cuda-samples"), func(ctx SpecContext) {
+
+			if nvidiaGPUConfig.CUDASamplesImage == "" {
+				glog.V(gpuparams.GpuLogLevel).Infof("env variable NVIDIAGPU_CUDA_SAMPLES_IMAGE not set, " +
+					"skipping CUDA samples Testcase")
+				Skip("CUDA samples image not set, skipping CUDA samples Testcase")
+			}
+
+			By(fmt.Sprintf("Create CUDA samples namespace '%s'", cudaSamplesNamespace))
+			cudaSamplesNsBuilder, err := namespace.NewBuilder(inittools.APIClient, cudaSamplesNamespace).
+				CreateWithContext(ctx)
+			Expect(err).ToNot(HaveOccurred(), "error creating CUDA samples namespace '%s': %v",
+				cudaSamplesNamespace, err)
 
-			By("Cleanup gpu-burn pod only if cleanupAfterTest is true and OperatorUpgradeToChannel is undefined")
 			defer func() {
-				if cleanupAfterTest && OperatorUpgradeToChannel == UndefinedValue {
-					_, err := gpuPodPulled.Delete()
+				if cleanupAfterTest {
+					err := cudaSamplesNsBuilder.Delete()
 					Expect(err).ToNot(HaveOccurred())
 				}
 			}()
 
-			By(fmt.Sprintf("Wait for up to %s for gpu-burn pod to be in Running phase", nvidiagpu.BurnPodRunningTimeout))
-			err = gpuPodPulled.WaitUntilInStatus(corev1.PodRunning, nvidiagpu.BurnPodRunningTimeout)
-			Expect(err).ToNot(HaveOccurred(), "timeout waiting for gpu-burn pod in "+
-				"namespace '%s' to go to Running phase:  %v ", burn.Namespace, err)
-			glog.V(gpuparams.GpuLogLevel).Infof("gpu-burn pod now in Running phase")
-
-			By(fmt.Sprintf("Wait for up to %s for gpu-burn pod to run to completion and be in Succeeded phase/Completed status", nvidiagpu.BurnPodSuccessTimeout))
-			err = gpuPodPulled.WaitUntilInStatus(corev1.PodSucceeded, nvidiagpu.BurnPodSuccessTimeout)
+			By("List the GPU nodes to run the CUDA samples on")
+			gpuNodes, err := nodes.ListWithContext(ctx, inittools.APIClient,
+				metav1.ListOptions{LabelSelector: labels.Set(WorkerNodeSelector).String()})
+			Expect(err).ToNot(HaveOccurred(), "error listing GPU nodes: %v", err)
+			Expect(gpuNodes).ToNot(BeEmpty(), "no GPU node found to run the CUDA samples on")
+
+			for _, workloadName := range cudasamples.WorkloadNames {
+				cudaWorkload, err := workload.New(workloadName, workload.Params{
+					Config:       nvidiaGPUConfig,
+					Architecture: clusterArchitecture,
+					Images:       imageCatalog,
+					NodeSelector: WorkerNodeSelector,
+				})
+				Expect(err).ToNot(HaveOccurred(), "error building the '%s' workload: %v", workloadName, err)
 
-			Expect(err).ToNot(HaveOccurred(), "timeout waiting for gpu-burn pod '%s' in "+
-				"namespace '%s'to go Succeeded phase/Completed status:  %v ", burn.Namespace, burn.Namespace, err)
-			glog.V(gpuparams.GpuLogLevel).Infof("gpu-burn pod now in Succeeded Phase/Completed status")
+				By(fmt.Sprintf("Run '%s' on all the GPUs of every GPU node", workloadName))
+				workloadRun, err := workload.DeployWithContext(ctx, inittools.APIClient, cudaWorkload,
+					cudaSamplesNamespace, workload.NodeTargets(workloadName, gpuNodes, 0))
 
-			By("Get the gpu-burn pod logs")
-			glog.V(gpuparams.GpuLogLevel).Infof("Get the gpu-burn pod logs")
+				defer func() {
+					if cleanupAfterTest {
+						err := workloadRun.CleanupWithContext(ctx)
+						Expect(err).ToNot(HaveOccurred())
+					}
+				}()
 
-			gpuBurnLogs, err := gpuPodPulled.GetLog(nvidiagpu.BurnLogCollectionPeriod, "gpu-burn-ctr")
+				Expect(err).ToNot(HaveOccurred(), "error deploying the '%s' pods: %v", workloadName, err)
 
-			Expect(err).ToNot(HaveOccurred(), "error getting gpu-burn pod '%s' logs "+
-				"from gpu burn namespace '%s' :  %v ", burn.Namespace, err)
-			glog.V(gpuparams.GpuLogLevel).Infof("Gpu-burn pod '%s' logs:\n%s",
-				gpuPodPulled.Definition.Name, gpuBurnLogs)
+				err = workloadRun.WaitForPhaseWithContext(ctx, corev1.PodSucceeded, nvidiagpu.CUDASamplesSuccessTimeout)
+				Expect(err).ToNot(HaveOccurred(), "error waiting for the '%s' pods to complete: %v", workloadName, err)
 
-			By("Parse the gpu-burn pod logs and check for successful execution")
-			match1 := strings.Contains(gpuBurnLogs, "GPU 0: OK")
-			match2 := strings.Contains(gpuBurnLogs, "100.0%  proc'd:")
+				workloadResults, err := workloadRun.ValidateWithContext(ctx)
+				Expect(err).ToNot(HaveOccurred(), "error validating the '%s' pods output: %v", workloadName, err)
 
-			Expect(match1 && match2).ToNot(BeFalse(), "gpu-burn pod execution was FAILED")
-			glog.V(gpuparams.GpuLogLevel).Infof("Gpu-burn pod execution was successful")
+				for _, workloadResult := range workloadResults {
+					for _, metric := range workloadResult.Metrics {
+						runSummary.AddMetric(metric.Name, metric.Value, metric.Unit, metric.Labels)
+					}
 
+					Expect(workloadResult.Problems).To(BeEmpty(), "'%s' pod '%s' on node '%s' was FAILED",
+						workloadName, workloadResult.PodName, workloadResult.NodeName)
+					glog.V(gpuparams.GpuLogLevel).Infof("'%s' pod '%s' on node '%s' was successful",
+						workloadName, workloadResult.PodName, workloadResult.NodeName)
+				}
+			}
 		})
 
-		It("Upgrade NVIDIA GPU Operator", Label("operator-upgrade"), func() {
+		It("Upgrade NVIDIA GPU Operator", Label("operator-upgrade"), func(ctx SpecContext) {
 
 			if OperatorUpgradeToChannel == UndefinedValue {
 				glog.V(gpuparams.GpuLogLevel).Infof("Operator Upgrade To Channel not set, skipping " +
@@ -843,7 +1056,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 
 			glog.V(100).Infof(
 				"Pulling ClusterPolicy builder structure named '%s'", nvidiagpu.ClusterPolicyName)
-			pulledClusterPolicyBuilder, err := nvidiagpu.Pull(inittools.APIClient, nvidiagpu.ClusterPolicyName)
+			pulledClusterPolicyBuilder, err := nvidiagpu.PullWithContext(ctx, inittools.APIClient, nvidiagpu.ClusterPolicyName)
 
 			Expect(err).ToNot(HaveOccurred(), "error pulling ClusterPolicy builder object name '%s' "+
 				"from cluster: %v", nvidiagpu.ClusterPolicyName, err)
@@ -862,23 +1075,14 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				"Setting pulled ClusterPolicy builder daemonset rollingUpdate.MaxUnavailable value to '%s'",
 				maxUnavailable)
 
-			myRollingUpdate := nvidiagpuv1.RollingUpdateSpec{
-				MaxUnavailable: maxUnavailable,
-			}
-
-			if pulledClusterPolicyBuilder.Definition.Spec.Daemonsets.RollingUpdate == nil {
-				pulledClusterPolicyBuilder.Definition.Spec.Daemonsets.RollingUpdate = &myRollingUpdate
-			}
-
-			myDriverAutoUpgradeTrue := nvidiagpuv1alpha1.DriverUpgradePolicySpec{
-				AutoUpgrade: true}
+			pulledClusterPolicyBuilder.WithDaemonsetsMaxUnavailable(maxUnavailable)
 
+			// The driver auto upgrade is only enabled without upgrade policy, an existing one is kept as is.
 			if pulledClusterPolicyBuilder.Definition.Spec.Driver.UpgradePolicy == nil {
-				pulledClusterPolicyBuilder.Definition.Spec.Driver.UpgradePolicy = &myDriverAutoUpgradeTrue
+				pulledClusterPolicyBuilder.WithDriverAutoUpgrade(true)
 			}
 
-			pulledClusterPolicyBuilder.Definition.Spec.Daemonsets.RollingUpdate.MaxUnavailable = maxUnavailable
-			updatedPulledClusterPolicyBuilder, err := pulledClusterPolicyBuilder.Update(true)
+			updatedPulledClusterPolicyBuilder, err := pulledClusterPolicyBuilder.UpdateWithContext(ctx, true)
 
 			Expect(err).ToNot(HaveOccurred(), "error updating pulled ClusterPolicy builder"+
 				" daemonset rollingUpdate.MaxUnavailable and Driver.UpgradePolicy fields:  %v", err)
@@ -897,7 +1101,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				"Pulling SubscriptionBuilder structure with the following params: %s, %s", nvidiagpu.SubscriptionName,
 				nvidiagpu.SubscriptionNamespace)
 
-			pulledSubBuilder, err := olm.PullSubscription(inittools.APIClient, nvidiagpu.SubscriptionName,
+			pulledSubBuilder, err := olm.PullSubscriptionWithContext(ctx, inittools.APIClient, nvidiagpu.SubscriptionName,
 				nvidiagpu.SubscriptionNamespace)
 
 			Expect(err).ToNot(HaveOccurred(), "Error pulling subscription '%s' in "+
@@ -908,6 +1112,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 
 			glog.V(100).Infof("Current Subscription Channel : %s", pulledSubBuilder.Definition.Spec.Channel)
 
+			previousCSV := pulledSubBuilder.Object.Status.CurrentCSV
 			pulledSubBuilder.Definition.Spec.Channel = OperatorUpgradeToChannel
 			glog.V(100).Infof("Updating Subscription Channel to upgrade to : %s",
 				pulledSubBuilder.Definition.Spec.Channel)
@@ -917,7 +1122,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				pulledSubBuilder.Object.Spec.StartingCSV)
 
 			By("Update the Subscription builder object with new channel value")
-			updatedPulledSubBuilder, err := pulledSubBuilder.Update()
+			updatedPulledSubBuilder, err := pulledSubBuilder.UpdateWithContext(ctx)
 
 			Expect(err).ToNot(HaveOccurred(), "Error updating pulled subscription '%s' in "+
 				"namespace '%s': %v", nvidiagpu.SubscriptionName, nvidiagpu.SubscriptionNamespace, err)
@@ -925,8 +1130,11 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 			glog.V(100).Infof("Successfully updated Subscription Channel to upgrade to '%s'",
 				updatedPulledSubBuilder.Definition.Spec.Channel)
 
-			glog.V(100).Infof("Sleeping for %s to allow new CSV to be deployed", nvidiagpu.CsvDeploymentSleepInterval)
-			time.Sleep(nvidiagpu.CsvDeploymentSleepInterval)
+			By(fmt.Sprintf("Wait up to %s for the Subscription to move away from CSV '%s'",
+				nvidiagpu.CsvUpgradeTimeout, previousCSV))
+			err = wait.WatchSubscriptionCSVChanged(ctx, inittools.APIClient, nvidiagpu.SubscriptionName,
+				nvidiagpu.SubscriptionNamespace, previousCSV, nvidiagpu.CsvUpgradeTimeout)
+			Expect(err).ToNot(HaveOccurred(), "error waiting for new CSV to be deployed: %v", err)
 
 			glog.V(100).Infof("After Subscription Channel upgrade, the StartingCSV is now '%s'",
 				updatedPulledSubBuilder.Object.Spec.StartingCSV)
@@ -934,14 +1142,14 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 			By("Wait for daemonsets to be redeployed up to 15 minutes and for ClusterPolicy to be ready again")
 			glog.V(gpuparams.GpuLogLevel).Infof("Waiting up to 15 mins for ClusterPolicy to be ready again " +
 				"after upgrade")
-			err = wait.ClusterPolicyReady(inittools.APIClient, nvidiagpu.ClusterPolicyName, 60*time.Second, 15*time.Minute)
+			err = wait.ClusterPolicyReadyWithContext(ctx, inittools.APIClient, nvidiagpu.ClusterPolicyName, 60*time.Second, 15*time.Minute)
 
 			glog.V(gpuparams.GpuLogLevel).Infof("error waiting for ClusterPolicy to be Ready:  %v ", err)
 			Expect(err).ToNot(HaveOccurred(), "error waiting for ClusterPolicy to be Ready:  %v ",
 				err)
 
 			By("Pull the post-upgrade Ready ClusterPolicy from cluster, with updated fields")
-			pulledUpdatedReadyClusterPolicy, err := nvidiagpu.Pull(inittools.APIClient, nvidiagpu.ClusterPolicyName)
+			pulledUpdatedReadyClusterPolicy, err := nvidiagpu.PullWithContext(ctx, inittools.APIClient, nvidiagpu.ClusterPolicyName)
 			Expect(err).ToNot(HaveOccurred(), "error pulling ClusterPolicy %s from cluster: "+
 				" %v ", nvidiagpu.ClusterPolicyName, err)
 
@@ -968,7 +1176,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 				"in json: %v", string(cpReadyAgainJSON))
 
 			By("Pull the previously deployed gpu-burn pod object from the cluster")
-			currentGpuBurnPodPulled, err := pod.Pull(inittools.APIClient, burn.Namespace, burn.Namespace)
+			currentGpuBurnPodPulled, err := pod.PullWithContext(ctx, inittools.APIClient, burn.Namespace, burn.Namespace)
 			Expect(err).ToNot(HaveOccurred(), "error pulling previously deployed and completed "+
 				"gpu-burn pod from namespace '%s' :  %v ", burn.Namespace, err)
 
@@ -986,9 +1194,6 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 			Expect(err).ToNot(HaveOccurred(), "Error deleting gpu-burn pod")
 
 			By("Re-deploy gpu-burn pod in test-gpu-burn namespace")
-			glog.V(gpuparams.GpuLogLevel).Infof("Re-deployed gpu-burn pod image name is: '%s', in "+
-				"namespace '%s'", BurnImageName[clusterArchitecture], burn.Namespace)
-
 			By("Get Cluster Architecture from first GPU enabled worker node")
 			glog.V(gpuparams.GpuLogLevel).Infof("Getting cluster architecture from nodes with "+
 				"WorkerNodeSelector: %v", WorkerNodeSelector)
@@ -998,8 +1203,18 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 			glog.V(gpuparams.GpuLogLevel).Infof("cluster architecture for GPU enabled worker node is: %s",
 				clusterArch)
 
+			burnImage, err := imageCatalog.Image(imagecatalog.GPUBurn, clusterArch)
+			Expect(err).ToNot(HaveOccurred(), "error resolving the gpu-burn image:  %v ", err)
+
+			glog.V(gpuparams.GpuLogLevel).Infof("Re-deployed gpu-burn pod image name is: '%s', in "+
+				"namespace '%s'", burnImage, burn.Namespace)
+
+			redeployedBurnConfig := gpuburn.NewDefaultConfig()
+			redeployedBurnConfig.GPUs = max(nvidiaGPUConfig.BurnGPUsPerPod, 1)
+			redeployedBurnConfig.NodeSelector = WorkerNodeSelector
+
 			gpuBurnPod2, err := gpuburn.CreateGPUBurnPod(inittools.APIClient, burn.Namespace, burn.Namespace,
-				BurnImageName[(clusterArch)], nvidiagpu.BurnPodPostUpgradeCreationTimeout)
+				burnImage, redeployedBurnConfig, nvidiagpu.BurnPodPostUpgradeCreationTimeout)
 			Expect(err).ToNot(HaveOccurred(), "Error re-building gpu burn pod object after "+
 				"upgrade: %v", err)
 
@@ -1021,7 +1236,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 			glog.V(gpuparams.GpuLogLevel).Infof("gpuPodName is %s ", gpuBurnPod2Name)
 
 			By("Pull the re-created gpu-burn pod object from the cluster")
-			gpuBurnPod2Pulled, err := pod.Pull(inittools.APIClient, gpuBurnPod2.Name, burn.Namespace)
+			gpuBurnPod2Pulled, err := pod.PullWithContext(ctx, inittools.APIClient, gpuBurnPod2.Name, burn.Namespace)
 			Expect(err).ToNot(HaveOccurred(), "error pulling re-deployed gpu-burn pod from "+
 				"namespace '%s' :  %v ", burn.Namespace, err)
 
@@ -1033,13 +1248,13 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 			}()
 
 			By(fmt.Sprintf("Wait for up to %s for re-deployed burn pod to be in Running phase", nvidiagpu.RedeployedBurnPodRunningTimeout))
-			err = gpuBurnPod2Pulled.WaitUntilInStatus(corev1.PodRunning, nvidiagpu.RedeployedBurnPodRunningTimeout)
+			err = gpuBurnPod2Pulled.WaitUntilInStatusWithContext(ctx, corev1.PodRunning, nvidiagpu.RedeployedBurnPodRunningTimeout)
 			Expect(err).ToNot(HaveOccurred(), "timeout waiting for re-deployed gpu-burn pod in "+
 				"namespace '%s' to go to Running phase:  %v ", burn.Namespace, err)
 			glog.V(gpuparams.GpuLogLevel).Infof("gpu-burn pod now in Running phase")
 
 			By(fmt.Sprintf("Wait for up to %s for re-deployed burn pod to run to completion and be in Succeeded phase/Completed status", nvidiagpu.RedeployedBurnPodSuccessTimeout))
-			err = gpuBurnPod2Pulled.WaitUntilInStatus(corev1.PodSucceeded, nvidiagpu.RedeployedBurnPodSuccessTimeout)
+			err = gpuBurnPod2Pulled.WaitUntilInStatusWithContext(ctx, corev1.PodSucceeded, nvidiagpu.RedeployedBurnPodSuccessTimeout)
 			Expect(err).ToNot(HaveOccurred(), "timeout waiting for gpu-burn pod '%s' in "+
 				"namespace '%s'to go Succeeded phase/Completed status:  %v ", burn.Namespace, burn.Namespace, err)
 			glog.V(gpuparams.GpuLogLevel).Infof("gpu-burn pod now in Succeeded Phase/Completed status")
@@ -1047,7 +1262,7 @@ var _ = Describe("GPU", Ordered, Label(tsparams.LabelSuite), func() {
 			By("Get the gpu-burn pod logs")
 			glog.V(gpuparams.GpuLogLevel).Infof("Get the re-created gpu-burn pod logs")
 
-			gpuBurnPod2Logs, err := gpuBurnPod2Pulled.GetLog(nvidiagpu.RedeployedBurnLogCollectionPeriod, "gpu-burn-ctr")
+			gpuBurnPod2Logs, err := gpuBurnPod2Pulled.GetLogWithContext(ctx, nvidiagpu.RedeployedBurnLogCollectionPeriod, "gpu-burn-ctr")
 
 			Expect(err).ToNot(HaveOccurred(), "error getting gpu-burn pod '%s' logs "+
 				"from gpu burn namespace '%s' :  %v ", burn.Namespace, err)
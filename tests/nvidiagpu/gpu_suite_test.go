@@ -10,6 +10,7 @@ import (
 
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/reporter"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/tsparams"
@@ -28,10 +29,11 @@ func TestGPUDeploy(t *testing.T) {
 	RunSpecs(t, "GPU", Label(tsparams.Labels...), reporterConfig)
 }
 
-var _ = JustAfterEach(func() {
+var _ = JustAfterEach(func(ctx SpecContext) {
 	specReport := CurrentSpecReport()
 	reporter.ReportIfFailed(
 		specReport, currentFile, tsparams.ReporterNamespacesToDump, tsparams.ReporterCRDsToDump, clients.SetScheme)
+	reporter.CollectMustGatherIfFailed(ctx, specReport, tsparams.ReporterNamespacesToDump)
 
 	scriptPath := os.Getenv("PATH_TO_MUST_GATHER_SCRIPT")
 	if scriptPath != "" {
@@ -41,3 +43,24 @@ var _ = JustAfterEach(func() {
 		}
 	}
 })
+
+var _ = BeforeEach(func() {
+	specLogPath := inittools.GeneralConfig.GetReportPath(logging.SpecLogFileName(CurrentSpecReport().FullText()))
+	if err := logging.StartSpecLog(specLogPath); err != nil {
+		glog.Errorf("Failed to start spec log: %v", err)
+	}
+})
+
+var _ = AfterEach(func() {
+	if err := logging.StopSpecLog(); err != nil {
+		glog.Errorf("Failed to stop spec log: %v", err)
+	}
+})
+
+var _ = ReportAfterSuite("run summary", func(report Report) {
+	runSummary.AddSpecReports(report.SpecReports)
+
+	if err := runSummary.Write(inittools.GeneralConfig); err != nil {
+		glog.Errorf("Failed to write run summary: %v", err)
+	}
+})
@@ -5,16 +5,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/get"
+	"strconv"
 	"time"
 
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/imagecatalog"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/nccl"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/nvidianetworkconfig"
 	rdmatest "github.com/rh-ecosystem-edge/nvidia-ci/internal/rdma"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/runsummary"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/deployment"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nfdcheck"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nodes"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/operatorconfig"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
 	"github.com/golang/glog"
 	. "github.com/onsi/ginkgo/v2"
@@ -35,6 +42,8 @@ import (
 )
 
 var (
+	runSummary = runsummary.New("NNO")
+
 	nfdInstance = operatorconfig.NewCustomConfig()
 
 	WorkerNodeSelector = map[string]string{
@@ -45,6 +54,7 @@ var (
 	// NvidiaNetworkConfig provides access to general configuration parameters.
 	nvidiaNetworkConfig *nvidianetworkconfig.NvidiaNetworkConfig
 	nfdConfig           *internalNFD.NFDConfig
+	imageCatalog        *imagecatalog.Catalog
 	CatalogSource                         = UndefinedValue
 	SubscriptionChannel                   = UndefinedValue
 	InstallPlanApproval v1alpha1.Approval = "Automatic"
@@ -87,20 +97,14 @@ var (
 
 	sriovNetworkName = UndefinedValue
 
-	rdmaTestImage = UndefinedValue
-
-	// Default image based on cluster architecture
-	rdmaTestImageDefault = map[string]string{
-		"amd64": "quay.io/wabouham/ecosys-nvidia/rdma-tools:0.0.3",
-		"arm64": "quay.io/wabouham/ecosys-nvidia/rdma-tools-aarch64:0.0.3",
-	}
+	rdmaTestImage   = UndefinedValue
+	debugToolsImage = UndefinedValue
 
 	withCuda = "no"
 )
 
 const (
-	nvidiaNetworkLabel                      = "feature.node.kubernetes.io/pci-15b3.present"
-	networkOperatorDefaultMasterBundleImage = "registry.gitlab.com/nvidia/kubernetes/network-operator/staging/network-operator-bundle:main-latest"
+	nvidiaNetworkLabel = "feature.node.kubernetes.io/pci-15b3.present"
 
 	nnoNamespace                        = "nvidia-network-operator"
 	nnoOperatorGroupName                = "nno-og"
@@ -125,6 +129,8 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 	var (
 		deployBundle       deploy.Deploy
 		deployBundleConfig deploy.BundleConfig
+		nfdConfigErr       error
+		imageCatalogErr    error
 	)
 
 	if mellanoxEthernetInterfaceName == "" {
@@ -136,11 +142,30 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 	}
 
 	nvidiaNetworkConfig = nvidianetworkconfig.NewNvidiaNetworkConfig()
-	nfdConfig, _ = internalNFD.NewNFDConfig()
+	nfdConfig, nfdConfigErr = internalNFD.NewNFDConfig()
+	imageCatalog, imageCatalogErr = imagecatalog.NewCatalog()
 
 	Context("DeployNNO", Label("deploy-nno-with-dtk"), func() {
 
 		BeforeAll(func() {
+			Expect(nvidiaNetworkConfig).ToNot(BeNil(), "error loading NvidiaNetworkConfig from profile file "+
+				"and NVIDIANETWORK_* env variables")
+			Expect(nfdConfigErr).ToNot(HaveOccurred(), "error loading NFDConfig:  %v", nfdConfigErr)
+			Expect(imageCatalogErr).ToNot(HaveOccurred(), "error loading the image catalog:  %v", imageCatalogErr)
+
+			if err := inittools.GeneralConfig.WriteEffectiveConfig(nvidianetworkconfig.ProfileSection,
+				nvidiaNetworkConfig); err != nil {
+				glog.Error("Error writing the effective NvidiaNetworkConfig: ", err)
+			}
+
+			if err := inittools.GeneralConfig.WriteEffectiveConfig(internalNFD.ProfileSection, nfdConfig); err != nil {
+				glog.Error("Error writing the effective NFDConfig: ", err)
+			}
+
+			if err := inittools.GeneralConfig.WriteEffectiveConfig(imagecatalog.ProfileSection,
+				imageCatalog); err != nil {
+				glog.Error("Error writing the effective image catalog: ", err)
+			}
 
 			if nvidiaNetworkConfig.CatalogSource == "" {
 				glog.V(networkparams.LogLevel).Infof("env variable NVIDIANETWORK_CATALOGSOURCE"+
@@ -354,11 +379,11 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 					"not set or set to False, will execute RDMA tests without cuda switch")
 			}
 
-			if nvidiaNetworkConfig.RdmaNetworkType == "sriov" {
+			if nvidiaNetworkConfig.RdmaNetworkType == nvidianetworkconfig.RdmaNetworkTypeSriov {
 				glog.V(networkparams.LogLevel).Infof("env variable NVIDIANETWORK_RDMA_NETWORK_TYPE" +
 					" is set to 'sriov', will remove the RDMASaredDevicePlugin element form the NicClusterPolicy")
 				rdmaNetworkType = nvidiaNetworkConfig.RdmaNetworkType
-			} else if nvidiaNetworkConfig.RdmaNetworkType == "shared-device" {
+			} else if nvidiaNetworkConfig.RdmaNetworkType == nvidianetworkconfig.RdmaNetworkTypeSharedDevice {
 				glog.V(networkparams.LogLevel).Infof("env variable NVIDIANETWORK_RDMA_NETWORK_TYPE" +
 					" is set to 'shared-device', proceeding with setting up default NicCLusterPolicy for Shared Device")
 				rdmaNetworkType = nvidiaNetworkConfig.RdmaNetworkType
@@ -392,15 +417,9 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				deployFromBundle = nvidiaNetworkConfig.DeployFromBundle
 				glog.V(networkparams.LogLevel).Infof("Flag deploy Network operator from bundle is set "+
 					"to env variable NVIDIANETWORK_DEPLOY_FROM_BUNDLE value '%v'", deployFromBundle)
-				if nvidiaNetworkConfig.BundleImage == "" {
-					glog.V(networkparams.LogLevel).Infof("env variable NVIDIANETWORK_BUNDLE_IMAGE"+
-						" is not set, will use the default bundle image '%s'", networkOperatorDefaultMasterBundleImage)
-					networkOperatorBundleImage = networkOperatorDefaultMasterBundleImage
-				} else {
-					networkOperatorBundleImage = nvidiaNetworkConfig.BundleImage
-					glog.V(networkparams.LogLevel).Infof("env variable NVIDIANETWORK_BUNDLE_IMAGE"+
-						" is set, will use the specified bundle image '%s'", networkOperatorBundleImage)
-				}
+				networkOperatorBundleImage = nvidiaNetworkConfig.BundleImage
+				glog.V(networkparams.LogLevel).Infof("env variable NVIDIANETWORK_BUNDLE_IMAGE"+
+					" is set, will use the specified bundle image '%s'", networkOperatorBundleImage)
 			} else {
 				glog.V(networkparams.LogLevel).Infof("env variable NVIDIANETWORK_DEPLOY_FROM_BUNDLE" +
 					" is set to false or is not set, will deploy Network Operator from catalogsource")
@@ -482,9 +501,11 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 					[]byte(ocpVersion)); writeErr != nil {
 					glog.Error("Error writing OpenShift version file: ", writeErr)
 				}
+
+				runSummary.SetOpenShiftVersion(ocpVersion)
 			}
 
-			nfd.EnsureNFDIsInstalled(inittools.APIClient, nfdInstance, ocpVersion, networkparams.LogLevel)
+			nfd.EnsureNFDIsInstalled(inittools.APIClient, nfdInstance, ocpVersion)
 
 			// After NFD is installed, we can network operator enabled worker node architecture
 			By("Get Cluster Architecture from first NVIDIA Network enabled worker node")
@@ -496,17 +517,23 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 			clusterArchitecture = clusterArch
 			glog.V(networkparams.LogLevel).Infof("cluster architecture for NVIDIA Network enabled worker node "+
 				"is: %s", clusterArchitecture)
+			runSummary.SetClusterArchitecture(clusterArchitecture)
 
 			if nvidiaNetworkConfig.RdmaTestImage == "" {
+				rdmaTestImage, err = imageCatalog.Image(imagecatalog.RdmaTools, clusterArchitecture)
+				Expect(err).ToNot(HaveOccurred(), "error resolving the RDMA test image:  %v", err)
 				glog.V(networkparams.LogLevel).Infof("env variable NVIDIANETWORK_RDMA_TEST_IMAGE"+
-					" is not set, will use default container image '%s'", rdmaTestImageDefault[clusterArchitecture])
-				rdmaTestImage = rdmaTestImageDefault[clusterArchitecture]
+					" is not set, will use catalog container image '%s'", rdmaTestImage)
 			} else {
-				rdmaTestImage = nvidiaNetworkConfig.RdmaTestImage
+				rdmaTestImage, err = imageCatalog.Rewrite(nvidiaNetworkConfig.RdmaTestImage)
+				Expect(err).ToNot(HaveOccurred(), "error resolving the RDMA test image:  %v", err)
 				glog.V(networkparams.LogLevel).Infof("rdmaTestImage is set to env variable "+
 					"NVIDIANETWORK_RDMA_TEST_IMAGE value '%v'", rdmaTestImage)
 			}
 
+			debugToolsImage, err = imageCatalog.Image(imagecatalog.DebugTools, clusterArchitecture)
+			Expect(err).ToNot(HaveOccurred(), "error resolving the debug tools image:  %v", err)
+
 		})
 
 		BeforeEach(func() {
@@ -526,10 +553,10 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 
 		})
 
-		It("Deploy NVIDIA Network Operator with DTK", Label("nno"), func() {
+		It("Deploy NVIDIA Network Operator with DTK", Label("nno"), func(ctx SpecContext) {
 
 			nfdcheck.CheckNfdInstallation(inittools.APIClient, nfd.OSLabel, nfd.GetAllowedOSLabels(),
-				inittools.GeneralConfig.WorkerLabelMap, networkparams.LogLevel)
+				inittools.GeneralConfig.WorkerLabelMap)
 
 			By("Check if at least one worker node is has label for Mellanox cards enabled")
 			networkNodeFound, _ := check.NodeWithLabel(inittools.APIClient, nvidiaNetworkLabel,
@@ -555,7 +582,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 					i, workerNode)
 				debugPodName := fmt.Sprintf("delete-rpm-pod-%d", i) // ensure unique name
 				deleteMofedRPMDirOutput, err := rdmatest.DeleteMofedRpmDir(inittools.APIClient, debugPodName,
-					"default", clusterArchitecture, workerNode)
+					"default", debugToolsImage, workerNode)
 				Expect(err).ToNot(HaveOccurred(), "Error deleting MOFED RPMs dir on worker node"+
 					" '%s':   %v", workerNode, err)
 				glog.V(networkparams.LogLevel).Infof("Output from deleting MOFED RPMS dir on worker node '%s'"+
@@ -577,7 +604,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				By("Check if 'nvidia-network-operator' packagemanifest exists in certified-operators catalog")
 				glog.V(networkparams.LogLevel).Infof("Using NNO catalogsource '%s'", CatalogSource)
 
-				nnoPkgManifestBuilderByCatalog, err := olm.PullPackageManifestByCatalog(inittools.APIClient,
+				nnoPkgManifestBuilderByCatalog, err := olm.PullPackageManifestByCatalogWithContext(ctx, inittools.APIClient,
 					nnoPackage, nnoCatalogSourceNamespace, nnoCatalogSourceDefault)
 
 				if err != nil {
@@ -603,26 +630,24 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 						Expect(nnoCustomCatalogSourceBuilder).NotTo(BeNil(), "Failed to Initialize "+
 							"CatalogSourceBuilder for custom NNO catalogsource '%s'", CustomCatalogSource)
 
-						createdNNOCustomCatalogSourceBuilder, err := nnoCustomCatalogSourceBuilder.Create()
+						createdNNOCustomCatalogSourceBuilder, err := nnoCustomCatalogSourceBuilder.CreateWithContext(ctx)
 						glog.V(networkparams.LogLevel).Infof("Creating custom NNO Catalogsource builder object "+
 							"'%s'", createdNNOCustomCatalogSourceBuilder.Definition.Name)
 						Expect(err).ToNot(HaveOccurred(), "error creating custom NNO catalogsource "+
 							"builder Object name %s:  %v", CustomCatalogSource, err)
 
-						By("Sleep for 60 seconds to allow the NNO custom catalogsource to be created")
-						time.Sleep(60 * time.Second)
-
-						glog.V(networkparams.LogLevel).Infof("Wait up to 4 mins for custom NNO catalogsource " +
-							"to be ready")
-
-						Expect(createdNNOCustomCatalogSourceBuilder.IsReady(4 * time.Minute)).NotTo(BeFalse())
+						By("Wait up to 5 mins for custom NNO catalogsource to be ready")
+						err = wait.WatchCatalogSourceReady(ctx, inittools.APIClient, CustomCatalogSource,
+							nnoCatalogSourceNamespace, 5*time.Minute)
+						Expect(err).ToNot(HaveOccurred(), "error waiting for custom NNO catalogsource "+
+							"'%s' to be ready: %v", CustomCatalogSource, err)
 
 						CatalogSource = createdNNOCustomCatalogSourceBuilder.Definition.Name
 
 						glog.V(networkparams.LogLevel).Infof("Custom NNO catalogsource '%s' is now ready",
 							createdNNOCustomCatalogSourceBuilder.Definition.Name)
 
-						nnoPkgManifestBuilderByCustomCatalog, err := olm.PullPackageManifestByCatalog(inittools.APIClient,
+						nnoPkgManifestBuilderByCustomCatalog, err := olm.PullPackageManifestByCatalogWithContext(ctx, inittools.APIClient,
 							nnoPackage, nnoCatalogSourceNamespace, CustomCatalogSource)
 
 						Expect(err).ToNot(HaveOccurred(), "error getting NNO packagemanifest '%s' "+
@@ -654,12 +679,12 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 
 			By("Check if NVIDIA Network Operator namespace exists, otherwise created it and label it")
 			nsBuilder := namespace.NewBuilder(inittools.APIClient, nnoNamespace)
-			if nsBuilder.Exists() {
+			if nsBuilder.ExistsWithContext(ctx) {
 				glog.V(networkparams.LogLevel).Infof("The namespace '%s' already exists",
 					nsBuilder.Object.Name)
 			} else {
 				glog.V(networkparams.LogLevel).Infof("Creating the namespace:  %v", nnoNamespace)
-				createdNsBuilder, err := nsBuilder.Create()
+				createdNsBuilder, err := nsBuilder.CreateWithContext(ctx)
 				Expect(err).ToNot(HaveOccurred(), "error creating namespace '%s' :  %v ",
 					nsBuilder.Definition.Name, err)
 
@@ -674,7 +699,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 					"pod-security.kubernetes.io/enforce": "privileged",
 				})
 
-				newLabeledNsBuilder, err := labeledNsBuilder.Update()
+				newLabeledNsBuilder, err := labeledNsBuilder.UpdateWithContext(ctx)
 				Expect(err).ToNot(HaveOccurred(), "error labeling namespace %v :  %v ",
 					newLabeledNsBuilder.Definition.Name, err)
 
@@ -710,14 +735,14 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				By("Create OperatorGroup in NVIDIA Network Operator Namespace")
 				ogBuilder := olm.NewOperatorGroupBuilder(inittools.APIClient, nnoOperatorGroupName, nnoNamespace)
 
-				if ogBuilder.Exists() {
+				if ogBuilder.ExistsWithContext(ctx) {
 					glog.V(networkparams.LogLevel).Infof("The ogBuilder that exists has name:  %v",
 						ogBuilder.Object.Name)
 				} else {
 					glog.V(networkparams.LogLevel).Infof("Create a new operatorgroup with name:  %v",
 						ogBuilder.Object.Name)
 
-					ogBuilderCreated, err := ogBuilder.Create()
+					ogBuilderCreated, err := ogBuilder.CreateWithContext(ctx)
 					Expect(err).ToNot(HaveOccurred(), "error creating operatorgroup %v :  %v ",
 						ogBuilderCreated.Definition.Name, err)
 				}
@@ -746,7 +771,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				subBuilder.WithInstallPlanApproval(InstallPlanApproval)
 
 				glog.V(networkparams.LogLevel).Infof("Creating the subscription, i.e Deploy the Network operator")
-				createdSub, err := subBuilder.Create()
+				createdSub, err := subBuilder.CreateWithContext(ctx)
 
 				Expect(err).ToNot(HaveOccurred(), "error creating subscription %v :  %v ",
 					createdSub.Definition.Name, err)
@@ -754,7 +779,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				glog.V(networkparams.LogLevel).Infof("Newly created subscription: %s was successfully created",
 					createdSub.Object.Name)
 
-				if createdSub.Exists() {
+				if createdSub.ExistsWithContext(ctx) {
 					glog.V(networkparams.LogLevel).Infof("The newly created NNO subscription '%s' in "+
 						"namespace '%v' has current CSV  '%v'", createdSub.Object.Name, createdSub.Object.Namespace,
 						createdSub.Object.Status.CurrentCSV)
@@ -769,19 +794,12 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 
 			}
 
-			By("Sleep for 2 minutes to allow the Network Operator deployment to be created")
-			glog.V(networkparams.LogLevel).Infof("Sleep for 2 minutes to allow the Network Operator deployment" +
-				" to be created")
-			time.Sleep(2 * time.Minute)
-
-			By("Wait for up to 4 minutes for Network Operator deployment to be created")
-			nnoDeploymentCreated := wait.DeploymentCreated(inittools.APIClient, nnoDeployment, nnoNamespace,
-				30*time.Second, 4*time.Minute)
-			Expect(nnoDeploymentCreated).ToNot(BeFalse(), "timed out waiting to deploy "+
-				"Network operator")
+			By("Wait for up to 6 minutes for Network Operator deployment to be created")
+			err := wait.WatchDeploymentCreated(ctx, inittools.APIClient, nnoDeployment, nnoNamespace, 6*time.Minute)
+			Expect(err).ToNot(HaveOccurred(), "timed out waiting to deploy Network operator: %v", err)
 
 			By("Check if the Network operator deployment is ready")
-			nnoOperatorDeployment, err := deployment.Pull(inittools.APIClient, nnoDeployment, nnoNamespace)
+			nnoOperatorDeployment, err := deployment.PullWithContext(ctx, inittools.APIClient, nnoDeployment, nnoNamespace)
 
 			Expect(err).ToNot(HaveOccurred(), "Error trying to pull Network operator "+
 				"deployment is: %v", err)
@@ -789,13 +807,13 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 			glog.V(networkparams.LogLevel).Infof("Pulled Network operator deployment is:  %v ",
 				nnoOperatorDeployment.Definition.Name)
 
-			if nnoOperatorDeployment.IsReady(4 * time.Minute) {
+			if nnoOperatorDeployment.IsReadyWithContext(ctx, 4*time.Minute) {
 				glog.V(networkparams.LogLevel).Infof("Pulled Network operator deployment '%s' is Ready",
 					nnoOperatorDeployment.Definition.Name)
 			}
 
 			By("Get the CSV deployed in NVIDIA Network Operator namespace")
-			csvBuilderList, err := olm.ListClusterServiceVersion(inittools.APIClient, nnoNamespace)
+			csvBuilderList, err := olm.ListClusterServiceVersionWithContext(ctx, inittools.APIClient, nnoNamespace)
 
 			Expect(err).ToNot(HaveOccurred(), "Error getting list of CSVs in Network operator "+
 				"namespace: '%v'", err)
@@ -837,10 +855,14 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				glog.Error("Error writing an operator version file: ", err)
 			}
 
+			if err := runSummary.CollectOperator(ctx, inittools.APIClient, nnoNamespace); err != nil {
+				glog.Error("Error collecting the operator for the run summary: ", err)
+			}
+
 			By("Wait for deployed ClusterServiceVersion to be in Succeeded phase")
 			glog.V(networkparams.LogLevel).Infof("Waiting for ClusterServiceVersion '%s' to be in Succeeded phase",
 				nnoCurrentCSV)
-			err = wait.CSVSucceeded(inittools.APIClient, nnoCurrentCSV, nnoNamespace, 60*time.Second,
+			err = wait.CSVSucceededWithContext(ctx, inittools.APIClient, nnoCurrentCSV, nnoNamespace, 60*time.Second,
 				5*time.Minute)
 			glog.V(networkparams.LogLevel).Info("error waiting for ClusterServiceVersion '%s' to be "+
 				"in Succeeded phase:  %v ", nnoCurrentCSV, err)
@@ -848,7 +870,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				"in Succeeded phase: ", err)
 
 			By("Pull existing CSV in NVIDIA Network Operator Namespace")
-			clusterCSV, err := olm.PullClusterServiceVersion(inittools.APIClient, nnoCurrentCSV, nnoNamespace)
+			clusterCSV, err := olm.PullClusterServiceVersionWithContext(ctx, inittools.APIClient, nnoCurrentCSV, nnoNamespace)
 			Expect(err).ToNot(HaveOccurred(), "error pulling CSV from cluster:  %v", err)
 
 			glog.V(networkparams.LogLevel).Infof("clusterCSV from cluster lastUpdatedTime is : %v ",
@@ -869,7 +891,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 			}()
 
 			By("Get ALM examples block form CSV")
-			almExamples, err := clusterCSV.GetAlmExamples()
+			almExamples, err := clusterCSV.GetAlmExamplesWithContext(ctx)
 			Expect(err).ToNot(HaveOccurred(), "Error from pulling almExamples from csv "+
 				"from cluster:  %v ", err)
 			glog.V(networkparams.LogLevel).Infof("almExamples block from clusterCSV  is : %v ", almExamples)
@@ -1022,7 +1044,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 			}
 
 			By("Deploy NicClusterPolicy")
-			createdNicClusterPolicyBuilder, err := nicClusterPolicyBuilder.Create()
+			createdNicClusterPolicyBuilder, err := nicClusterPolicyBuilder.CreateWithContext(ctx)
 			Expect(err).ToNot(HaveOccurred(), "Error Creating NicClusterPolicy from csv "+
 				"almExamples  %v ", err)
 			glog.V(networkparams.LogLevel).Infof("NicClusterPolicy '%s' is successfully created",
@@ -1036,7 +1058,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 			}()
 
 			By("Pull the NicClusterPolicy just created from cluster, with updated fields")
-			pulledNicClusterPolicy, err := nvidianetwork.PullNicClusterPolicy(inittools.APIClient,
+			pulledNicClusterPolicy, err := nvidianetwork.PullNicClusterPolicyWithContext(ctx, inittools.APIClient,
 				nnoNicClusterPolicyName)
 			Expect(err).ToNot(HaveOccurred(), "error pulling NicClusterPolicy %s from cluster: "+
 				" %v ", nnoNicClusterPolicyName, err)
@@ -1055,7 +1077,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 
 			By("Wait up to 24 minutes for NicClusterPolicy to be ready")
 			glog.V(networkparams.LogLevel).Infof("Waiting for NicClusterPolicy to be ready")
-			err = wait.NicClusterPolicyReady(inittools.APIClient, nnoNicClusterPolicyName, 60*time.Second,
+			err = wait.NicClusterPolicyReadyWithContext(ctx, inittools.APIClient, nnoNicClusterPolicyName, 60*time.Second,
 				24*time.Minute)
 
 			glog.V(networkparams.LogLevel).Infof("error waiting for NicClusterPolicy to be Ready:  %v ", err)
@@ -1063,7 +1085,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				" %v ", err)
 
 			By("Pull the ready NicClusterPolicy from cluster, with updated fields")
-			pulledReadyNicClusterPolicy, err := nvidianetwork.PullNicClusterPolicy(inittools.APIClient,
+			pulledReadyNicClusterPolicy, err := nvidianetwork.PullNicClusterPolicyWithContext(ctx, inittools.APIClient,
 				nnoNicClusterPolicyName)
 			Expect(err).ToNot(HaveOccurred(), "error pulling NicClusterPolicy %s from cluster: "+
 				" %v ", nnoNicClusterPolicyName, err)
@@ -1105,7 +1127,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 			macvlanNetworkBuilder.Definition.Spec.Master = mellanoxEthernetInterfaceName
 
 			By("Deploy MacvlanNetwork")
-			createdMacvlanNetworkBuilder, err := macvlanNetworkBuilder.Create()
+			createdMacvlanNetworkBuilder, err := macvlanNetworkBuilder.CreateWithContext(ctx)
 			Expect(err).ToNot(HaveOccurred(), "Error Creating MacvlanNetwork from csv "+
 				"almExamples  %v ", err)
 			glog.V(networkparams.LogLevel).Infof("MacvlanNetwork '%s' is successfully created",
@@ -1119,7 +1141,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 			}()
 
 			By("Pull the MacvlanNetwork just created from cluster, with updated fields")
-			pulledMacvlanNetwork, err := nvidianetwork.PullMacvlanNetwork(inittools.APIClient, macvlanNetworkName)
+			pulledMacvlanNetwork, err := nvidianetwork.PullMacvlanNetworkWithContext(ctx, inittools.APIClient, macvlanNetworkName)
 			Expect(err).ToNot(HaveOccurred(), "error pulling MacvlanNetwork %s from cluster: "+
 				" %v ", macvlanNetworkName, err)
 
@@ -1137,7 +1159,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 
 			By("Wait up to 5 minutes for MacvlanNetwork to be ready")
 			glog.V(networkparams.LogLevel).Infof("Waiting for MacvlanNetwork to be ready")
-			err = wait.MacvlanNetworkReady(inittools.APIClient, macvlanNetworkName, 60*time.Second,
+			err = wait.MacvlanNetworkReadyWithContext(ctx, inittools.APIClient, macvlanNetworkName, 60*time.Second,
 				5*time.Minute)
 
 			glog.V(networkparams.LogLevel).Infof("error waiting for MacvlanNetwork to be Ready:  %v ", err)
@@ -1145,7 +1167,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				" %v ", err)
 
 			By("Pull the ready MacvlanNetwork from cluster, with updated fields")
-			pulledReadyMacvlanNetwork, err := nvidianetwork.PullMacvlanNetwork(inittools.APIClient, macvlanNetworkName)
+			pulledReadyMacvlanNetwork, err := nvidianetwork.PullMacvlanNetworkWithContext(ctx, inittools.APIClient, macvlanNetworkName)
 			Expect(err).ToNot(HaveOccurred(), "error pulling MacvlanNetwork %s from cluster: "+
 				" %v ", macvlanNetworkName, err)
 
@@ -1198,7 +1220,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 			ipoibNetworkBuilder.Definition.Spec.Master = mellanoxInfinibandInterfaceName
 
 			By("Deploy IPoIBNetwork")
-			createdIPoIBNetworkBuilder, err := ipoibNetworkBuilder.Create()
+			createdIPoIBNetworkBuilder, err := ipoibNetworkBuilder.CreateWithContext(ctx)
 			Expect(err).ToNot(HaveOccurred(), "Error Creating IPoIBNetwork from csv "+
 				"almExamples  %v ", err)
 			glog.V(networkparams.LogLevel).Infof("IPoIBNetwork '%s' is successfully created",
@@ -1212,7 +1234,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 			}()
 
 			By("Pull the IPoIBNetwork just created from cluster, with updated fields")
-			pulledIPoIBNetwork, err := nvidianetwork.PullIPoIBNetwork(inittools.APIClient, ipoibNetworkName)
+			pulledIPoIBNetwork, err := nvidianetwork.PullIPoIBNetworkWithContext(ctx, inittools.APIClient, ipoibNetworkName)
 			Expect(err).ToNot(HaveOccurred(), "error pulling IPoIBNetwork %s from cluster: "+
 				" %v ", ipoibNetworkName, err)
 
@@ -1230,7 +1252,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 
 			By("Wait up to 5 minutes for IPoIBNetwork to be ready")
 			glog.V(networkparams.LogLevel).Infof("Waiting for IPoIBNetwork to be ready")
-			err = wait.IPoIBNetworkReady(inittools.APIClient, ipoibNetworkName, 60*time.Second,
+			err = wait.IPoIBNetworkReadyWithContext(ctx, inittools.APIClient, ipoibNetworkName, 60*time.Second,
 				5*time.Minute)
 
 			glog.V(networkparams.LogLevel).Infof("error waiting for IPoIBNetwork to be Ready:  %v ", err)
@@ -1238,7 +1260,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				" %v ", err)
 
 			By("Pull the ready IPoIBNetwork from cluster, with updated fields")
-			pulledReadyIPoIBNetwork, err := nvidianetwork.PullIPoIBNetwork(inittools.APIClient, ipoibNetworkName)
+			pulledReadyIPoIBNetwork, err := nvidianetwork.PullIPoIBNetworkWithContext(ctx, inittools.APIClient, ipoibNetworkName)
 			Expect(err).ToNot(HaveOccurred(), "error pulling IPoIBNetwork %s from cluster: "+
 				" %v ", ipoibNetworkName, err)
 
@@ -1256,7 +1278,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 
 		})
 
-		It("Run RDMA connectivity test with ib_write_bw", Label("rdma-shared-dev"), func() {
+		It("Run RDMA connectivity test with ib_write_bw", Label("rdma-shared-dev"), func(ctx SpecContext) {
 
 			var (
 				rdmaServerPodNamePrefix = "rdma-shared-dev-server-ci"
@@ -1288,9 +1310,11 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 			glog.V(networkparams.LogLevel).Infof("Successfully created RDMA ib_write_bw server workload pod '%s'",
 				createdRdmaServerPod.Name)
 
-			By("Wait 4 minutes for RDMA server pod to be running")
-			glog.V(networkparams.LogLevel).Infof("Waiting for 4 minutes for the RDMA server to be running")
-			time.Sleep(4 * time.Minute)
+			By("Wait up to 4 minutes for RDMA server pod to be running")
+			err = wait.WatchPodPhase(ctx, inittools.APIClient, rdmaServerPodName, rdmaWorkloadNamespace,
+				4*time.Minute, corev1.PodRunning)
+			Expect(err).ToNot(HaveOccurred(), "error waiting for RDMA Server '%s' to be running: %v",
+				rdmaServerPodName, err)
 
 			By("Get the interface net1 IP address in the ib_write_bw server workload pod")
 			glog.V(networkparams.LogLevel).Infof("Get the interface net1 interface Ip address in the "+
@@ -1328,11 +1352,11 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				"namespace '%s' and passed server IP Address '%s'", createdRdmaClientPod.Name,
 				createdRdmaClientPod.Namespace, net1IntIpAddrServer)
 
-			// Later remove sleep time and detect when RDMA test has completed
-			By("Wait 7 minutes for RDMA ib_write_bw tests to complete")
-			glog.V(networkparams.LogLevel).Infof("Waiting for 7 minutes for the RDMA ib_write_bw tests to " +
-				"complete")
-			time.Sleep(7 * time.Minute)
+			By("Wait up to 7 minutes for RDMA ib_write_bw tests to complete")
+			err = wait.WatchPodPhase(ctx, inittools.APIClient, rdmaServerPodName, rdmaWorkloadNamespace,
+				7*time.Minute, corev1.PodSucceeded, corev1.PodFailed)
+			Expect(err).ToNot(HaveOccurred(), "error waiting for RDMA Server '%s' to complete: %v",
+				rdmaServerPodName, err)
 
 			By("Collect logs from RDMA ib_write_bw tests from server workload pod")
 			glog.V(networkparams.LogLevel).Infof("Collect logs from RDMA ib_write_bw tests from server " +
@@ -1357,6 +1381,8 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 			glog.V(networkparams.LogLevel).Infof("Parsed and formatted RDMA server logs: \n'%s'",
 				string(jsonParseLogsMap))
 
+			recordRdmaBandwidth("rdma-shared-dev", parseLogsMap)
+
 			By("Validate logs from RDMA ib_write_bw tests from server workload pod")
 			rdmaTestPassFail, err := rdmatest.ValidateRDMAResults(parseLogsMap)
 
@@ -1366,7 +1392,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 		})
 
 		// RDMA Legacy SRIOV testcase
-		It("Run RDMA connectivity test with ib_write_bw", Label("rdma-legacy-sriov"), func() {
+		It("Run RDMA connectivity test with ib_write_bw", Label("rdma-legacy-sriov"), func(ctx SpecContext) {
 
 			By("Starting RDMA Legacy SRIOV connectivity test with ib_write_bw testcase")
 
@@ -1396,10 +1422,8 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				"-c",
 				"rdma link show"}
 
-			// For arm64 architecture image: quay.io/wabouham/ecosys-nvidia/ubi9-tools-arm64:0.0.1
-
 			rdmaLinkShowCmdLogClientHostOutput, err := rdmatest.RunCommandsOnSpecificNode(inittools.APIClient,
-				"oc-debug-busy-pod-client", rdmaWorkloadNamespace, clusterArchitecture, rdmaClientHostname,
+				"oc-debug-busy-pod-client", rdmaWorkloadNamespace, debugToolsImage, rdmaClientHostname,
 				rdmaCmd)
 
 			if err != nil {
@@ -1411,7 +1435,7 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				rdmaLinkShowCmdLogClientHostOutput)
 
 			rdmaLinkShowCmdLogServerHostOutput, err := rdmatest.RunCommandsOnSpecificNode(inittools.APIClient,
-				"oc-debug-busy-pod-server", rdmaWorkloadNamespace, clusterArchitecture, rdmaServerHostname,
+				"oc-debug-busy-pod-server", rdmaWorkloadNamespace, debugToolsImage, rdmaServerHostname,
 				rdmaCmd)
 
 			if err != nil {
@@ -1440,9 +1464,11 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 			glog.V(networkparams.LogLevel).Infof("Successfully created RDMA ib_write_bw server workload pod '%s'",
 				createdRdmaServerPod.Name)
 
-			By("Wait 4 minutes for RDMA server pod to be running")
-			glog.V(networkparams.LogLevel).Infof("Waiting for 4 minutes for the RDMA server to be running")
-			time.Sleep(4 * time.Minute)
+			By("Wait up to 4 minutes for RDMA server pod to be running")
+			err = wait.WatchPodPhase(ctx, inittools.APIClient, rdmaServerPodName, rdmaWorkloadNamespace,
+				4*time.Minute, corev1.PodRunning)
+			Expect(err).ToNot(HaveOccurred(), "error waiting for RDMA Server '%s' to be running: %v",
+				rdmaServerPodName, err)
 
 			By("Get the interface net1 IP address in the ib_write_bw server workload pod")
 			glog.V(networkparams.LogLevel).Infof("Get the interface net1 interface Ip address in the "+
@@ -1484,11 +1510,11 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 				"namespace '%s' and passed server IP Address '%s'", createdRdmaClientPod.Name,
 				createdRdmaClientPod.Namespace, net1IntIpAddrServer)
 
-			// Later remove sleep time and detect when RDMA test has completed
-			By("Wait 7 minutes for RDMA ib_write_bw tests to complete")
-			glog.V(networkparams.LogLevel).Infof("Waiting for 7 minutes for the RDMA ib_write_bw tests to " +
-				"complete")
-			time.Sleep(7 * time.Minute)
+			By("Wait up to 7 minutes for RDMA ib_write_bw tests to complete")
+			err = wait.WatchPodPhase(ctx, inittools.APIClient, rdmaServerPodName, rdmaWorkloadNamespace,
+				7*time.Minute, corev1.PodSucceeded, corev1.PodFailed)
+			Expect(err).ToNot(HaveOccurred(), "error waiting for RDMA Server '%s' to complete: %v",
+				rdmaServerPodName, err)
 
 			By("Collect logs from RDMA ib_write_bw tests from server workload pod")
 			glog.V(networkparams.LogLevel).Infof("Collect logs from RDMA ib_write_bw tests from server " +
@@ -1513,6 +1539,8 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 			glog.V(networkparams.LogLevel).Infof("Parsed and formatted RDMA server logs: \n'%s'",
 				string(jsonParseLogsMap))
 
+			recordRdmaBandwidth("rdma-legacy-sriov", parseLogsMap)
+
 			By("Validate logs from RDMA ib_write_bw tests from server workload pod")
 			rdmaTestPassFail, err := rdmatest.ValidateRDMAResults(parseLogsMap)
 
@@ -1524,5 +1552,117 @@ var _ = Describe("NNO", Ordered, Label(tsparams.LabelSuite), func() {
 
 		})
 
+		It("Run multi-node NCCL tests over the RDMA network", Label("nccl"), func(ctx SpecContext) {
+
+			if nvidiaNetworkConfig.NcclTestImage == "" {
+				Skip("NVIDIANETWORK_NCCL_TEST_IMAGE is not set, skipping the NCCL tests")
+			}
+
+			By("List the GPU nodes attached to the RDMA network")
+			ncclNodeSelector := map[string]string{nvidiagpu.NvidiaGPULabel: "true"}
+			for label, value := range WorkerNodeSelector {
+				ncclNodeSelector[label] = value
+			}
+
+			gpuNodes, err := nodes.ListWithContext(ctx, inittools.APIClient,
+				metav1.ListOptions{LabelSelector: labels.Set(ncclNodeSelector).String()})
+			Expect(err).ToNot(HaveOccurred(), "error listing GPU nodes with a Mellanox NIC: %v", err)
+
+			if len(gpuNodes) < nvidiaNetworkConfig.NcclNodeCount {
+				Skip(fmt.Sprintf("found %d GPU nodes with a Mellanox NIC, the NCCL tests need %d",
+					len(gpuNodes), nvidiaNetworkConfig.NcclNodeCount))
+			}
+
+			ncclImage, err := imageCatalog.Rewrite(nvidiaNetworkConfig.NcclTestImage)
+			Expect(err).ToNot(HaveOccurred(), "error resolving the NCCL tests image: %v", err)
+
+			ncclConfig := nccl.Config{
+				Image:        ncclImage,
+				NetworkName:  macvlanNetworkName,
+				GPUsPerNode:  nvidiaNetworkConfig.NcclGPUsPerNode,
+				RDMAResource: rdmatest.RdmaSharedDeviceResourceName[rdmaLinkType],
+				Device:       rdmaMlxDevice,
+				MaxBytes:     nvidiaNetworkConfig.NcclMaxBytes,
+			}
+
+			if rdmaNetworkType == nvidianetworkconfig.RdmaNetworkTypeSriov {
+				ncclConfig.NetworkName = sriovNetworkName
+				ncclConfig.RDMAResource = rdmatest.RdmaLegacySriovResourceName
+				// The pods only see a VF of the RDMA device, let NCCL pick it.
+				ncclConfig.Device = ""
+			} else if rdmaLinkType == "infiniband" {
+				ncclConfig.NetworkName = ipoibNetworkName
+			}
+
+			for _, gpuNode := range gpuNodes[:nvidiaNetworkConfig.NcclNodeCount] {
+				ncclConfig.Nodes = append(ncclConfig.Nodes, gpuNode.Object.Name)
+			}
+
+			glog.V(networkparams.LogLevel).Infof("Running NCCL tests %v on nodes %v attached to network '%s'",
+				nvidiaNetworkConfig.NcclTests, ncclConfig.Nodes, ncclConfig.NetworkName)
+
+			By("Deploy the NCCL worker pods")
+			benchmark, err := nccl.DeployWithContext(ctx, inittools.APIClient, ncclConfig, rdmaWorkloadNamespace)
+
+			defer func() {
+				if cleanupAfterTest {
+					err := benchmark.CleanupWithContext(ctx)
+					Expect(err).ToNot(HaveOccurred(), "error cleaning up the NCCL pods: %v", err)
+				}
+			}()
+
+			Expect(err).ToNot(HaveOccurred(), "error deploying the NCCL worker pods: %v", err)
+
+			By("Wait up to 5 minutes for the NCCL worker pods to be running")
+			err = benchmark.WaitForWorkersWithContext(ctx, 5*time.Minute)
+			Expect(err).ToNot(HaveOccurred(), "error waiting for the NCCL worker pods: %v", err)
+
+			for _, test := range nvidiaNetworkConfig.NcclTests {
+				By(fmt.Sprintf("Run '%s' on %d ranks", test, len(ncclConfig.Nodes)*ncclConfig.GPUsPerNode))
+				testResult, err := benchmark.RunTestWithContext(ctx, test, 15*time.Minute)
+				Expect(err).ToNot(HaveOccurred(), "error running NCCL test '%s': %v", test, err)
+
+				glog.V(networkparams.LogLevel).Infof("NCCL test '%s' output: \n'%s'", test, testResult.Output)
+
+				recordNcclBandwidth(test, testResult.Result)
+
+				problems := testResult.Result.Check(nvidiaNetworkConfig.NcclMinBusBandwidth)
+				Expect(problems).To(BeEmpty(), "NCCL test '%s' failed: %v", test, problems)
+			}
+		})
+
 	})
 })
+
+// recordRdmaBandwidth adds the ib_write_bw average and peak bandwidth of the given test to the run summary.
+func recordRdmaBandwidth(testName string, results map[string]string) {
+	for _, metric := range []struct{ name, resultKey string }{
+		{name: "ib_write_bw-avg", resultKey: "BW_Avg_Gbps"},
+		{name: "ib_write_bw-peak", resultKey: "BW_Peak_Gbps"},
+	} {
+		value, err := strconv.ParseFloat(results[metric.resultKey], 64)
+		if err != nil {
+			glog.V(networkparams.LogLevel).Infof("Error parsing RDMA result '%s': %v", metric.resultKey, err)
+
+			continue
+		}
+
+		runSummary.AddMetric(metric.name, value, "Gb/s", map[string]string{
+			"test":     testName,
+			"linkType": results["Link type"],
+		})
+	}
+}
+
+// recordNcclBandwidth adds the bus bandwidth of every message size and the average bus bandwidth of the given NCCL
+// test to the run summary.
+func recordNcclBandwidth(testName string, result *nccl.Result) {
+	for _, row := range result.Rows {
+		runSummary.AddMetric("nccl-busbw", max(row.OutOfPlace.BusBW, row.InPlace.BusBW), "GB/s", map[string]string{
+			"test":      testName,
+			"sizeBytes": strconv.FormatInt(row.SizeBytes, 10),
+		})
+	}
+
+	runSummary.AddMetric("nccl-busbw-avg", result.AvgBusBW, "GB/s", map[string]string{"test": testName})
+}
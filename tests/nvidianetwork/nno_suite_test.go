@@ -4,8 +4,11 @@ import (
 	"runtime"
 	"testing"
 
+	"github.com/golang/glog"
+
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/reporter"
 	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/clients"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/logging"
 
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
 	"github.com/rh-ecosystem-edge/nvidia-ci/internal/tsparams"
@@ -24,8 +27,30 @@ func TestNNODeploy(t *testing.T) {
 	RunSpecs(t, "NNO", Label(tsparams.NetworkLabels...), reporterConfig)
 }
 
-var _ = JustAfterEach(func() {
+var _ = JustAfterEach(func(ctx SpecContext) {
 	reporter.ReportIfFailed(
 		CurrentSpecReport(), currentFile, tsparams.NetworkReporterNamespacesToDump, tsparams.NetworkReporterCRDsToDump,
 		clients.SetScheme)
+	reporter.CollectMustGatherIfFailed(ctx, CurrentSpecReport(), tsparams.NetworkReporterNamespacesToDump)
+})
+
+var _ = BeforeEach(func() {
+	specLogPath := inittools.GeneralConfig.GetReportPath(logging.SpecLogFileName(CurrentSpecReport().FullText()))
+	if err := logging.StartSpecLog(specLogPath); err != nil {
+		glog.Errorf("Failed to start spec log: %v", err)
+	}
+})
+
+var _ = AfterEach(func() {
+	if err := logging.StopSpecLog(); err != nil {
+		glog.Errorf("Failed to stop spec log: %v", err)
+	}
+})
+
+var _ = ReportAfterSuite("run summary", func(report Report) {
+	runSummary.AddSpecReports(report.SpecReports)
+
+	if err := runSummary.Write(inittools.GeneralConfig); err != nil {
+		glog.Errorf("Failed to write run summary: %v", err)
+	}
 })
@@ -0,0 +1,176 @@
+package soak
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/glog"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/get"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/imagecatalog"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/nvidiagpuconfig"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/soak"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/tsparams"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/workload"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/namespace"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nodes"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// TestNamespace is the namespace where the soak workload and node debug pods run.
+	TestNamespace = "test-soak"
+	// TimelineFileName is the name of the human-readable soak timeline written in the report directory.
+	TimelineFileName = "soak-timeline.txt"
+)
+
+var (
+	nvidiaGPUConfig *nvidiagpuconfig.NvidiaGPUConfig
+	imageCatalog    *imagecatalog.Catalog
+	imageCatalogErr error
+)
+
+var _ = Describe("Soak", Ordered, Label(tsparams.LabelSuite), func() {
+	var (
+		gpuNodes     []*nodes.Builder
+		gpuWorkload  workload.GPUWorkload
+		debugImage   string
+		nsBuilder    *namespace.Builder
+		workloadName string
+	)
+
+	gpuNodeSelector := map[string]string{
+		inittools.GeneralConfig.WorkerLabel: "",
+		nvidiagpu.NvidiaGPULabel:            "true",
+	}
+
+	nvidiaGPUConfig = nvidiagpuconfig.NewNvidiaGPUConfig()
+	imageCatalog, imageCatalogErr = imagecatalog.NewCatalog()
+
+	BeforeAll(func(ctx SpecContext) {
+		glog.V(gpuparams.GpuLogLevel).Info("Starting soak test suite")
+
+		Expect(nvidiaGPUConfig).ToNot(BeNil(), "error loading NvidiaGPUConfig from profile file "+
+			"and NVIDIAGPU_* env variables")
+		Expect(imageCatalogErr).ToNot(HaveOccurred(), "error loading the image catalog: %v", imageCatalogErr)
+
+		if err := inittools.GeneralConfig.WriteEffectiveConfig(nvidiagpuconfig.ProfileSection,
+			nvidiaGPUConfig); err != nil {
+			glog.Error("Error writing the effective NvidiaGPUConfig: ", err)
+		}
+
+		if err := inittools.GeneralConfig.WriteEffectiveConfig(imagecatalog.ProfileSection,
+			imageCatalog); err != nil {
+			glog.Error("Error writing the effective image catalog: ", err)
+		}
+
+		if nvidiaGPUConfig.SoakDuration == 0 {
+			Skip("NVIDIAGPU_SOAK_DURATION not set, skipping the soak test")
+		}
+
+		var err error
+		gpuNodes, err = nodes.ListWithContext(ctx, inittools.APIClient,
+			metav1.ListOptions{LabelSelector: labels.Set(gpuNodeSelector).String()})
+		Expect(err).ToNot(HaveOccurred(), "error listing GPU nodes: %v", err)
+
+		if len(gpuNodes) == 0 {
+			Skip("no GPU node found")
+		}
+
+		clusterArchitecture, err := get.GetClusterArchitecture(inittools.APIClient, gpuNodeSelector)
+		Expect(err).ToNot(HaveOccurred(), "error getting the GPU nodes architecture: %v", err)
+
+		debugImage, err = imageCatalog.Image(imagecatalog.DebugTools, clusterArchitecture)
+		Expect(err).ToNot(HaveOccurred(), "error resolving the node debug image: %v", err)
+
+		workloadName = nvidiaGPUConfig.SoakWorkload
+		if workloadName == "" {
+			workloadName = nvidiaGPUConfig.Workload
+		}
+
+		gpuWorkload, err = workload.New(workloadName, workload.Params{
+			Config:       nvidiaGPUConfig,
+			Architecture: clusterArchitecture,
+			Images:       imageCatalog,
+			NodeSelector: gpuNodeSelector,
+		})
+		Expect(err).ToNot(HaveOccurred(), "error building the '%s' GPU workload: %v", workloadName, err)
+
+		nsBuilder = namespace.NewBuilder(inittools.APIClient, TestNamespace)
+		if !nsBuilder.ExistsWithContext(ctx) {
+			_, err := nsBuilder.CreateWithContext(ctx)
+			Expect(err).ToNot(HaveOccurred(), "error creating namespace %s: %v", TestNamespace, err)
+		}
+
+		// The node debug pods reading the kernel log are privileged.
+		_, err = nsBuilder.WithMultipleLabels(map[string]string{
+			"pod-security.kubernetes.io/enforce": "privileged",
+		}).UpdateWithContext(ctx)
+		Expect(err).ToNot(HaveOccurred(), "error labeling namespace %s: %v", TestNamespace, err)
+	})
+
+	AfterAll(func(ctx SpecContext) {
+		if nsBuilder != nil && nvidiaGPUConfig.CleanupAfterTest {
+			if err := nsBuilder.DeleteWithContext(ctx); err != nil {
+				glog.Errorf("Error deleting namespace %s: %v", TestNamespace, err)
+			}
+		}
+	})
+
+	It("Should run GPU workloads on every GPU node without GPU errors", Label("soak"), func(ctx SpecContext) {
+		By(fmt.Sprintf("Run '%s' on all the GPUs of %d nodes for %s", workloadName, len(gpuNodes),
+			nvidiaGPUConfig.SoakDuration))
+		report, err := soak.Run(ctx, inittools.APIClient, soak.Config{
+			Duration:        nvidiaGPUConfig.SoakDuration,
+			ScanInterval:    nvidiaGPUConfig.SoakScanInterval,
+			Workload:        gpuWorkload,
+			Namespace:       TestNamespace,
+			Targets:         workload.NodeTargets("soak", gpuNodes, 0),
+			DebugImage:      debugImage,
+			DriverNamespace: nvidiagpu.NvidiaGPUNamespace,
+			IgnoredXids:     nvidiaGPUConfig.SoakIgnoredXids,
+		})
+
+		if report != nil {
+			writeReport(report)
+		}
+
+		Expect(err).ToNot(HaveOccurred(), "error running the soak test: %v", err)
+		Expect(report.Failures()).To(BeEmpty(), "GPU errors detected during the soak test:\n%s",
+			report.Timeline())
+	})
+})
+
+// writeReport writes the soak report and timeline to the report directory, and adds the number of iterations and
+// failures per node to the run summary.
+func writeReport(report *soak.Report) {
+	glog.V(gpuparams.GpuLogLevel).Infof("Soak timeline:\n%s", report.Timeline())
+
+	if content, err := json.MarshalIndent(report, "", "  "); err != nil {
+		glog.Errorf("Error marshalling the soak report: %v", err)
+	} else if err := inittools.GeneralConfig.WriteReport(soak.ReportFileName, content); err != nil {
+		glog.Errorf("Error writing the soak report: %v", err)
+	}
+
+	if err := inittools.GeneralConfig.WriteReport(TimelineFileName, []byte(report.Timeline())); err != nil {
+		glog.Errorf("Error writing the soak timeline: %v", err)
+	}
+
+	runSummary.AddMetric("soak-iterations", float64(report.Iterations), "iterations",
+		map[string]string{"workload": report.Workload})
+
+	failures := map[string]int{}
+	for _, event := range report.Failures() {
+		failures[event.Node]++
+	}
+
+	for _, nodeName := range report.Nodes {
+		runSummary.AddMetric("soak-failures", float64(failures[nodeName]), "events",
+			map[string]string{workload.NodeMetricLabel: nodeName})
+	}
+}
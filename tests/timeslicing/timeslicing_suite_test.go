@@ -0,0 +1,28 @@
+package timeslicing
+
+import (
+	"runtime"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/runsummary"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/suite"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/tsparams"
+)
+
+var _, currentFile, _, _ = runtime.Caller(0)
+
+var runSummary = runsummary.New("TimeSlicing")
+
+var _ = suite.Register(runSummary, currentFile, tsparams.TimeSlicingReporterNamespacesToDump,
+	tsparams.TimeSlicingReporterCRDsToDump)
+
+func TestTimeSlicing(t *testing.T) {
+	_, reporterConfig := GinkgoConfiguration()
+	reporterConfig.JUnitReport = inittools.GeneralConfig.GetJunitReportPath(currentFile)
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "TimeSlicing", Label("nvidia-ci", "time-slicing"), reporterConfig)
+}
@@ -0,0 +1,334 @@
+package timeslicing
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	nvidiagpuv1 "github.com/NVIDIA/gpu-operator/api/nvidia/v1"
+	"github.com/golang/glog"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/gpuparams"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/imagecatalog"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/inittools"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/nvidiagpuconfig"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/timeslicing"
+	"github.com/rh-ecosystem-edge/nvidia-ci/internal/tsparams"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/configmap"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/namespace"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nodes"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/nvidiagpu"
+	"github.com/rh-ecosystem-edge/nvidia-ci/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// TestNamespace is the namespace where the time-slicing test pods run.
+	TestNamespace = "test-time-slicing"
+	// DevicePluginConfigMapName is the name of the ConfigMap holding the time-slicing configurations.
+	DevicePluginConfigMapName = "time-slicing-config"
+	// DefaultConfigName is the time-slicing configuration of the nodes without device plugin config label.
+	DefaultConfigName = "time-slicing"
+	// SharedConfigName is the time-slicing configuration renaming the replicas to nvidia.com/gpu.shared.
+	SharedConfigName = "time-slicing-shared"
+	// TestDuration is how long to wait for the node capacity and the test pods.
+	TestDuration = 10 * time.Minute
+	// TimeStep is the polling interval of the test.
+	TimeStep = 30 * time.Second
+)
+
+var (
+	nvidiaGPUConfig *nvidiagpuconfig.NvidiaGPUConfig
+	imageCatalog    *imagecatalog.Catalog
+	imageCatalogErr error
+)
+
+var _ = Describe("TimeSlicing", Ordered, Label(tsparams.LabelSuite), func() {
+	var (
+		gpuNodes                   []string
+		defaultConfig              timeslicing.Config
+		sharedConfig               timeslicing.Config
+		originalDevicePluginConfig *nvidiagpuv1.DevicePluginConfig
+		configMap                  *configmap.Builder
+		nsBuilder                  *namespace.Builder
+		testImage                  string
+	)
+
+	gpuNodeSelector := map[string]string{
+		inittools.GeneralConfig.WorkerLabel: "",
+		nvidiagpu.NvidiaGPULabel:            "true",
+	}
+
+	nvidiaGPUConfig = nvidiagpuconfig.NewNvidiaGPUConfig()
+	imageCatalog, imageCatalogErr = imagecatalog.NewCatalog()
+
+	BeforeAll(func(ctx SpecContext) {
+		glog.V(gpuparams.GpuLogLevel).Info("Starting time-slicing test suite")
+
+		Expect(nvidiaGPUConfig).ToNot(BeNil(), "error loading NvidiaGPUConfig from profile file "+
+			"and NVIDIAGPU_* env variables")
+		Expect(imageCatalogErr).ToNot(HaveOccurred(), "error loading the image catalog: %v", imageCatalogErr)
+
+		if err := inittools.GeneralConfig.WriteEffectiveConfig(nvidiagpuconfig.ProfileSection,
+			nvidiaGPUConfig); err != nil {
+			glog.Error("Error writing the effective NvidiaGPUConfig: ", err)
+		}
+
+		if err := inittools.GeneralConfig.WriteEffectiveConfig(imagecatalog.ProfileSection,
+			imageCatalog); err != nil {
+			glog.Error("Error writing the effective image catalog: ", err)
+		}
+
+		defaultConfig = timeslicing.Config{
+			Replicas:                   nvidiaGPUConfig.TimeSlicingReplicas,
+			FailRequestsGreaterThanOne: true,
+		}
+		sharedConfig = timeslicing.Config{Replicas: nvidiaGPUConfig.TimeSlicingReplicas, RenameByDefault: true}
+
+		var err error
+		testImage, err = imageCatalog.Resolve(inittools.APIClient, imagecatalog.CUDABase, gpuNodeSelector)
+		Expect(err).ToNot(HaveOccurred(), "error resolving the time-slicing test image: %v", err)
+
+		nodeBuilders, err := nodes.ListWithContext(ctx, inittools.APIClient,
+			metav1.ListOptions{LabelSelector: labels.Set(gpuNodeSelector).String()})
+		Expect(err).ToNot(HaveOccurred(), "error listing GPU nodes: %v", err)
+
+		if len(nodeBuilders) == 0 {
+			Skip("no GPU node found")
+		}
+
+		for _, nodeBuilder := range nodeBuilders {
+			gpuNodes = append(gpuNodes, nodeBuilder.Object.Name)
+		}
+
+		slices.Sort(gpuNodes)
+
+		clusterPolicy, err := nvidiagpu.PullWithContext(ctx, inittools.APIClient, nvidiagpu.ClusterPolicyName)
+		Expect(err).ToNot(HaveOccurred(), "error pulling ClusterPolicy, the time-slicing tests require a deployed "+
+			"GPU operator: %v", err)
+
+		if clusterPolicy.Object.Spec.DevicePlugin.Config != nil {
+			originalDevicePluginConfig = clusterPolicy.Object.Spec.DevicePlugin.Config.DeepCopy()
+		}
+
+		nsBuilder = namespace.NewBuilder(inittools.APIClient, TestNamespace)
+		if !nsBuilder.ExistsWithContext(ctx) {
+			_, err := nsBuilder.CreateWithContext(ctx)
+			Expect(err).ToNot(HaveOccurred(), "error creating namespace %s: %v", TestNamespace, err)
+		}
+
+		configMap, err = timeslicing.CreateDevicePluginConfigMap(inittools.APIClient, DevicePluginConfigMapName,
+			nvidiagpu.NvidiaGPUNamespace, map[string]timeslicing.Config{
+				DefaultConfigName: defaultConfig,
+				SharedConfigName:  sharedConfig,
+			})
+		Expect(err).ToNot(HaveOccurred(), "error creating device plugin ConfigMap: %v", err)
+
+		_, err = clusterPolicy.WithDevicePluginConfig(DevicePluginConfigMapName, DefaultConfigName).
+			UpdateWithContext(ctx, true)
+		Expect(err).ToNot(HaveOccurred(), "error setting ClusterPolicy device plugin config: %v", err)
+	})
+
+	AfterAll(func(ctx SpecContext) {
+		for _, nodeName := range gpuNodes {
+			nodeBuilder, err := nodes.PullWithContext(ctx, inittools.APIClient, nodeName)
+			if err != nil {
+				glog.Errorf("Error pulling node %s: %v", nodeName, err)
+
+				continue
+			}
+
+			if _, labeled := nodeBuilder.Object.Labels[timeslicing.DevicePluginConfigLabel]; !labeled {
+				continue
+			}
+
+			_, err = nodeBuilder.RemoveLabel(timeslicing.DevicePluginConfigLabel, "").UpdateWithContext(ctx)
+			if err != nil {
+				glog.Errorf("Error removing label %s from node %s: %v", timeslicing.DevicePluginConfigLabel,
+					nodeName, err)
+			}
+		}
+
+		if configMap != nil {
+			if clusterPolicy, err := nvidiagpu.PullWithContext(ctx, inittools.APIClient,
+				nvidiagpu.ClusterPolicyName); err != nil {
+				glog.Errorf("Error pulling ClusterPolicy: %v", err)
+			} else {
+				clusterPolicy.Definition.Spec.DevicePlugin.Config = originalDevicePluginConfig
+				if _, err := clusterPolicy.UpdateWithContext(ctx, true); err != nil {
+					glog.Errorf("Error restoring ClusterPolicy device plugin config: %v", err)
+				}
+			}
+
+			if err := configMap.DeleteWithContext(ctx); err != nil {
+				glog.Errorf("Error deleting ConfigMap %s: %v", DevicePluginConfigMapName, err)
+			}
+		}
+
+		if nsBuilder != nil {
+			if err := nsBuilder.DeleteWithContext(ctx); err != nil {
+				glog.Errorf("Error deleting namespace %s: %v", TestNamespace, err)
+			}
+		}
+	})
+
+	It("Should advertise the time-sliced GPU replicas", Label("time-slicing"), func(ctx SpecContext) {
+		for _, nodeName := range gpuNodes {
+			By(fmt.Sprintf("Check node %s advertises %d replicas of every GPU", nodeName, defaultConfig.Replicas))
+			Eventually(func() error {
+				return checkNodeCapacity(ctx, nodeName, defaultConfig)
+			}, TestDuration, TimeStep).Should(Succeed(), "node %s does not advertise the GPU replicas", nodeName)
+		}
+	})
+
+	It("Should share one GPU between concurrent pods", Label("time-slicing"), func(ctx SpecContext) {
+		nodeName := gpuNodes[0]
+
+		nodeBuilder, err := nodes.PullWithContext(ctx, inittools.APIClient, nodeName)
+		Expect(err).ToNot(HaveOccurred(), "error pulling node %s: %v", nodeName, err)
+
+		gpuCount, err := strconv.Atoi(nodeBuilder.Object.Labels[nvidiagpu.GPUCountLabel])
+		Expect(err).ToNot(HaveOccurred(), "node %s has no valid %s label: %v", nodeName,
+			nvidiagpu.GPUCountLabel, err)
+
+		podCount := gpuCount * defaultConfig.Replicas
+
+		By(fmt.Sprintf("Run %d pods on the %d GPUs of node %s", podCount, gpuCount, nodeName))
+
+		var podNames []string
+
+		for index := range podCount {
+			podName := fmt.Sprintf("time-slicing-%d", index)
+			podNames = append(podNames, podName)
+
+			createTestPod(ctx, timeslicing.CreateTimeSlicingTestPod(podName, TestNamespace, testImage, nodeName,
+				defaultConfig.ResourceName(), 1))
+		}
+
+		podsPerGPU := make(map[string]int)
+
+		for _, podName := range podNames {
+			podBuilder, err := pod.PullWithContext(ctx, inittools.APIClient, podName, TestNamespace)
+			Expect(err).ToNot(HaveOccurred(), "error pulling pod %s: %v", podName, err)
+
+			err = podBuilder.WaitUntilInStatusWithContext(ctx, corev1.PodRunning, TestDuration)
+			Expect(err).ToNot(HaveOccurred(), "error waiting for pod %s to run: %v", podName, err)
+		}
+
+		By("Check every GPU is shared by all its replicas")
+
+		for _, podName := range podNames {
+			var uuids []string
+
+			Eventually(func() []string {
+				podBuilder, err := pod.PullWithContext(ctx, inittools.APIClient, podName, TestNamespace)
+				if err != nil {
+					glog.Errorf("Error pulling pod %s: %v", podName, err)
+
+					return nil
+				}
+
+				Expect(podBuilder.Object.Status.Phase).To(Equal(corev1.PodRunning),
+					"pod %s stopped running while sharing the GPU", podName)
+
+				output, err := podBuilder.GetFullLogWithContext(ctx, "time-slicing-test-ctr")
+				if err != nil {
+					glog.Errorf("Error getting pod %s log: %v", podName, err)
+
+					return nil
+				}
+
+				uuids = timeslicing.GPUUUIDs(output)
+
+				return uuids
+			}, TestDuration, TimeStep).Should(HaveLen(1), "pod %s was not allocated exactly one GPU", podName)
+
+			podsPerGPU[uuids[0]]++
+		}
+
+		glog.V(gpuparams.GpuLogLevel).Infof("Pods per GPU on node %s: %v", nodeName, podsPerGPU)
+
+		Expect(podsPerGPU).To(HaveLen(gpuCount), "pods did not run on all the GPUs of node %s", nodeName)
+
+		for uuid, count := range podsPerGPU {
+			Expect(count).To(Equal(defaultConfig.Replicas), "GPU %s is shared by %d pods instead of %d", uuid, count,
+				defaultConfig.Replicas)
+		}
+	})
+
+	It("Should fail requests of more than one time-sliced GPU", Label("time-slicing"), func(ctx SpecContext) {
+		podName := "time-slicing-greater-than-one"
+		createTestPod(ctx, timeslicing.CreateTimeSlicingTestPod(podName, TestNamespace, testImage, gpuNodes[0],
+			defaultConfig.ResourceName(), 2))
+
+		var podStatus corev1.PodStatus
+
+		Eventually(func() corev1.PodPhase {
+			podBuilder, err := pod.PullWithContext(ctx, inittools.APIClient, podName, TestNamespace)
+			if err != nil {
+				glog.Errorf("Error pulling pod %s: %v", podName, err)
+
+				return ""
+			}
+
+			podStatus = podBuilder.Object.Status
+
+			return podStatus.Phase
+		}, TestDuration, TimeStep).Should(Equal(corev1.PodFailed), "pod %s requesting 2 GPUs did not fail", podName)
+
+		Expect(podStatus.Reason).To(Equal("UnexpectedAdmissionError"),
+			"pod %s failed for an unexpected reason: %s", podName, podStatus.Message)
+	})
+
+	It("Should apply the device plugin config selected by the node label", Label("time-slicing"),
+		func(ctx SpecContext) {
+			nodeName := gpuNodes[0]
+
+			By(fmt.Sprintf("Label node %s with device plugin config %s", nodeName, SharedConfigName))
+			nodeBuilder, err := nodes.PullWithContext(ctx, inittools.APIClient, nodeName)
+			Expect(err).ToNot(HaveOccurred(), "error pulling node %s: %v", nodeName, err)
+
+			_, err = nodeBuilder.RemoveLabel(timeslicing.DevicePluginConfigLabel, "").
+				WithNewLabel(timeslicing.DevicePluginConfigLabel, SharedConfigName).
+				UpdateWithContext(ctx)
+			Expect(err).ToNot(HaveOccurred(), "error labeling node %s: %v", nodeName, err)
+
+			By(fmt.Sprintf("Check node %s advertises %s", nodeName, timeslicing.SharedGPUResourceName))
+			Eventually(func() error {
+				return checkNodeCapacity(ctx, nodeName, sharedConfig)
+			}, TestDuration, TimeStep).Should(Succeed(), "node %s does not advertise the shared GPU replicas",
+				nodeName)
+
+			for _, otherNode := range gpuNodes[1:] {
+				Expect(checkNodeCapacity(ctx, otherNode, defaultConfig)).To(Succeed(),
+					"node %s without device plugin config label does not keep the default config", otherNode)
+			}
+		})
+})
+
+// checkNodeCapacity pulls the node and checks it advertises the GPU replicas of the time-slicing configuration.
+func checkNodeCapacity(ctx context.Context, nodeName string, config timeslicing.Config) error {
+	nodeBuilder, err := nodes.PullWithContext(ctx, inittools.APIClient, nodeName)
+	if err != nil {
+		return err
+	}
+
+	return timeslicing.CheckNodeCapacity(nodeBuilder.Object, config)
+}
+
+// createTestPod creates the time-slicing test pod and deletes it when the spec ends.
+func createTestPod(ctx context.Context, testPod *corev1.Pod) {
+	podBuilder, err := pod.NewBuilderFromDefinition(inittools.APIClient, testPod).CreateWithContext(ctx)
+	Expect(err).ToNot(HaveOccurred(), "error creating pod %s: %v", testPod.Name, err)
+
+	DeferCleanup(func(ctx SpecContext) {
+		if _, err := podBuilder.DeleteWithContext(ctx); err != nil {
+			glog.Errorf("Error deleting pod %s: %v", testPod.Name, err)
+		}
+	})
+}